@@ -0,0 +1,47 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/mashfeii/songs_library/internal/fakemusicinfo"
+	"github.com/sirupsen/logrus"
+)
+
+func main() {
+	addr := flag.String("addr", ":8081", "address to listen on")
+	fixturesPath := flag.String("fixtures", "cmd/fake_music_info/fixtures.json", "JSON file with the served songs")
+	latency := flag.Duration("latency", 0, "delay added to every answer")
+	errorRate := flag.Float64("error-rate", 0, "share of requests answered with -error-status, from 0 to 1")
+	errorStatus := flag.Int("error-status", 500, "status of randomly failed requests")
+	status := flag.Int("status", 0, "status every request is answered with, 0 serves the fixtures")
+	flag.Parse()
+
+	opts := fakemusicinfo.Options{
+		Latency:     *latency,
+		ErrorRate:   *errorRate,
+		ErrorStatus: *errorStatus,
+		Status:      *status,
+	}
+	if err := opts.Validate(); err != nil {
+		fmt.Fprintln(flag.CommandLine.Output(), "invalid flags:", err)
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	fixtures, err := fakemusicinfo.LoadFixtures(*fixturesPath)
+	if err != nil {
+		logrus.Fatal("Loading fixtures: ", err)
+	}
+
+	fake := fakemusicinfo.New(fixtures, opts)
+
+	logrus.WithFields(logrus.Fields{
+		"addr":     *addr,
+		"fixtures": len(fixtures),
+	}).Info("Starting fake music info API")
+
+	logrus.Error(http.ListenAndServe(*addr, fake))
+}
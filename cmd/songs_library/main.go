@@ -2,7 +2,15 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -11,6 +19,7 @@ import (
 	"github.com/mashfeii/songs_library/internal/application"
 	"github.com/mashfeii/songs_library/internal/infrastructure/database"
 	"github.com/mashfeii/songs_library/internal/infrastructure/handlers"
+	"github.com/mashfeii/songs_library/internal/infrastructure/musicinfo"
 	"github.com/sirupsen/logrus"
 
 	_ "github.com/mashfeii/songs_library/docs"
@@ -22,6 +31,9 @@ import (
 	_ "github.com/golang-migrate/migrate/v4/source/file"
 )
 
+// shutdownTimeout is how long requests in flight may take to finish on shutdown.
+const shutdownTimeout = 10 * time.Second
+
 func migrateDB(connectionString string) {
 	logrus.SetFormatter(&logrus.JSONFormatter{})
 	logrus.SetLevel(logrus.DebugLevel)
@@ -41,12 +53,108 @@ func migrateDB(connectionString string) {
 	logrus.Info("Database migrated successfully")
 }
 
-func initRouting(r *gin.Engine, service *application.SongsService) {
+// newMetadataProvider chains the providers listed in METADATA_PROVIDERS in their order.
+func newMetadataProvider(cfg *config.Config, apiClient client.ClientWithResponsesInterface) (*application.ProviderChain, error) {
+	var providers []application.MetadataProvider
+
+	for _, name := range strings.Split(cfg.MetadataProviders, ",") {
+		switch name = strings.TrimSpace(name); name {
+		case "":
+			continue
+		case musicinfo.APIProviderName:
+			providers = append(providers, musicinfo.NewAPIProvider(apiClient))
+		case musicinfo.CatalogProviderName:
+			catalog, err := musicinfo.LoadCatalog(cfg.MetadataCatalogPath)
+			if err != nil {
+				return nil, err
+			}
+
+			providers = append(providers, catalog)
+		default:
+			return nil, fmt.Errorf("unknown metadata provider %q", name)
+		}
+	}
+
+	if len(providers) == 0 {
+		return nil, fmt.Errorf("no metadata providers configured")
+	}
+
+	return application.NewProviderChain(providers...), nil
+}
+
+func initRouting(
+	r *gin.Engine,
+	service *application.SongsService,
+	groupsService *application.GroupsService,
+	albumsService *application.AlbumsService,
+	tagsService *application.TagsService,
+	lyricsService *application.LyricsService,
+	annotationsService *application.AnnotationsService,
+	translationsService *application.TranslationsService,
+	musicInfo musicinfo.BreakerReporter,
+	infoCacheService *application.InfoCacheService,
+	metadataRefreshService *application.MetadataRefreshService,
+	auditService *application.AuditService,
+) {
 	r.GET("/songs", handlers.GetSongs(service))
+	r.GET("/songs/search", handlers.SearchSongs(service))
+	r.GET("/songs/:id", handlers.GetSong(service))
 	r.GET("/songs/:id/verses", handlers.GetSongVerses(service))
+	r.GET("/songs/:id/status", handlers.GetSongStatus(service))
 	r.POST("/songs", handlers.AddSong(service))
 	r.PUT("/songs/:id", handlers.UpdateSong(service))
+	r.PATCH("/songs/:id", handlers.PatchSong(service))
 	r.DELETE("/songs/:id", handlers.DeleteSong(service))
+	r.POST("/songs/:id/restore", handlers.RestoreSong(service))
+	r.GET("/trash/songs", handlers.GetTrashedSongs(service))
+	r.GET("/songs/:id/revisions", handlers.GetSongRevisions(service))
+	r.GET("/songs/:id/revisions/diff", handlers.DiffSongRevisions(service))
+	r.GET("/songs/:id/revisions/:rev", handlers.GetSongRevision(service))
+	r.POST("/songs/:id/revisions/:rev/restore", handlers.RestoreSongRevision(service))
+	r.PUT("/songs/:id/artists", handlers.SetSongArtists(service))
+	r.PUT("/songs/:id/tags", handlers.SetSongTags(tagsService))
+	r.GET("/songs/:id/lyrics", handlers.GetLyrics(lyricsService))
+	r.GET("/songs/:id/lyrics/at", handlers.GetLyricsAt(lyricsService))
+	r.PUT("/songs/:id/lyrics", handlers.PutLyrics(lyricsService))
+	r.DELETE("/songs/:id/lyrics", handlers.DeleteLyrics(lyricsService))
+	r.GET("/songs/:id/annotations", handlers.GetAnnotations(annotationsService))
+	r.POST("/songs/:id/annotations", handlers.AddAnnotation(annotationsService))
+	r.GET("/songs/:id/annotations/:annotationId", handlers.GetAnnotation(annotationsService))
+	r.PUT("/songs/:id/annotations/:annotationId", handlers.UpdateAnnotation(annotationsService))
+	r.DELETE("/songs/:id/annotations/:annotationId", handlers.DeleteAnnotation(annotationsService))
+	r.GET("/songs/:id/translations", handlers.GetTranslations(translationsService))
+	r.POST("/songs/:id/translations", handlers.AddTranslation(translationsService))
+	r.GET("/songs/:id/translations/:lang", handlers.GetTranslation(translationsService))
+	r.PUT("/songs/:id/translations/:lang", handlers.UpdateTranslation(translationsService))
+
+	r.GET("/groups", handlers.GetGroups(groupsService))
+	r.GET("/groups/:id", handlers.GetGroup(groupsService))
+	r.GET("/groups/:id/songs", handlers.GetGroupSongs(groupsService))
+	r.POST("/groups", handlers.AddGroup(groupsService))
+	r.PUT("/groups/:id", handlers.RenameGroup(groupsService))
+	r.DELETE("/groups/:id", handlers.DeleteGroup(groupsService))
+	r.POST("/groups/:id/merge", handlers.MergeGroups(groupsService))
+	r.GET("/groups/:id/aliases", handlers.GetGroupAliases(groupsService))
+	r.POST("/groups/:id/aliases", handlers.AddGroupAlias(groupsService))
+	r.DELETE("/groups/:id/aliases/:alias", handlers.DeleteGroupAlias(groupsService))
+
+	r.GET("/albums", handlers.GetAlbums(albumsService))
+	r.GET("/albums/:id", handlers.GetAlbum(albumsService))
+	r.GET("/albums/:id/tracks", handlers.GetAlbumTracks(albumsService))
+	r.POST("/albums", handlers.AddAlbum(albumsService))
+	r.PUT("/albums/:id", handlers.UpdateAlbum(albumsService))
+	r.DELETE("/albums/:id", handlers.DeleteAlbum(albumsService))
+
+	r.GET("/tags", handlers.GetTags(tagsService))
+
+	r.GET("/audit", handlers.GetAuditEvents(auditService))
+
+	r.GET("/admin/music-info/breaker", handlers.GetMusicInfoBreaker(musicInfo))
+	r.GET("/admin/music-info/cache", handlers.GetInfoCache(infoCacheService))
+	r.DELETE("/admin/music-info/cache", handlers.PurgeInfoCache(infoCacheService))
+	r.GET("/admin/metadata-refresh/runs", handlers.GetMetadataRefreshRuns(metadataRefreshService))
+	r.POST("/admin/metadata-refresh/runs", handlers.RunMetadataRefresh(metadataRefreshService))
+	r.GET("/admin/metadata-refresh/runs/:id", handlers.GetMetadataRefreshRun(metadataRefreshService))
 
 	r.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 }
@@ -59,7 +167,9 @@ func main() {
 
 	migrateDB(config.ToDSN())
 
-	ctx := context.Background()
+	// Background work and the server stop on SIGINT or SIGTERM.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 
 	pool, err := pgxpool.New(ctx, config.ToDSN())
 	if err != nil {
@@ -69,23 +179,113 @@ func main() {
 
 	logrus.Info("Database connection pool created")
 
-	externalClient, err := client.NewClientWithResponses(config.APIEndpoint)
+	generatedClient, err := client.NewClientWithResponses(config.APIEndpoint)
 	if err != nil {
 		logrus.Fatal("Creating external client: ", err)
 	}
 
+	resilientClient := musicinfo.NewResilientClient(generatedClient, musicinfo.OptionsFromConfig(config))
+
 	songsRepo := database.NewSongsPoolRepository(pool)
 	groupsRepo := database.NewGroupsPoolRepository(pool)
+	albumsRepo := database.NewAlbumsPoolRepository(pool)
+	tagsRepo := database.NewTagsPoolRepository(pool)
+	lyricsRepo := database.NewLyricsPoolRepository(pool)
+	annotationsRepo := database.NewAnnotationsPoolRepository(pool)
+	translationsRepo := database.NewTranslationsPoolRepository(pool)
+	auditRepo := database.NewAuditPoolRepository(pool)
+	transactor := database.NewPoolTransactor(pool)
+	infoCacheRepo := database.NewInfoCachePoolRepository(pool)
+	cachePolicy := musicinfo.CachePolicyFromConfig(config)
+	externalClient := musicinfo.NewCachingClient(resilientClient, infoCacheRepo, cachePolicy)
+
+	metadataProvider, err := newMetadataProvider(config, externalClient)
+	if err != nil {
+		logrus.Fatal("Creating metadata providers: ", err)
+	}
+
 	service := application.NewSongsService(
 		songsRepo,
 		groupsRepo,
-		externalClient,
+		albumsRepo,
+		lyricsRepo,
+		annotationsRepo,
+		translationsRepo,
+		metadataProvider,
+		transactor,
+		auditRepo,
+	)
+	groupsService := application.NewGroupsService(groupsRepo, songsRepo, transactor, auditRepo)
+	albumsService := application.NewAlbumsService(albumsRepo, groupsRepo, transactor, auditRepo)
+	tagsService := application.NewTagsService(tagsRepo, songsRepo, transactor, auditRepo)
+	lyricsService := application.NewLyricsService(lyricsRepo, transactor, auditRepo)
+	annotationsService := application.NewAnnotationsService(annotationsRepo, songsRepo, transactor, auditRepo)
+	translationsService := application.NewTranslationsService(translationsRepo, songsRepo, transactor, auditRepo)
+	infoCacheService := application.NewInfoCacheService(infoCacheRepo, cachePolicy)
+	auditService := application.NewAuditService(auditRepo)
+	metadataRefreshService := application.NewMetadataRefreshService(
+		database.NewMetadataRefreshPoolRepository(pool),
+		songsRepo,
+		metadataProvider,
+		transactor,
+		auditRepo,
+		application.RefreshOptions{
+			MaxAge:    config.MetadataRefreshMaxAge,
+			BatchSize: config.MetadataRefreshBatchSize,
+		},
 	)
 
+	if err := metadataRefreshService.FailInterruptedRuns(ctx); err != nil {
+		logrus.Fatal("Failing interrupted metadata refresh runs: ", err)
+	}
+
+	var background sync.WaitGroup
+
+	for _, run := range []func(context.Context){
+		application.NewEnrichmentWorker(service, config.EnrichmentWorkers, config.EnrichmentPollInterval,
+			config.EnrichmentLease).Run,
+		application.NewRefreshScheduler(metadataRefreshService, config.MetadataRefreshInterval).Run,
+		application.NewTrashPurger(service, config.TrashPurgeInterval, config.TrashRetention).Run,
+	} {
+		background.Add(1)
+
+		go func() {
+			defer background.Done()
+
+			run(ctx)
+		}()
+	}
+
 	r := gin.Default()
-	initRouting(r, service)
+	r.ContextWithFallback = true
+	r.Use(handlers.RequestContext())
+	initRouting(r, service, groupsService, albumsService, tagsService, lyricsService, annotationsService,
+		translationsService, resilientClient, infoCacheService, metadataRefreshService, auditService)
+
+	server := &http.Server{
+		Addr:              fmt.Sprintf(":%d", config.ServingPort),
+		Handler:           r,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		<-ctx.Done()
+
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			logrus.Error("Shutting down server: ", err)
+		}
+	}()
 
 	logrus.Info("Starting server on port ", config.ServingPort)
 
-	logrus.Error(r.Run(fmt.Sprintf(":%d", config.ServingPort)))
+	if err := server.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+		logrus.Error(err)
+		stop()
+	}
+
+	background.Wait()
+	logrus.Info("Server stopped")
 }
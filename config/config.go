@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/spf13/viper"
 )
@@ -14,6 +15,35 @@ type Config struct {
 	Username    string `mapstructure:"DB_USER"`
 	Password    string `mapstructure:"DB_PASSWORD"`
 	Name        string `mapstructure:"DB_NAME"`
+
+	// External API resilience, durations are given as "500ms", "2s" and so on.
+	APITimeout          time.Duration `mapstructure:"API_TIMEOUT"`
+	APIMaxRetries       int           `mapstructure:"API_MAX_RETRIES"`
+	APIBackoffBase      time.Duration `mapstructure:"API_BACKOFF_BASE"`
+	APIBackoffMax       time.Duration `mapstructure:"API_BACKOFF_MAX"`
+	APIBreakerThreshold int           `mapstructure:"API_BREAKER_THRESHOLD"`
+	APIBreakerCooldown  time.Duration `mapstructure:"API_BREAKER_COOLDOWN"`
+	APICacheTTL         time.Duration `mapstructure:"API_CACHE_TTL"`
+	APICacheNegativeTTL time.Duration `mapstructure:"API_CACHE_NEGATIVE_TTL"`
+
+	// Comma separated metadata providers asked in order, "music-info" and "catalog".
+	MetadataProviders   string `mapstructure:"METADATA_PROVIDERS"`
+	MetadataCatalogPath string `mapstructure:"METADATA_CATALOG_PATH"`
+
+	// Workers filling in songs added with ?async=true and how often they look for pending ones.
+	// A song is claimed by a worker for the lease, which has to outlast a lookup with its retries.
+	EnrichmentWorkers      int           `mapstructure:"ENRICHMENT_WORKERS"`
+	EnrichmentPollInterval time.Duration `mapstructure:"ENRICHMENT_POLL_INTERVAL"`
+	EnrichmentLease        time.Duration `mapstructure:"ENRICHMENT_LEASE"`
+
+	// Metadata refresh of songs enriched longer than the max age ago, a zero interval disables it.
+	MetadataRefreshInterval  time.Duration `mapstructure:"METADATA_REFRESH_INTERVAL"`
+	MetadataRefreshMaxAge    time.Duration `mapstructure:"METADATA_REFRESH_MAX_AGE"`
+	MetadataRefreshBatchSize int           `mapstructure:"METADATA_REFRESH_BATCH_SIZE"`
+
+	// Deleted songs stay in the trash for the retention, purged every interval. Zero keeps them.
+	TrashRetention     time.Duration `mapstructure:"TRASH_RETENTION"`
+	TrashPurgeInterval time.Duration `mapstructure:"TRASH_PURGE_INTERVAL"`
 }
 
 func (d *Config) ToDSN() string {
@@ -34,12 +64,30 @@ func NewConfigFromFile(name string) (cfg *Config, err error) {
 	v.SetConfigName(name)
 
 	v.SetDefault("SERVING_PORT", 8080)
-	v.SetDefault("API_ENDPOINT", "http://localhost:8081/info")
+	v.SetDefault("API_ENDPOINT", "http://localhost:8081")
 	v.SetDefault("DB_HOST", "localhost")
 	v.SetDefault("DB_PORT", 5432)
 	v.SetDefault("DB_USER", "postgres")
 	v.SetDefault("DB_PASSWORD", "password")
 	v.SetDefault("DB_NAME", "mydb")
+	v.SetDefault("API_TIMEOUT", "5s")
+	v.SetDefault("API_MAX_RETRIES", 3)
+	v.SetDefault("API_BACKOFF_BASE", "200ms")
+	v.SetDefault("API_BACKOFF_MAX", "2s")
+	v.SetDefault("API_BREAKER_THRESHOLD", 5)
+	v.SetDefault("API_BREAKER_COOLDOWN", "30s")
+	v.SetDefault("API_CACHE_TTL", "24h")
+	v.SetDefault("API_CACHE_NEGATIVE_TTL", "1h")
+	v.SetDefault("METADATA_PROVIDERS", "music-info")
+	v.SetDefault("METADATA_CATALOG_PATH", "")
+	v.SetDefault("ENRICHMENT_WORKERS", 4)
+	v.SetDefault("ENRICHMENT_POLL_INTERVAL", "1s")
+	v.SetDefault("ENRICHMENT_LEASE", "5m")
+	v.SetDefault("METADATA_REFRESH_INTERVAL", "1h")
+	v.SetDefault("METADATA_REFRESH_MAX_AGE", "720h")
+	v.SetDefault("METADATA_REFRESH_BATCH_SIZE", 100)
+	v.SetDefault("TRASH_RETENTION", "720h")
+	v.SetDefault("TRASH_PURGE_INTERVAL", "1h")
 
 	v.AutomaticEnv()
 
@@ -16,8 +16,17 @@ import (
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// SongAlbum defines model for SongAlbum.
+type SongAlbum struct {
+	CoverLink   *string             `json:"coverLink,omitempty"`
+	ReleaseDate *openapi_types.Date `json:"releaseDate,omitempty"`
+	Title       string              `json:"title"`
+	TrackNumber *int                `json:"trackNumber,omitempty"`
+}
+
 // SongDetail defines model for SongDetail.
 type SongDetail struct {
+	Album       *SongAlbum         `json:"album,omitempty"`
 	Link        string             `json:"link"`
 	ReleaseDate openapi_types.Date `json:"releaseDate"`
 	Text        string             `json:"text"`
@@ -0,0 +1,156 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mashfeii/songs_library/internal/domain"
+	"github.com/mashfeii/songs_library/internal/infrastructure/database"
+	clientErrors "github.com/mashfeii/songs_library/internal/infrastructure/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type AlbumsServiceInterface interface {
+	GetAlbums(ctx context.Context, groupID int, pageReq domain.PageRequest) (*domain.Page[domain.Album], error)
+	GetAlbum(ctx context.Context, id int) (*domain.Album, error)
+	GetAlbumTracks(ctx context.Context, id int) ([]domain.Song, error)
+	AddAlbum(ctx context.Context, req *domain.AlbumRequest) (*domain.Album, error)
+	UpdateAlbum(ctx context.Context, id int, req *domain.AlbumRequest) (*domain.Album, error)
+	DeleteAlbum(ctx context.Context, id int) error
+}
+
+type AlbumsService struct {
+	albumsRepo database.AlbumsRepository
+	groupsRepo database.GroupsRepository
+	transactor database.Transactor
+	auditRepo  database.AuditRepository
+}
+
+func NewAlbumsService(
+	albumsRepo database.AlbumsRepository,
+	groupsRepo database.GroupsRepository,
+	transactor database.Transactor,
+	auditRepo database.AuditRepository,
+) *AlbumsService {
+	return &AlbumsService{
+		albumsRepo: albumsRepo,
+		groupsRepo: groupsRepo,
+		transactor: transactor,
+		auditRepo:  auditRepo,
+	}
+}
+
+func (s *AlbumsService) GetAlbums(ctx context.Context, groupID int, pageReq domain.PageRequest) (*domain.Page[domain.Album], error) {
+	page, err := s.albumsRepo.GetAlbums(ctx, groupID, pageReq)
+	if err != nil {
+		return nil, fmt.Errorf("getting albums: %w", err)
+	}
+
+	return page, nil
+}
+
+func (s *AlbumsService) GetAlbum(ctx context.Context, id int) (*domain.Album, error) {
+	return s.albumsRepo.GetAlbumByID(ctx, id)
+}
+
+func (s *AlbumsService) GetAlbumTracks(ctx context.Context, id int) ([]domain.Song, error) {
+	if _, err := s.albumsRepo.GetAlbumByID(ctx, id); err != nil {
+		return nil, err
+	}
+
+	tracks, err := s.albumsRepo.GetAlbumTracks(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("getting album tracks: %w", err)
+	}
+
+	return tracks, nil
+}
+
+func (s *AlbumsService) AddAlbum(ctx context.Context, req *domain.AlbumRequest) (*domain.Album, error) {
+	var album *domain.Album
+
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+
+		if album, err = s.albumFromRequest(ctx, req); err != nil {
+			return err
+		}
+
+		if album.ID, err = s.albumsRepo.AddAlbum(ctx, album); err != nil {
+			return err
+		}
+
+		return recordAudit(ctx, s.auditRepo, domain.AuditEntityAlbum, album.ID, domain.AuditActionCreate, nil, album)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logrus.WithField("id", album.ID).Info("New album added to the database")
+
+	return album, nil
+}
+
+func (s *AlbumsService) UpdateAlbum(ctx context.Context, id int, req *domain.AlbumRequest) (*domain.Album, error) {
+	var album *domain.Album
+
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		before, err := s.albumsRepo.GetAlbumByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if album, err = s.albumFromRequest(ctx, req); err != nil {
+			return err
+		}
+
+		album.ID = id
+
+		if err := s.albumsRepo.UpdateAlbum(ctx, album); err != nil {
+			return err
+		}
+
+		return recordAudit(ctx, s.auditRepo, domain.AuditEntityAlbum, id, domain.AuditActionUpdate, before, album)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return album, nil
+}
+
+func (s *AlbumsService) DeleteAlbum(ctx context.Context, id int) error {
+	return s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		before, err := s.albumsRepo.GetAlbumByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := s.albumsRepo.DeleteAlbum(ctx, id); err != nil {
+			return err
+		}
+
+		return recordAudit(ctx, s.auditRepo, domain.AuditEntityAlbum, id, domain.AuditActionDelete, before, nil)
+	})
+}
+
+func (s *AlbumsService) albumFromRequest(ctx context.Context, req *domain.AlbumRequest) (*domain.Album, error) {
+	title := strings.TrimSpace(req.Title)
+	if title == "" {
+		return nil, clientErrors.NewErrInvalidInput("title")
+	}
+
+	groupID, err := s.groupsRepo.UpsertGroup(ctx, req.Group)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.Album{
+		GroupID:     groupID,
+		Group:       req.Group,
+		Title:       title,
+		ReleaseDate: req.ReleaseDate,
+		CoverLink:   req.CoverLink,
+	}, nil
+}
@@ -0,0 +1,110 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mashfeii/songs_library/internal/application"
+	"github.com/mashfeii/songs_library/internal/domain"
+	"github.com/mashfeii/songs_library/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	clientErrors "github.com/mashfeii/songs_library/internal/infrastructure/errors"
+)
+
+func TestAlbumsService_AddAlbum(t *testing.T) {
+	mockAlbumsRepo := mocks.NewAlbumsRepositoryMock(t)
+	mockGroupsRepo := mocks.NewGroupsRepositoryMock(t)
+
+	audit := &auditRecorder{}
+	service := application.NewAlbumsService(mockAlbumsRepo, mockGroupsRepo, &txRecorder{}, audit)
+
+	t.Run("Success", func(t *testing.T) {
+		mockGroupsRepo.On("UpsertGroup", inTx, "Muse").Return(1, nil).Once()
+		mockAlbumsRepo.On("AddAlbum", inTx, mock.MatchedBy(func(album *domain.Album) bool {
+			return album.GroupID == 1 && album.Title == "Absolution"
+		})).Return(4, nil).Once()
+
+		album, err := service.AddAlbum(context.Background(), &domain.AlbumRequest{Group: "Muse", Title: " Absolution "})
+		assert.NoError(t, err)
+		assert.Equal(t, 4, album.ID)
+		assert.Equal(t, "Absolution", album.Title)
+
+		if assert.Len(t, audit.events, 1) {
+			event := audit.events[0]
+			assert.Equal(t, domain.AuditActionCreate, event.Action)
+			assert.Equal(t, domain.AuditEntityAlbum, event.Entity)
+			assert.Equal(t, 4, *event.EntityID)
+			assert.Nil(t, event.Before)
+			assert.NotNil(t, event.After)
+		}
+
+		assert.Zero(t, audit.outsideTx)
+	})
+
+	t.Run("BlankTitle", func(t *testing.T) {
+		album, err := service.AddAlbum(context.Background(), &domain.AlbumRequest{Group: "Muse", Title: " "})
+		assert.Nil(t, album)
+		assert.True(t, errors.As(err, &clientErrors.ErrInvalidInput{}))
+	})
+}
+
+func TestAlbumsService_DeleteAlbum(t *testing.T) {
+	mockAlbumsRepo := mocks.NewAlbumsRepositoryMock(t)
+
+	audit := &auditRecorder{}
+	service := application.NewAlbumsService(mockAlbumsRepo, nil, &txRecorder{}, audit)
+
+	t.Run("Success", func(t *testing.T) {
+		mockAlbumsRepo.On("GetAlbumByID", inTx, 4).Return(&domain.Album{ID: 4, GroupID: 1, Title: "Absolution"}, nil).Once()
+		mockAlbumsRepo.On("DeleteAlbum", inTx, 4).Return(nil).Once()
+
+		err := service.DeleteAlbum(context.Background(), 4)
+		assert.NoError(t, err)
+
+		if assert.Len(t, audit.events, 1) {
+			assert.Equal(t, domain.AuditActionDelete, audit.events[0].Action)
+			assert.Equal(t, domain.AuditEntityAlbum, audit.events[0].Entity)
+			assert.NotNil(t, audit.events[0].Before)
+			assert.Nil(t, audit.events[0].After)
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mockAlbumsRepo.On("GetAlbumByID", inTx, 9).Return(nil, clientErrors.NewErrNotFound("album")).Once()
+
+		err := service.DeleteAlbum(context.Background(), 9)
+		assert.True(t, errors.As(err, &clientErrors.ErrNotFound{}))
+		assert.Len(t, audit.events, 1)
+	})
+}
+
+func TestAlbumsService_GetAlbumTracks(t *testing.T) {
+	mockAlbumsRepo := mocks.NewAlbumsRepositoryMock(t)
+
+	service := application.NewAlbumsService(mockAlbumsRepo, nil, &txRecorder{}, &auditRecorder{})
+
+	t.Run("Success", func(t *testing.T) {
+		first, second := 1, 2
+
+		mockAlbumsRepo.On("GetAlbumByID", mock.Anything, 4).Return(&domain.Album{ID: 4}, nil).Once()
+		mockAlbumsRepo.On("GetAlbumTracks", mock.Anything, 4).Return([]domain.Song{
+			{ID: 8, TrackNumber: &first},
+			{ID: 5, TrackNumber: &second},
+		}, nil).Once()
+
+		tracks, err := service.GetAlbumTracks(context.Background(), 4)
+		assert.NoError(t, err)
+		assert.Len(t, tracks, 2)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mockAlbumsRepo.On("GetAlbumByID", mock.Anything, 9).Return(nil, clientErrors.NewErrNotFound("album")).Once()
+
+		tracks, err := service.GetAlbumTracks(context.Background(), 9)
+		assert.Nil(t, tracks)
+		assert.True(t, errors.As(err, &clientErrors.ErrNotFound{}))
+	})
+}
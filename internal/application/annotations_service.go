@@ -0,0 +1,180 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mashfeii/songs_library/internal/domain"
+	"github.com/mashfeii/songs_library/internal/infrastructure/database"
+	clientErrors "github.com/mashfeii/songs_library/internal/infrastructure/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type AnnotationsServiceInterface interface {
+	GetAnnotations(ctx context.Context, songID int) ([]domain.Annotation, error)
+	GetAnnotation(ctx context.Context, songID, id int) (*domain.Annotation, error)
+	AddAnnotation(ctx context.Context, songID int, req *domain.AnnotationRequest) (*domain.Annotation, error)
+	UpdateAnnotation(ctx context.Context, songID, id int, req *domain.AnnotationRequest) (*domain.Annotation, error)
+	DeleteAnnotation(ctx context.Context, songID, id int) error
+}
+
+type AnnotationsService struct {
+	annotationsRepo database.AnnotationsRepository
+	songsRepo       database.SongsRepository
+	transactor      database.Transactor
+	auditRepo       database.AuditRepository
+}
+
+func NewAnnotationsService(
+	annotationsRepo database.AnnotationsRepository,
+	songsRepo database.SongsRepository,
+	transactor database.Transactor,
+	auditRepo database.AuditRepository,
+) *AnnotationsService {
+	return &AnnotationsService{
+		annotationsRepo: annotationsRepo,
+		songsRepo:       songsRepo,
+		transactor:      transactor,
+		auditRepo:       auditRepo,
+	}
+}
+
+func (s *AnnotationsService) GetAnnotations(ctx context.Context, songID int) ([]domain.Annotation, error) {
+	if _, err := s.songsRepo.GetSongByID(ctx, songID); err != nil {
+		return nil, err
+	}
+
+	return s.annotationsRepo.GetAnnotations(ctx, songID)
+}
+
+func (s *AnnotationsService) GetAnnotation(ctx context.Context, songID, id int) (*domain.Annotation, error) {
+	return s.annotationsRepo.GetAnnotation(ctx, songID, id)
+}
+
+// AddAnnotation anchors a new annotation to the current lines of the song.
+func (s *AnnotationsService) AddAnnotation(
+	ctx context.Context,
+	songID int,
+	req *domain.AnnotationRequest,
+) (*domain.Annotation, error) {
+	annotation, err := s.anchor(ctx, songID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	annotation.Author = domain.ActorFrom(ctx)
+
+	err = s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.annotationsRepo.AddAnnotation(ctx, annotation); err != nil {
+			return err
+		}
+
+		return recordAudit(ctx, s.auditRepo, domain.AuditEntitySong, songID, domain.AuditActionAddAnnotation, nil, annotation)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"song_id": songID,
+		"id":      annotation.ID,
+	}).Info("Annotation added")
+
+	return annotation, nil
+}
+
+// UpdateAnnotation replaces an annotation and anchors it again to the current lines of the
+// song, clearing its stale flag.
+func (s *AnnotationsService) UpdateAnnotation(
+	ctx context.Context,
+	songID, id int,
+	req *domain.AnnotationRequest,
+) (*domain.Annotation, error) {
+	annotation, err := s.anchor(ctx, songID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	annotation.ID = id
+
+	err = s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		before, err := s.annotationsRepo.GetAnnotation(ctx, songID, id)
+		if err != nil {
+			return err
+		}
+
+		if err := s.annotationsRepo.UpdateAnnotation(ctx, annotation); err != nil {
+			return err
+		}
+
+		return recordAudit(ctx, s.auditRepo, domain.AuditEntitySong, songID, domain.AuditActionUpdateAnnotation, before, annotation)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return annotation, nil
+}
+
+func (s *AnnotationsService) DeleteAnnotation(ctx context.Context, songID, id int) error {
+	return s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		before, err := s.annotationsRepo.GetAnnotation(ctx, songID, id)
+		if err != nil {
+			return err
+		}
+
+		if err := s.annotationsRepo.DeleteAnnotation(ctx, songID, id); err != nil {
+			return err
+		}
+
+		return recordAudit(ctx, s.auditRepo, domain.AuditEntitySong, songID, domain.AuditActionDeleteAnnotation, before, nil)
+	})
+}
+
+func (s *AnnotationsService) anchor(
+	ctx context.Context,
+	songID int,
+	req *domain.AnnotationRequest,
+) (*domain.Annotation, error) {
+	body := strings.TrimSpace(req.Body)
+	if body == "" {
+		return nil, clientErrors.NewErrInvalidInput("body")
+	}
+
+	end := req.EndLine
+	if end == 0 {
+		end = req.StartLine
+	}
+
+	if req.StartLine < 1 {
+		return nil, clientErrors.NewErrInvalidInput("startLine")
+	}
+
+	if end < req.StartLine {
+		return nil, clientErrors.NewErrInvalidInput("endLine")
+	}
+
+	song, err := s.songsRepo.GetSongByID(ctx, songID)
+	if err != nil {
+		if errors.As(err, &clientErrors.ErrNotFound{}) {
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("getting song: %w", err)
+	}
+
+	anchor, ok := domain.AnchorLines(song.Text, req.StartLine, end)
+	if !ok {
+		return nil, clientErrors.NewErrInvalidInput("endLine")
+	}
+
+	return &domain.Annotation{
+		SongID:    songID,
+		StartLine: req.StartLine,
+		EndLine:   end,
+		Body:      body,
+		Anchor:    anchor,
+	}, nil
+}
@@ -0,0 +1,128 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mashfeii/songs_library/internal/application"
+	"github.com/mashfeii/songs_library/internal/domain"
+	"github.com/mashfeii/songs_library/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	clientErrors "github.com/mashfeii/songs_library/internal/infrastructure/errors"
+)
+
+func TestAnnotationsService_AddAnnotation(t *testing.T) {
+	mockAnnotationsRepo := mocks.NewAnnotationsRepositoryMock(t)
+	mockSongsRepo := mocks.NewSongsRepositoryMock(t)
+
+	audit := &auditRecorder{}
+	service := application.NewAnnotationsService(mockAnnotationsRepo, mockSongsRepo, &txRecorder{}, audit)
+	song := &domain.Song{ID: 1, Text: "[Verse 1]\r\nFirst line\r\nSecond line\r\n\r\nThird line"}
+
+	t.Run("Success", func(t *testing.T) {
+		mockSongsRepo.On("GetSongByID", mock.Anything, 1).Return(song, nil).Once()
+		mockAnnotationsRepo.On("AddAnnotation", inTx, &domain.Annotation{
+			SongID:    1,
+			StartLine: 2,
+			EndLine:   3,
+			Body:      "About the first verse",
+			Anchor:    "First line\nSecond line",
+			Author:    "editor",
+		}).Return(nil).Once()
+
+		ctx := domain.WithActor(context.Background(), "editor")
+		annotation, err := service.AddAnnotation(ctx, 1, &domain.AnnotationRequest{
+			StartLine: 2,
+			EndLine:   3,
+			Body:      " About the first verse ",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "First line\nSecond line", annotation.Anchor)
+
+		if assert.Len(t, audit.events, 1) {
+			event := audit.events[0]
+			assert.Equal(t, "editor", event.Actor)
+			assert.Equal(t, domain.AuditActionAddAnnotation, event.Action)
+			assert.Equal(t, domain.AuditEntitySong, event.Entity)
+			assert.Equal(t, 1, *event.EntityID)
+			assert.Nil(t, event.Before)
+			assert.NotNil(t, event.After)
+		}
+
+		assert.Zero(t, audit.outsideTx)
+	})
+
+	t.Run("SingleLine", func(t *testing.T) {
+		mockSongsRepo.On("GetSongByID", mock.Anything, 1).Return(song, nil).Once()
+		mockAnnotationsRepo.On("AddAnnotation", inTx, mock.MatchedBy(func(annotation *domain.Annotation) bool {
+			return annotation.EndLine == 5 && annotation.Anchor == "Third line"
+		})).Return(nil).Once()
+
+		_, err := service.AddAnnotation(context.Background(), 1, &domain.AnnotationRequest{StartLine: 5, Body: "Last"})
+		assert.NoError(t, err)
+	})
+
+	t.Run("OutOfRange", func(t *testing.T) {
+		mockSongsRepo.On("GetSongByID", mock.Anything, 1).Return(song, nil).Once()
+
+		annotation, err := service.AddAnnotation(context.Background(), 1, &domain.AnnotationRequest{
+			StartLine: 4,
+			EndLine:   6,
+			Body:      "Too far",
+		})
+		assert.Nil(t, annotation)
+		assert.True(t, errors.As(err, &clientErrors.ErrInvalidInput{}))
+	})
+
+	t.Run("InvertedRange", func(t *testing.T) {
+		annotation, err := service.AddAnnotation(context.Background(), 1, &domain.AnnotationRequest{
+			StartLine: 3,
+			EndLine:   2,
+			Body:      "Backwards",
+		})
+		assert.Nil(t, annotation)
+		assert.Equal(t, clientErrors.NewErrInvalidInput("endLine"), err)
+	})
+
+	t.Run("SongNotFound", func(t *testing.T) {
+		mockSongsRepo.On("GetSongByID", mock.Anything, 2).
+			Return(nil, clientErrors.NewErrNotFound("song with id: 2")).Once()
+
+		_, err := service.AddAnnotation(context.Background(), 2, &domain.AnnotationRequest{StartLine: 1, Body: "Missing"})
+		assert.True(t, errors.As(err, &clientErrors.ErrNotFound{}))
+	})
+}
+
+func TestAnnotationsService_DeleteAnnotation(t *testing.T) {
+	mockAnnotationsRepo := mocks.NewAnnotationsRepositoryMock(t)
+
+	audit := &auditRecorder{}
+	service := application.NewAnnotationsService(mockAnnotationsRepo, nil, &txRecorder{}, audit)
+
+	t.Run("Success", func(t *testing.T) {
+		mockAnnotationsRepo.On("GetAnnotation", inTx, 1, 3).
+			Return(&domain.Annotation{ID: 3, SongID: 1, StartLine: 2, EndLine: 2, Body: "About it"}, nil).Once()
+		mockAnnotationsRepo.On("DeleteAnnotation", inTx, 1, 3).Return(nil).Once()
+
+		err := service.DeleteAnnotation(context.Background(), 1, 3)
+		assert.NoError(t, err)
+
+		if assert.Len(t, audit.events, 1) {
+			assert.Equal(t, domain.AuditActionDeleteAnnotation, audit.events[0].Action)
+			assert.Equal(t, 1, *audit.events[0].EntityID)
+			assert.NotNil(t, audit.events[0].Before)
+			assert.Nil(t, audit.events[0].After)
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mockAnnotationsRepo.On("GetAnnotation", inTx, 1, 9).Return(nil, clientErrors.NewErrNotFound("annotation")).Once()
+
+		err := service.DeleteAnnotation(context.Background(), 1, 9)
+		assert.True(t, errors.As(err, &clientErrors.ErrNotFound{}))
+		assert.Len(t, audit.events, 1)
+	})
+}
@@ -0,0 +1,96 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/mashfeii/songs_library/internal/domain"
+	"github.com/mashfeii/songs_library/internal/infrastructure/database"
+	clientErrors "github.com/mashfeii/songs_library/internal/infrastructure/errors"
+)
+
+const (
+	// enrichmentActor is the actor of changes made by the enrichment worker.
+	enrichmentActor = "enrichment"
+	// trashPurgeActor is the actor of songs purged from the trash.
+	trashPurgeActor = "trash-purge"
+)
+
+type AuditServiceInterface interface {
+	GetAuditEvents(
+		ctx context.Context,
+		filter domain.AuditFilter,
+		pageReq domain.PageRequest,
+	) (*domain.Page[domain.AuditEvent], error)
+}
+
+type AuditService struct {
+	auditRepo database.AuditRepository
+}
+
+func NewAuditService(auditRepo database.AuditRepository) *AuditService {
+	return &AuditService{auditRepo: auditRepo}
+}
+
+func (s *AuditService) GetAuditEvents(
+	ctx context.Context,
+	filter domain.AuditFilter,
+	pageReq domain.PageRequest,
+) (*domain.Page[domain.AuditEvent], error) {
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return nil, clientErrors.NewErrInvalidInput("to")
+	}
+
+	page, err := s.auditRepo.GetAuditEvents(ctx, filter, pageReq)
+	if err != nil {
+		return nil, fmt.Errorf("getting audit events: %w", err)
+	}
+
+	return page, nil
+}
+
+// recordAudit stores an audit event of a change made by the actor of ctx, in the transaction
+// of the change when ctx carries one. Nil snapshots stand for entities that did not exist
+// before or after the change.
+func recordAudit(
+	ctx context.Context,
+	auditRepo database.AuditRepository,
+	entity string,
+	entityID int,
+	action string,
+	before, after any,
+) error {
+	event := domain.AuditEvent{
+		Actor:     domain.ActorFrom(ctx),
+		Action:    action,
+		Entity:    entity,
+		EntityID:  &entityID,
+		RequestID: domain.RequestIDFrom(ctx),
+	}
+
+	var err error
+
+	if event.Before, err = auditSnapshot(before); err != nil {
+		return err
+	}
+
+	if event.After, err = auditSnapshot(after); err != nil {
+		return err
+	}
+
+	return auditRepo.AddAuditEvent(ctx, &event)
+}
+
+func auditSnapshot(state any) (json.RawMessage, error) {
+	encoded, err := json.Marshal(state)
+	if err != nil {
+		return nil, fmt.Errorf("encoding audit snapshot: %w", err)
+	}
+
+	if string(encoded) == "null" {
+		return nil, nil
+	}
+
+	return encoded, nil
+}
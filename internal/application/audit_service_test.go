@@ -0,0 +1,43 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mashfeii/songs_library/internal/application"
+	"github.com/mashfeii/songs_library/internal/domain"
+	"github.com/mashfeii/songs_library/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	clientErrors "github.com/mashfeii/songs_library/internal/infrastructure/errors"
+)
+
+func TestAuditService_GetAuditEvents(t *testing.T) {
+	mockAuditRepo := mocks.NewAuditRepositoryMock(t)
+
+	service := application.NewAuditService(mockAuditRepo)
+	pageReq := domain.PageRequest{Page: 1, Size: 10}
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+
+	t.Run("Success", func(t *testing.T) {
+		filter := domain.AuditFilter{Entity: domain.AuditEntitySong, Actor: "alice", From: &from, To: &to}
+
+		mockAuditRepo.On("GetAuditEvents", mock.Anything, filter, pageReq).Return(&domain.Page[domain.AuditEvent]{
+			Items: []domain.AuditEvent{{ID: 1, Actor: "alice", Action: domain.AuditActionUpdate}},
+			Total: 1,
+		}, nil).Once()
+
+		events, err := service.GetAuditEvents(context.Background(), filter, pageReq)
+		assert.NoError(t, err)
+		assert.Len(t, events.Items, 1)
+	})
+
+	t.Run("EmptyTimeRange", func(t *testing.T) {
+		events, err := service.GetAuditEvents(context.Background(), domain.AuditFilter{From: &to, To: &from}, pageReq)
+		assert.Nil(t, events)
+		assert.Equal(t, clientErrors.NewErrInvalidInput("to"), err)
+	})
+}
@@ -0,0 +1,77 @@
+package application
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// EnrichmentWorker runs a pool of workers filling in pending songs. Each worker takes songs
+// until none is pending and then polls again after the interval. Pending songs live in
+// Postgres, so the songs queued before a restart are picked up when the workers start, and
+// songs left processing by a stopped worker are taken again once their lease expires.
+type EnrichmentWorker struct {
+	service  SongsServiceInterface
+	workers  int
+	interval time.Duration
+	lease    time.Duration
+}
+
+func NewEnrichmentWorker(service SongsServiceInterface, workers int, interval, lease time.Duration) *EnrichmentWorker {
+	return &EnrichmentWorker{
+		service:  service,
+		workers:  max(workers, 1),
+		interval: interval,
+		lease:    lease,
+	}
+}
+
+// Run blocks until ctx is done and every worker has stopped.
+func (w *EnrichmentWorker) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+
+	for worker := range w.workers {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			w.work(ctx, worker)
+		}()
+	}
+
+	logrus.WithField("workers", w.workers).Info("Song enrichment workers started")
+
+	wg.Wait()
+}
+
+func (w *EnrichmentWorker) work(ctx context.Context, worker int) {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+
+		for ctx.Err() == nil {
+			enriched, err := w.service.EnrichPendingSong(ctx, w.lease)
+			if err != nil {
+				logrus.WithFields(logrus.Fields{
+					"error":  err,
+					"worker": worker,
+				}).Error("Failed to enrich pending song")
+			}
+
+			if err != nil || !enriched {
+				break
+			}
+		}
+
+		timer.Reset(w.interval)
+	}
+}
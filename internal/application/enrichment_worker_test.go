@@ -0,0 +1,42 @@
+package application_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/mashfeii/songs_library/internal/application"
+	"github.com/mashfeii/songs_library/internal/mocks"
+	"github.com/stretchr/testify/mock"
+
+	clientErrors "github.com/mashfeii/songs_library/internal/infrastructure/errors"
+)
+
+func TestEnrichmentWorker_Run(t *testing.T) {
+	mockService := mocks.NewSongsServiceInterfaceMock(t)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// The worker drains pending songs, keeps polling after a failure and stops once idle.
+	mockService.On("EnrichPendingSong", mock.Anything, time.Minute).Return(true, nil).Twice()
+	mockService.On("EnrichPendingSong", mock.Anything, time.Minute).Return(false, nil).Once()
+	mockService.On("EnrichPendingSong", mock.Anything, time.Minute).
+		Return(false, fmt.Errorf("claiming pending song: %w", clientErrors.NewErrDatabase())).Once()
+	mockService.On("EnrichPendingSong", mock.Anything, time.Minute).Return(false, nil).Run(func(mock.Arguments) {
+		cancel()
+	}).Once()
+
+	done := make(chan struct{})
+
+	go func() {
+		application.NewEnrichmentWorker(mockService, 1, time.Millisecond, time.Minute).Run(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		cancel()
+		t.Fatal("worker did not stop")
+	}
+}
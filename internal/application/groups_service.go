@@ -0,0 +1,233 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/mashfeii/songs_library/internal/domain"
+	"github.com/mashfeii/songs_library/internal/infrastructure/database"
+	clientErrors "github.com/mashfeii/songs_library/internal/infrastructure/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type GroupsServiceInterface interface {
+	GetGroups(ctx context.Context, pageReq domain.PageRequest) (*domain.Page[domain.Group], error)
+	GetGroup(ctx context.Context, id int) (*domain.Group, error)
+	GetGroupSongs(ctx context.Context, id int, pageReq domain.PageRequest) (*domain.Page[domain.Song], error)
+	AddGroup(ctx context.Context, name string) (*domain.Group, error)
+	RenameGroup(ctx context.Context, id int, name string) (*domain.Group, error)
+	DeleteGroup(ctx context.Context, id int, cascade bool) error
+	MergeGroups(ctx context.Context, targetID int, sourceIDs []int) (*domain.MergeGroupsResponse, error)
+	GetGroupAliases(ctx context.Context, id int) ([]string, error)
+	AddGroupAlias(ctx context.Context, id int, alias string) error
+	DeleteGroupAlias(ctx context.Context, id int, alias string) error
+}
+
+type GroupsService struct {
+	groupsRepo database.GroupsRepository
+	songsRepo  database.SongsRepository
+	transactor database.Transactor
+	auditRepo  database.AuditRepository
+}
+
+func NewGroupsService(
+	groupsRepo database.GroupsRepository,
+	songsRepo database.SongsRepository,
+	transactor database.Transactor,
+	auditRepo database.AuditRepository,
+) *GroupsService {
+	return &GroupsService{
+		groupsRepo: groupsRepo,
+		songsRepo:  songsRepo,
+		transactor: transactor,
+		auditRepo:  auditRepo,
+	}
+}
+
+func (s *GroupsService) GetGroups(ctx context.Context, pageReq domain.PageRequest) (*domain.Page[domain.Group], error) {
+	page, err := s.groupsRepo.GetGroups(ctx, pageReq)
+	if err != nil {
+		return nil, fmt.Errorf("getting groups: %w", err)
+	}
+
+	return page, nil
+}
+
+func (s *GroupsService) GetGroup(ctx context.Context, id int) (*domain.Group, error) {
+	return s.groupsRepo.GetGroupByID(ctx, id)
+}
+
+func (s *GroupsService) GetGroupSongs(ctx context.Context, id int, pageReq domain.PageRequest) (*domain.Page[domain.Song], error) {
+	if _, err := s.groupsRepo.GetGroupByID(ctx, id); err != nil {
+		return nil, err
+	}
+
+	page, err := s.songsRepo.GetSongs(ctx, &domain.SongFilter{GroupID: id, Match: domain.MatchExact}, pageReq)
+	if err != nil {
+		if errors.As(err, &clientErrors.ErrInvalidInput{}) {
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("getting group songs: %w", err)
+	}
+
+	return page, nil
+}
+
+func (s *GroupsService) AddGroup(ctx context.Context, name string) (*domain.Group, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, clientErrors.NewErrInvalidInput("name")
+	}
+
+	group := &domain.Group{Name: name}
+
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+
+		if group.ID, err = s.groupsRepo.AddGroup(ctx, name); err != nil {
+			return err
+		}
+
+		return recordAudit(ctx, s.auditRepo, domain.AuditEntityGroup, group.ID, domain.AuditActionCreate, nil, group)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logrus.WithField("id", group.ID).Info("New group added to the database")
+
+	return group, nil
+}
+
+func (s *GroupsService) RenameGroup(ctx context.Context, id int, name string) (*domain.Group, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, clientErrors.NewErrInvalidInput("name")
+	}
+
+	group := &domain.Group{ID: id, Name: name}
+
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		before, err := s.groupsRepo.GetGroupByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := s.groupsRepo.RenameGroup(ctx, id, name); err != nil {
+			return err
+		}
+
+		return recordAudit(ctx, s.auditRepo, domain.AuditEntityGroup, id, domain.AuditActionUpdate, before, group)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return group, nil
+}
+
+func (s *GroupsService) DeleteGroup(ctx context.Context, id int, cascade bool) error {
+	return s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		before, err := s.groupsRepo.GetGroupByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := s.groupsRepo.DeleteGroup(ctx, id, cascade); err != nil {
+			return err
+		}
+
+		return recordAudit(ctx, s.auditRepo, domain.AuditEntityGroup, id, domain.AuditActionDelete, before, nil)
+	})
+}
+
+func (s *GroupsService) MergeGroups(ctx context.Context, targetID int, sourceIDs []int) (*domain.MergeGroupsResponse, error) {
+	sources := make([]int, 0, len(sourceIDs))
+
+	for _, id := range sourceIDs {
+		if id != targetID && !slices.Contains(sources, id) {
+			sources = append(sources, id)
+		}
+	}
+
+	if len(sources) == 0 {
+		return nil, clientErrors.NewErrInvalidInput("sources")
+	}
+
+	var (
+		moved int
+		group *domain.Group
+	)
+
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		// The source groups are gone after the merge, the audit event keeps their names.
+		merged := make([]*domain.Group, 0, len(sources))
+
+		for _, id := range sources {
+			source, err := s.groupsRepo.GetGroupByID(ctx, id)
+			if err != nil {
+				return err
+			}
+
+			merged = append(merged, source)
+		}
+
+		var err error
+
+		if moved, err = s.groupsRepo.MergeGroups(ctx, targetID, sources); err != nil {
+			return err
+		}
+
+		if group, err = s.groupsRepo.GetGroupByID(ctx, targetID); err != nil {
+			return err
+		}
+
+		return recordAudit(ctx, s.auditRepo, domain.AuditEntityGroup, targetID, domain.AuditActionMerge,
+			map[string]any{"sources": merged}, map[string]any{"group": group, "moved_songs": moved})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"target":      targetID,
+		"sources":     sources,
+		"moved_songs": moved,
+	}).Info("Groups merged")
+
+	return &domain.MergeGroupsResponse{Group: *group, MovedSongs: moved}, nil
+}
+
+func (s *GroupsService) GetGroupAliases(ctx context.Context, id int) ([]string, error) {
+	return s.groupsRepo.GetGroupAliases(ctx, id)
+}
+
+func (s *GroupsService) AddGroupAlias(ctx context.Context, id int, alias string) error {
+	if strings.TrimSpace(alias) == "" {
+		return clientErrors.NewErrInvalidInput("alias")
+	}
+
+	return s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.groupsRepo.AddGroupAlias(ctx, id, alias); err != nil {
+			return err
+		}
+
+		return recordAudit(ctx, s.auditRepo, domain.AuditEntityGroup, id, domain.AuditActionAddAlias,
+			nil, map[string]string{"alias": alias})
+	})
+}
+
+func (s *GroupsService) DeleteGroupAlias(ctx context.Context, id int, alias string) error {
+	return s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.groupsRepo.DeleteGroupAlias(ctx, id, alias); err != nil {
+			return err
+		}
+
+		return recordAudit(ctx, s.auditRepo, domain.AuditEntityGroup, id, domain.AuditActionDeleteAlias,
+			map[string]string{"alias": alias}, nil)
+	})
+}
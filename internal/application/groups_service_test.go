@@ -0,0 +1,138 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mashfeii/songs_library/internal/application"
+	"github.com/mashfeii/songs_library/internal/domain"
+	"github.com/mashfeii/songs_library/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	clientErrors "github.com/mashfeii/songs_library/internal/infrastructure/errors"
+)
+
+func TestGroupsService_GetGroupSongs(t *testing.T) {
+	mockGroupsRepo := mocks.NewGroupsRepositoryMock(t)
+	mockSongsRepo := mocks.NewSongsRepositoryMock(t)
+
+	service := application.NewGroupsService(mockGroupsRepo, mockSongsRepo, &txRecorder{}, &auditRecorder{})
+	pageReq := domain.PageRequest{Page: 1, Size: 10}
+
+	t.Run("Success", func(t *testing.T) {
+		mockGroupsRepo.On("GetGroupByID", mock.Anything, 1).Return(&domain.Group{ID: 1, Name: "Muse"}, nil).Once()
+		mockSongsRepo.On("GetSongs", mock.Anything, mock.MatchedBy(func(filter *domain.SongFilter) bool {
+			return filter.GroupID == 1
+		}), pageReq).Return(&domain.Page[domain.Song]{Items: []domain.Song{{ID: 3, GroupID: 1}}, Total: 1}, nil).Once()
+
+		songs, err := service.GetGroupSongs(context.Background(), 1, pageReq)
+		assert.NoError(t, err)
+		assert.Len(t, songs.Items, 1)
+	})
+
+	t.Run("GroupNotFound", func(t *testing.T) {
+		mockGroupsRepo.On("GetGroupByID", mock.Anything, 2).Return(nil, clientErrors.NewErrNotFound("group")).Once()
+
+		songs, err := service.GetGroupSongs(context.Background(), 2, pageReq)
+		assert.Nil(t, songs)
+		assert.True(t, errors.As(err, &clientErrors.ErrNotFound{}))
+	})
+}
+
+func TestGroupsService_AddGroup(t *testing.T) {
+	mockGroupsRepo := mocks.NewGroupsRepositoryMock(t)
+
+	audit := &auditRecorder{}
+	service := application.NewGroupsService(mockGroupsRepo, nil, &txRecorder{}, audit)
+
+	t.Run("Success", func(t *testing.T) {
+		mockGroupsRepo.On("AddGroup", inTx, "Muse").Return(5, nil).Once()
+
+		group, err := service.AddGroup(domain.WithActor(context.Background(), "alice"), " Muse ")
+		assert.NoError(t, err)
+		assert.Equal(t, &domain.Group{ID: 5, Name: "Muse"}, group)
+
+		if assert.Len(t, audit.events, 1) {
+			event := audit.events[0]
+			assert.Equal(t, "alice", event.Actor)
+			assert.Equal(t, domain.AuditActionCreate, event.Action)
+			assert.Equal(t, domain.AuditEntityGroup, event.Entity)
+			assert.Equal(t, 5, *event.EntityID)
+			assert.Nil(t, event.Before)
+			assert.JSONEq(t, `{"id":5,"name":"Muse"}`, string(event.After))
+		}
+
+		assert.Zero(t, audit.outsideTx)
+	})
+
+	t.Run("EmptyName", func(t *testing.T) {
+		group, err := service.AddGroup(context.Background(), "  ")
+		assert.Nil(t, group)
+		assert.True(t, errors.As(err, &clientErrors.ErrInvalidInput{}))
+	})
+}
+
+func TestGroupsService_DeleteGroup(t *testing.T) {
+	mockGroupsRepo := mocks.NewGroupsRepositoryMock(t)
+
+	tx := &txRecorder{}
+	audit := &auditRecorder{}
+	service := application.NewGroupsService(mockGroupsRepo, nil, tx, audit)
+
+	t.Run("RefusedWithSongs", func(t *testing.T) {
+		mockGroupsRepo.On("GetGroupByID", inTx, 1).Return(&domain.Group{ID: 1, Name: "Muse"}, nil).Once()
+		mockGroupsRepo.On("DeleteGroup", inTx, 1, false).Return(clientErrors.NewErrConflict("group has songs")).Once()
+
+		err := service.DeleteGroup(context.Background(), 1, false)
+		assert.True(t, errors.As(err, &clientErrors.ErrConflict{}))
+		assert.Equal(t, 1, tx.rolledBack)
+		assert.Empty(t, audit.events)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		mockGroupsRepo.On("GetGroupByID", inTx, 1).Return(&domain.Group{ID: 1, Name: "Muse"}, nil).Once()
+		mockGroupsRepo.On("DeleteGroup", inTx, 1, true).Return(nil).Once()
+
+		err := service.DeleteGroup(context.Background(), 1, true)
+		assert.NoError(t, err)
+
+		if assert.Len(t, audit.events, 1) {
+			assert.Equal(t, domain.AuditActionDelete, audit.events[0].Action)
+			assert.JSONEq(t, `{"id":1,"name":"Muse"}`, string(audit.events[0].Before))
+			assert.Nil(t, audit.events[0].After)
+		}
+	})
+}
+
+func TestGroupsService_MergeGroups(t *testing.T) {
+	mockGroupsRepo := mocks.NewGroupsRepositoryMock(t)
+
+	audit := &auditRecorder{}
+	service := application.NewGroupsService(mockGroupsRepo, nil, &txRecorder{}, audit)
+
+	t.Run("Success", func(t *testing.T) {
+		mockGroupsRepo.On("GetGroupByID", inTx, 2).Return(&domain.Group{ID: 2, Name: "Beatles"}, nil).Once()
+		mockGroupsRepo.On("GetGroupByID", inTx, 3).Return(&domain.Group{ID: 3, Name: "The Beatles (UK)"}, nil).Once()
+		mockGroupsRepo.On("MergeGroups", inTx, 1, []int{2, 3}).Return(4, nil).Once()
+		mockGroupsRepo.On("GetGroupByID", inTx, 1).Return(&domain.Group{ID: 1, Name: "The Beatles"}, nil).Once()
+
+		result, err := service.MergeGroups(context.Background(), 1, []int{2, 1, 3, 2})
+		assert.NoError(t, err)
+		assert.Equal(t, &domain.MergeGroupsResponse{Group: domain.Group{ID: 1, Name: "The Beatles"}, MovedSongs: 4}, result)
+
+		if assert.Len(t, audit.events, 1) {
+			assert.Equal(t, domain.AuditActionMerge, audit.events[0].Action)
+			assert.JSONEq(t, `{"sources":[{"id":2,"name":"Beatles"},{"id":3,"name":"The Beatles (UK)"}]}`,
+				string(audit.events[0].Before))
+			assert.JSONEq(t, `{"group":{"id":1,"name":"The Beatles"},"moved_songs":4}`, string(audit.events[0].After))
+		}
+	})
+
+	t.Run("OnlyTarget", func(t *testing.T) {
+		result, err := service.MergeGroups(context.Background(), 1, []int{1})
+		assert.Nil(t, result)
+		assert.True(t, errors.As(err, &clientErrors.ErrInvalidInput{}))
+	})
+}
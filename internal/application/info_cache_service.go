@@ -0,0 +1,63 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/mashfeii/songs_library/internal/domain"
+	"github.com/mashfeii/songs_library/internal/infrastructure/database"
+	"github.com/sirupsen/logrus"
+)
+
+type InfoCacheServiceInterface interface {
+	GetEntries(
+		ctx context.Context,
+		filter domain.InfoCacheFilter,
+		pageReq domain.PageRequest,
+	) (*domain.Page[domain.InfoCacheEntry], error)
+	Purge(ctx context.Context, filter domain.InfoCacheFilter) (int, error)
+}
+
+type InfoCacheService struct {
+	cacheRepo database.InfoCacheRepository
+	policy    domain.InfoCachePolicy
+}
+
+func NewInfoCacheService(cacheRepo database.InfoCacheRepository, policy domain.InfoCachePolicy) *InfoCacheService {
+	return &InfoCacheService{cacheRepo: cacheRepo, policy: policy}
+}
+
+// GetEntries lists cached lookups with their expiry under the current policy.
+func (s *InfoCacheService) GetEntries(
+	ctx context.Context,
+	filter domain.InfoCacheFilter,
+	pageReq domain.PageRequest,
+) (*domain.Page[domain.InfoCacheEntry], error) {
+	page, err := s.cacheRepo.GetInfoCacheEntries(ctx, filter, s.policy, pageReq)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	for i := range page.Items {
+		s.policy.Annotate(&page.Items[i], now)
+	}
+
+	return page, nil
+}
+
+// Purge deletes the cached lookups matching the filter, every entry when it is empty.
+func (s *InfoCacheService) Purge(ctx context.Context, filter domain.InfoCacheFilter) (int, error) {
+	purged, err := s.cacheRepo.DeleteInfoCacheEntries(ctx, filter, s.policy)
+	if err != nil {
+		return 0, err
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"filter": filter,
+		"purged": purged,
+	}).Info("Info cache purged")
+
+	return purged, nil
+}
@@ -0,0 +1,109 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/mashfeii/songs_library/internal/application"
+	"github.com/mashfeii/songs_library/internal/domain"
+	"github.com/mashfeii/songs_library/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	clientErrors "github.com/mashfeii/songs_library/internal/infrastructure/errors"
+)
+
+func TestInfoCacheService_GetEntries(t *testing.T) {
+	mockCacheRepo := mocks.NewInfoCacheRepositoryMock(t)
+
+	policy := domain.InfoCachePolicy{TTL: 24 * time.Hour, NegativeTTL: time.Hour}
+	service := application.NewInfoCacheService(mockCacheRepo, policy)
+	pageReq := domain.PageRequest{Page: 1, Size: 10}
+
+	entries := func(items ...domain.InfoCacheEntry) *domain.Page[domain.InfoCacheEntry] {
+		return &domain.Page[domain.InfoCacheEntry]{Items: items, Total: len(items), Page: 1, Size: 10}
+	}
+
+	t.Run("Hit", func(t *testing.T) {
+		fetchedAt := time.Now().Add(-time.Hour)
+
+		mockCacheRepo.On("GetInfoCacheEntries", mock.Anything, domain.InfoCacheFilter{Group: "Muse"}, policy, pageReq).
+			Return(entries(domain.InfoCacheEntry{Group: "Muse", Song: "Starlight", Status: http.StatusOK, FetchedAt: fetchedAt}), nil).
+			Once()
+
+		page, err := service.GetEntries(context.Background(), domain.InfoCacheFilter{Group: "Muse"}, pageReq)
+		require.NoError(t, err)
+		require.Len(t, page.Items, 1)
+		assert.Equal(t, fetchedAt.Add(24*time.Hour), page.Items[0].ExpiresAt)
+		assert.False(t, page.Items[0].Expired)
+	})
+
+	t.Run("Miss", func(t *testing.T) {
+		mockCacheRepo.On("GetInfoCacheEntries", mock.Anything, domain.InfoCacheFilter{Song: "Unknown"}, policy, pageReq).
+			Return(entries(), nil).Once()
+
+		page, err := service.GetEntries(context.Background(), domain.InfoCacheFilter{Song: "Unknown"}, pageReq)
+		require.NoError(t, err)
+		assert.Empty(t, page.Items)
+	})
+
+	// Expired details stay stored, the caching client still serves them when the provider fails.
+	t.Run("StaleOnError", func(t *testing.T) {
+		fetchedAt := time.Now().Add(-48 * time.Hour)
+
+		mockCacheRepo.On("GetInfoCacheEntries", mock.Anything, domain.InfoCacheFilter{Expired: true}, policy, pageReq).
+			Return(entries(domain.InfoCacheEntry{Group: "Muse", Song: "Uprising", Status: http.StatusOK, FetchedAt: fetchedAt}), nil).
+			Once()
+
+		page, err := service.GetEntries(context.Background(), domain.InfoCacheFilter{Expired: true}, pageReq)
+		require.NoError(t, err)
+		require.Len(t, page.Items, 1)
+		assert.Equal(t, fetchedAt.Add(24*time.Hour), page.Items[0].ExpiresAt)
+		assert.True(t, page.Items[0].Expired)
+	})
+
+	t.Run("NegativeTTL", func(t *testing.T) {
+		fresh := time.Now().Add(-30 * time.Minute)
+		expired := time.Now().Add(-2 * time.Hour)
+
+		mockCacheRepo.On("GetInfoCacheEntries", mock.Anything, domain.InfoCacheFilter{}, policy, pageReq).
+			Return(entries(
+				domain.InfoCacheEntry{Group: "Muse", Song: "Unknown", Status: http.StatusNotFound, FetchedAt: fresh},
+				domain.InfoCacheEntry{Group: "Muse", Song: "Missing", Status: http.StatusNotFound, FetchedAt: expired},
+			), nil).Once()
+
+		page, err := service.GetEntries(context.Background(), domain.InfoCacheFilter{}, pageReq)
+		require.NoError(t, err)
+		require.Len(t, page.Items, 2)
+		assert.Equal(t, fresh.Add(time.Hour), page.Items[0].ExpiresAt)
+		assert.False(t, page.Items[0].Expired)
+		assert.True(t, page.Items[1].Expired)
+	})
+
+	t.Run("RepositoryError", func(t *testing.T) {
+		mockCacheRepo.On("GetInfoCacheEntries", mock.Anything, domain.InfoCacheFilter{Group: "Broken"}, policy, pageReq).
+			Return(nil, clientErrors.NewErrDatabase()).Once()
+
+		page, err := service.GetEntries(context.Background(), domain.InfoCacheFilter{Group: "Broken"}, pageReq)
+		assert.Nil(t, page)
+		assert.True(t, errors.As(err, &clientErrors.ErrDatabase{}))
+	})
+}
+
+func TestInfoCacheService_Purge(t *testing.T) {
+	mockCacheRepo := mocks.NewInfoCacheRepositoryMock(t)
+
+	policy := domain.InfoCachePolicy{TTL: 24 * time.Hour, NegativeTTL: time.Hour}
+	service := application.NewInfoCacheService(mockCacheRepo, policy)
+
+	mockCacheRepo.On("DeleteInfoCacheEntries", mock.Anything, domain.InfoCacheFilter{Expired: true}, policy).
+		Return(3, nil).Once()
+
+	purged, err := service.Purge(context.Background(), domain.InfoCacheFilter{Expired: true})
+	require.NoError(t, err)
+	assert.Equal(t, 3, purged)
+}
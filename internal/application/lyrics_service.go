@@ -0,0 +1,152 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/mashfeii/songs_library/internal/domain"
+	"github.com/mashfeii/songs_library/internal/infrastructure/database"
+	clientErrors "github.com/mashfeii/songs_library/internal/infrastructure/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type LyricsServiceInterface interface {
+	GetLyrics(ctx context.Context, songID int) (*domain.SyncedLyrics, error)
+	ImportLRC(ctx context.Context, songID int, data string) (*domain.SyncedLyrics, error)
+	SaveLyrics(ctx context.Context, lyrics *domain.SyncedLyrics) (*domain.SyncedLyrics, error)
+	DeleteLyrics(ctx context.Context, songID int) error
+	GetLyricsAt(ctx context.Context, songID, timeMs, next int) (*domain.LyricsPosition, error)
+}
+
+type LyricsService struct {
+	lyricsRepo database.LyricsRepository
+	transactor database.Transactor
+	auditRepo  database.AuditRepository
+}
+
+func NewLyricsService(
+	lyricsRepo database.LyricsRepository,
+	transactor database.Transactor,
+	auditRepo database.AuditRepository,
+) *LyricsService {
+	return &LyricsService{
+		lyricsRepo: lyricsRepo,
+		transactor: transactor,
+		auditRepo:  auditRepo,
+	}
+}
+
+func (s *LyricsService) GetLyrics(ctx context.Context, songID int) (*domain.SyncedLyrics, error) {
+	return s.lyricsRepo.GetSyncedLyrics(ctx, songID)
+}
+
+func (s *LyricsService) ImportLRC(ctx context.Context, songID int, data string) (*domain.SyncedLyrics, error) {
+	lyrics, err := domain.ParseLRC(data)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"error":   err,
+			"song_id": songID,
+		}).Warn("Failed to parse LRC lyrics")
+
+		return nil, clientErrors.NewErrInvalidInput("lyrics")
+	}
+
+	lyrics.SongID = songID
+
+	return s.save(ctx, lyrics)
+}
+
+// SaveLyrics stores lyrics given as JSON, lines are ordered by time and tag keys lowercased
+// the same way LRC imports are.
+func (s *LyricsService) SaveLyrics(ctx context.Context, lyrics *domain.SyncedLyrics) (*domain.SyncedLyrics, error) {
+	if len(lyrics.Lines) == 0 {
+		return nil, clientErrors.NewErrInvalidInput("lines")
+	}
+
+	for _, line := range lyrics.Lines {
+		if line.TimeMs < 0 || line.TimeMs > domain.MaxSyncedTimeMs {
+			return nil, clientErrors.NewErrInvalidInput("timeMs")
+		}
+	}
+
+	tags := make(map[string]string, len(lyrics.Tags))
+	for key, value := range lyrics.Tags {
+		tags[strings.ToLower(strings.TrimSpace(key))] = strings.TrimSpace(value)
+	}
+
+	lyrics.Tags = tags
+
+	sort.SliceStable(lyrics.Lines, func(i, j int) bool {
+		return lyrics.Lines[i].TimeMs < lyrics.Lines[j].TimeMs
+	})
+
+	return s.save(ctx, lyrics)
+}
+
+func (s *LyricsService) save(ctx context.Context, lyrics *domain.SyncedLyrics) (*domain.SyncedLyrics, error) {
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		before, err := syncedLyricsOf(ctx, s.lyricsRepo, lyrics.SongID)
+		if err != nil {
+			return err
+		}
+
+		if err := s.lyricsRepo.SaveSyncedLyrics(ctx, lyrics); err != nil {
+			return err
+		}
+
+		return recordAudit(ctx, s.auditRepo, domain.AuditEntitySong, lyrics.SongID, domain.AuditActionSetLyrics, before, lyrics)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"song_id": lyrics.SongID,
+		"lines":   len(lyrics.Lines),
+	}).Info("Synced lyrics saved")
+
+	return lyrics, nil
+}
+
+func (s *LyricsService) DeleteLyrics(ctx context.Context, songID int) error {
+	return s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		before, err := syncedLyricsOf(ctx, s.lyricsRepo, songID)
+		if err != nil {
+			return err
+		}
+
+		if err := s.lyricsRepo.DeleteSyncedLyrics(ctx, songID); err != nil {
+			return err
+		}
+
+		return recordAudit(ctx, s.auditRepo, domain.AuditEntitySong, songID, domain.AuditActionDeleteLyrics, before, nil)
+	})
+}
+
+// GetLyricsAt returns the line active at the playback position and the next lines.
+func (s *LyricsService) GetLyricsAt(ctx context.Context, songID, timeMs, next int) (*domain.LyricsPosition, error) {
+	if timeMs < 0 {
+		return nil, clientErrors.NewErrInvalidInput("t")
+	}
+
+	lyrics, err := s.lyricsRepo.GetSyncedLyrics(ctx, songID)
+	if err != nil {
+		return nil, err
+	}
+
+	position := lyrics.PositionAt(timeMs, next)
+
+	return &position, nil
+}
+
+// syncedLyricsOf returns the synced lyrics of a song or nil when the song has none.
+func syncedLyricsOf(ctx context.Context, repo database.LyricsRepository, songID int) (*domain.SyncedLyrics, error) {
+	lyrics, err := repo.GetSyncedLyrics(ctx, songID)
+	if errors.As(err, &clientErrors.ErrNotFound{}) {
+		return nil, nil
+	}
+
+	return lyrics, err
+}
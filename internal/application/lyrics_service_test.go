@@ -0,0 +1,162 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/mashfeii/songs_library/internal/application"
+	"github.com/mashfeii/songs_library/internal/domain"
+	"github.com/mashfeii/songs_library/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	clientErrors "github.com/mashfeii/songs_library/internal/infrastructure/errors"
+)
+
+func TestLyricsService_ImportLRC(t *testing.T) {
+	mockLyricsRepo := mocks.NewLyricsRepositoryMock(t)
+
+	audit := &auditRecorder{}
+	service := application.NewLyricsService(mockLyricsRepo, &txRecorder{}, audit)
+
+	t.Run("Success", func(t *testing.T) {
+		lrc := "[ti:Supermassive Black Hole]\n[ar:Muse]\n[offset:+500]\n\n" +
+			"[00:12.50]Ooh baby, don't you know I suffer?\n" +
+			"[00:20.00][01:23.40]Ooh baby, can you hear me moan?\n" +
+			"[00:15.7]You caught me under false pretenses\n"
+
+		mockLyricsRepo.On("GetSyncedLyrics", inTx, 1).Return(nil, clientErrors.NewErrNotFound("synced lyrics")).Once()
+		mockLyricsRepo.On("SaveSyncedLyrics", inTx, mock.MatchedBy(func(lyrics *domain.SyncedLyrics) bool {
+			return lyrics.SongID == 1 && len(lyrics.Lines) == 4
+		})).Return(nil).Once()
+
+		lyrics, err := service.ImportLRC(context.Background(), 1, lrc)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{"ti": "Supermassive Black Hole", "ar": "Muse"}, lyrics.Tags)
+		assert.Equal(t, []domain.SyncedLine{
+			{TimeMs: 12000, Text: "Ooh baby, don't you know I suffer?"},
+			{TimeMs: 15200, Text: "You caught me under false pretenses"},
+			{TimeMs: 19500, Text: "Ooh baby, can you hear me moan?"},
+			{TimeMs: 82900, Text: "Ooh baby, can you hear me moan?"},
+		}, lyrics.Lines)
+		assert.Equal(t, "[ti:Supermassive Black Hole]\n[ar:Muse]\n"+
+			"[00:12.00]Ooh baby, don't you know I suffer?\n"+
+			"[00:15.20]You caught me under false pretenses\n"+
+			"[00:19.50]Ooh baby, can you hear me moan?\n"+
+			"[01:22.90]Ooh baby, can you hear me moan?\n", domain.FormatLRC(lyrics))
+
+		if assert.Len(t, audit.events, 1) {
+			event := audit.events[0]
+			assert.Equal(t, domain.AuditActionSetLyrics, event.Action)
+			assert.Equal(t, domain.AuditEntitySong, event.Entity)
+			assert.Equal(t, 1, *event.EntityID)
+			assert.Nil(t, event.Before)
+			assert.NotNil(t, event.After)
+		}
+
+		assert.Zero(t, audit.outsideTx)
+	})
+
+	t.Run("NoTimestamps", func(t *testing.T) {
+		lyrics, err := service.ImportLRC(context.Background(), 1, "[ar:Muse]\nplain text")
+		assert.Nil(t, lyrics)
+		assert.True(t, errors.As(err, &clientErrors.ErrInvalidInput{}))
+	})
+
+	t.Run("TimestampOutOfRange", func(t *testing.T) {
+		for _, lrc := range []string{
+			"[99999999999999999999:00.00]Overlong minutes",
+			"[40000:00.00]Past the latest time",
+			"[offset:-2147483647]\n[01:00.00]Pushed past the latest time",
+		} {
+			lyrics, err := service.ImportLRC(context.Background(), 1, lrc)
+			assert.Nil(t, lyrics)
+			assert.True(t, errors.As(err, &clientErrors.ErrInvalidInput{}), lrc)
+		}
+	})
+}
+
+func TestLyricsService_GetLyricsAt(t *testing.T) {
+	mockLyricsRepo := mocks.NewLyricsRepositoryMock(t)
+
+	service := application.NewLyricsService(mockLyricsRepo, &txRecorder{}, &auditRecorder{})
+
+	lyrics := &domain.SyncedLyrics{SongID: 1, Lines: []domain.SyncedLine{
+		{TimeMs: 1000, Text: "one"},
+		{TimeMs: 2000, Text: "two"},
+		{TimeMs: 3000, Text: "three"},
+		{TimeMs: 4000, Text: "four"},
+	}}
+
+	t.Run("ActiveAndNext", func(t *testing.T) {
+		mockLyricsRepo.On("GetSyncedLyrics", mock.Anything, 1).Return(lyrics, nil).Once()
+
+		position, err := service.GetLyricsAt(context.Background(), 1, 2500, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, &domain.SyncedLine{TimeMs: 2000, Text: "two"}, position.Active)
+		assert.Equal(t, []domain.SyncedLine{{TimeMs: 3000, Text: "three"}, {TimeMs: 4000, Text: "four"}}, position.Next)
+	})
+
+	t.Run("BeforeFirstLine", func(t *testing.T) {
+		mockLyricsRepo.On("GetSyncedLyrics", mock.Anything, 1).Return(lyrics, nil).Once()
+
+		position, err := service.GetLyricsAt(context.Background(), 1, 500, 1)
+		assert.NoError(t, err)
+		assert.Nil(t, position.Active)
+		assert.Equal(t, []domain.SyncedLine{{TimeMs: 1000, Text: "one"}}, position.Next)
+	})
+
+	t.Run("HugeNext", func(t *testing.T) {
+		mockLyricsRepo.On("GetSyncedLyrics", mock.Anything, 1).Return(lyrics, nil).Once()
+
+		position, err := service.GetLyricsAt(context.Background(), 1, 3500, math.MaxInt)
+		assert.NoError(t, err)
+		assert.Equal(t, []domain.SyncedLine{{TimeMs: 4000, Text: "four"}}, position.Next)
+	})
+
+	t.Run("NotSynced", func(t *testing.T) {
+		mockLyricsRepo.On("GetSyncedLyrics", mock.Anything, 2).Return(nil, clientErrors.NewErrNotFound("synced lyrics")).Once()
+
+		position, err := service.GetLyricsAt(context.Background(), 2, 500, 1)
+		assert.Nil(t, position)
+		assert.True(t, errors.As(err, &clientErrors.ErrNotFound{}))
+	})
+}
+
+func TestLyricsService_DeleteLyrics(t *testing.T) {
+	mockLyricsRepo := mocks.NewLyricsRepositoryMock(t)
+
+	tx := &txRecorder{}
+	audit := &auditRecorder{}
+	service := application.NewLyricsService(mockLyricsRepo, tx, audit)
+
+	t.Run("Success", func(t *testing.T) {
+		lyrics := &domain.SyncedLyrics{SongID: 1, Lines: []domain.SyncedLine{{TimeMs: 1000, Text: "one"}}}
+
+		mockLyricsRepo.On("GetSyncedLyrics", inTx, 1).Return(lyrics, nil).Once()
+		mockLyricsRepo.On("DeleteSyncedLyrics", inTx, 1).Return(nil).Once()
+
+		err := service.DeleteLyrics(domain.WithActor(context.Background(), "alice"), 1)
+		assert.NoError(t, err)
+
+		if assert.Len(t, audit.events, 1) {
+			event := audit.events[0]
+			assert.Equal(t, "alice", event.Actor)
+			assert.Equal(t, domain.AuditActionDeleteLyrics, event.Action)
+			assert.NotNil(t, event.Before)
+			assert.Nil(t, event.After)
+		}
+	})
+
+	t.Run("NotSynced", func(t *testing.T) {
+		mockLyricsRepo.On("GetSyncedLyrics", inTx, 2).Return(nil, clientErrors.NewErrNotFound("synced lyrics")).Once()
+		mockLyricsRepo.On("DeleteSyncedLyrics", inTx, 2).Return(clientErrors.NewErrNotFound("synced lyrics")).Once()
+
+		err := service.DeleteLyrics(context.Background(), 2)
+		assert.True(t, errors.As(err, &clientErrors.ErrNotFound{}))
+		assert.Equal(t, 1, tx.rolledBack)
+		assert.Len(t, audit.events, 1)
+	})
+}
@@ -0,0 +1,73 @@
+package application
+
+import (
+	"context"
+	"errors"
+
+	"github.com/mashfeii/songs_library/internal/domain"
+	clientErrors "github.com/mashfeii/songs_library/internal/infrastructure/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// MetadataProvider looks up details of a song. Providers return ErrNotFound for songs
+// they know nothing about and leave unknown fields of the result zero.
+type MetadataProvider interface {
+	Name() string
+	Lookup(ctx context.Context, group, song string) (*domain.SongMetadata, error)
+}
+
+// ProviderChain asks its providers in order and fills every field from the first provider
+// that has it. Failing providers are skipped, so a song is still found while at least one
+// of the others knows it.
+type ProviderChain struct {
+	providers []MetadataProvider
+}
+
+func NewProviderChain(providers ...MetadataProvider) *ProviderChain {
+	return &ProviderChain{providers: providers}
+}
+
+func (c *ProviderChain) Name() string {
+	return "chain"
+}
+
+func (c *ProviderChain) Lookup(ctx context.Context, group, song string) (*domain.SongMetadata, error) {
+	var (
+		merged  domain.SongMetadata
+		lastErr error
+	)
+
+	for _, provider := range c.providers {
+		metadata, err := provider.Lookup(ctx, group, song)
+		if err != nil {
+			if !errors.As(err, &clientErrors.ErrNotFound{}) {
+				logrus.WithFields(logrus.Fields{
+					"provider": provider.Name(),
+					"group":    group,
+					"song":     song,
+					"error":    err,
+				}).Warn("Metadata provider failed, trying the next one")
+
+				lastErr = err
+			}
+
+			continue
+		}
+
+		merged.Merge(metadata, provider.Name())
+
+		if merged.Complete() {
+			break
+		}
+	}
+
+	if merged.Empty() {
+		if lastErr != nil {
+			return nil, clientErrors.NewErrExternal(lastErr)
+		}
+
+		return nil, clientErrors.NewErrNotFound("song metadata")
+	}
+
+	return &merged, nil
+}
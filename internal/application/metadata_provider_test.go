@@ -0,0 +1,95 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/mashfeii/songs_library/internal/application"
+	"github.com/mashfeii/songs_library/internal/domain"
+	"github.com/mashfeii/songs_library/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	clientErrors "github.com/mashfeii/songs_library/internal/infrastructure/errors"
+)
+
+func TestProviderChain_Lookup(t *testing.T) {
+	api := mocks.NewMetadataProviderMock(t)
+	catalog := mocks.NewMetadataProviderMock(t)
+
+	api.On("Name").Return("music-info").Maybe()
+	catalog.On("Name").Return("catalog").Maybe()
+
+	chain := application.NewProviderChain(api, catalog)
+	releaseDate := time.Date(2006, 6, 19, 0, 0, 0, 0, time.UTC)
+
+	t.Run("FirstProviderWins", func(t *testing.T) {
+		api.On("Lookup", mock.Anything, "Muse", "Starlight").Return(&domain.SongMetadata{
+			Text: "Far away, this ship is taking me far away",
+			Link: "https://www.youtube.com/watch?v=Pgum6OT_VH8",
+		}, nil).Once()
+		catalog.On("Lookup", mock.Anything, "Muse", "Starlight").Return(&domain.SongMetadata{
+			ReleaseDate: releaseDate,
+			Link:        "https://example.com/starlight",
+			Album:       &domain.AlbumMetadata{Title: "Black Holes and Revelations"},
+		}, nil).Once()
+
+		metadata, err := chain.Lookup(context.Background(), "Muse", "Starlight")
+		require.NoError(t, err)
+		assert.Equal(t, "https://www.youtube.com/watch?v=Pgum6OT_VH8", metadata.Link)
+		assert.Equal(t, releaseDate, metadata.ReleaseDate)
+		assert.Equal(t, domain.MetadataSources{
+			domain.MetadataFieldText:        "music-info",
+			domain.MetadataFieldLink:        "music-info",
+			domain.MetadataFieldReleaseDate: "catalog",
+			domain.MetadataFieldAlbum:       "catalog",
+		}, metadata.Sources)
+	})
+
+	t.Run("CompleteStopsTheChain", func(t *testing.T) {
+		api.On("Lookup", mock.Anything, "Muse", "Starlight").Return(&domain.SongMetadata{
+			ReleaseDate: releaseDate,
+			Text:        "Far away, this ship is taking me far away",
+			Link:        "https://www.youtube.com/watch?v=Pgum6OT_VH8",
+			Album:       &domain.AlbumMetadata{Title: "Black Holes and Revelations"},
+		}, nil).Once()
+
+		metadata, err := chain.Lookup(context.Background(), "Muse", "Starlight")
+		require.NoError(t, err)
+		assert.Equal(t, "music-info", metadata.Sources[domain.MetadataFieldAlbum])
+	})
+
+	t.Run("FailingProviderIsSkipped", func(t *testing.T) {
+		api.On("Lookup", mock.Anything, "Muse", "Starlight").
+			Return(nil, clientErrors.NewErrExternal(errors.New("circuit breaker is open"))).Once()
+		catalog.On("Lookup", mock.Anything, "Muse", "Starlight").Return(&domain.SongMetadata{
+			Text: "Far away, this ship is taking me far away",
+		}, nil).Once()
+
+		metadata, err := chain.Lookup(context.Background(), "Muse", "Starlight")
+		require.NoError(t, err)
+		assert.Equal(t, domain.MetadataSources{domain.MetadataFieldText: "catalog"}, metadata.Sources)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		api.On("Lookup", mock.Anything, "Muse", "Unknown").Return(nil, clientErrors.NewErrNotFound("song info")).Once()
+		catalog.On("Lookup", mock.Anything, "Muse", "Unknown").Return(nil, clientErrors.NewErrNotFound("catalog song")).Once()
+
+		metadata, err := chain.Lookup(context.Background(), "Muse", "Unknown")
+		assert.Nil(t, metadata)
+		assert.True(t, errors.As(err, &clientErrors.ErrNotFound{}))
+	})
+
+	t.Run("AllFailed", func(t *testing.T) {
+		api.On("Lookup", mock.Anything, "Muse", "Unknown").
+			Return(nil, clientErrors.NewErrExternal(errors.New("status code: 502"))).Once()
+		catalog.On("Lookup", mock.Anything, "Muse", "Unknown").Return(nil, clientErrors.NewErrNotFound("catalog song")).Once()
+
+		metadata, err := chain.Lookup(context.Background(), "Muse", "Unknown")
+		assert.Nil(t, metadata)
+		assert.True(t, errors.As(err, &clientErrors.ErrExternal{}))
+	})
+}
@@ -0,0 +1,50 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	clientErrors "github.com/mashfeii/songs_library/internal/infrastructure/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// RefreshScheduler starts a metadata refresh run every interval, a zero interval disables it.
+type RefreshScheduler struct {
+	service  MetadataRefreshServiceInterface
+	interval time.Duration
+}
+
+func NewRefreshScheduler(service MetadataRefreshServiceInterface, interval time.Duration) *RefreshScheduler {
+	return &RefreshScheduler{service: service, interval: interval}
+}
+
+// Run blocks until ctx is done.
+func (s *RefreshScheduler) Run(ctx context.Context) {
+	if s.interval <= 0 {
+		logrus.Info("Metadata refresh scheduler disabled")
+
+		return
+	}
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if _, err := s.service.RunRefresh(ctx); err != nil {
+			if errors.As(err, &clientErrors.ErrConflict{}) {
+				logrus.Info("Skipping scheduled metadata refresh, a run is in progress")
+
+				continue
+			}
+
+			logrus.WithField("error", err).Error("Scheduled metadata refresh failed")
+		}
+	}
+}
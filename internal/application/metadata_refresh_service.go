@@ -0,0 +1,271 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"sync/atomic"
+	"time"
+
+	"github.com/mashfeii/songs_library/internal/domain"
+	"github.com/mashfeii/songs_library/internal/infrastructure/database"
+	clientErrors "github.com/mashfeii/songs_library/internal/infrastructure/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// refreshAuthor is the revision author and audit actor of changes applied by the metadata refresh.
+const refreshAuthor = "metadata-refresh"
+
+type MetadataRefreshServiceInterface interface {
+	RunRefresh(ctx context.Context) (*domain.RefreshRun, error)
+	StartRefresh(ctx context.Context) (*domain.RefreshRun, error)
+	GetRefreshRuns(ctx context.Context, pageReq domain.PageRequest) (*domain.Page[domain.RefreshRun], error)
+	GetRefreshRun(ctx context.Context, id int) (*domain.RefreshRun, error)
+}
+
+// RefreshOptions selects the songs of a run: at most BatchSize songs whose metadata is older
+// than MaxAge.
+type RefreshOptions struct {
+	MaxAge    time.Duration
+	BatchSize int
+}
+
+type MetadataRefreshService struct {
+	refreshRepo database.MetadataRefreshRepository
+	songsRepo   database.SongsRepository
+	metadata    MetadataProvider
+	transactor  database.Transactor
+	auditRepo   database.AuditRepository
+	opts        RefreshOptions
+	running     atomic.Bool
+}
+
+func NewMetadataRefreshService(
+	refreshRepo database.MetadataRefreshRepository,
+	songsRepo database.SongsRepository,
+	metadata MetadataProvider,
+	transactor database.Transactor,
+	auditRepo database.AuditRepository,
+	opts RefreshOptions,
+) *MetadataRefreshService {
+	return &MetadataRefreshService{
+		refreshRepo: refreshRepo,
+		songsRepo:   songsRepo,
+		metadata:    metadata,
+		transactor:  transactor,
+		auditRepo:   auditRepo,
+		opts:        opts,
+	}
+}
+
+// RunRefresh looks up the stale songs again and compares them with the stored metadata.
+// Changed fields are applied as a new revision, fields edited manually get a proposal
+// instead. Only one run is allowed at a time.
+func (s *MetadataRefreshService) RunRefresh(ctx context.Context) (*domain.RefreshRun, error) {
+	if !s.running.CompareAndSwap(false, true) {
+		return nil, clientErrors.NewErrConflict("a metadata refresh is already running")
+	}
+	defer s.running.Store(false)
+
+	run, err := s.refreshRepo.StartRefreshRun(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.refresh(ctx, run)
+}
+
+// StartRefresh starts a run like RunRefresh in the background and returns it while it is
+// still running. The run is detached from ctx, so it outlives the request starting it.
+func (s *MetadataRefreshService) StartRefresh(ctx context.Context) (*domain.RefreshRun, error) {
+	if !s.running.CompareAndSwap(false, true) {
+		return nil, clientErrors.NewErrConflict("a metadata refresh is already running")
+	}
+
+	run, err := s.refreshRepo.StartRefreshRun(ctx)
+	if err != nil {
+		s.running.Store(false)
+
+		return nil, err
+	}
+
+	started := *run
+
+	go func() {
+		defer s.running.Store(false)
+
+		if _, err := s.refresh(context.WithoutCancel(ctx), run); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"error": err,
+				"run":   run.ID,
+			}).Error("Metadata refresh run failed")
+		}
+	}()
+
+	return &started, nil
+}
+
+// FailInterruptedRuns marks runs left running by a previous process as failed, it is called
+// on startup before any run can begin.
+func (s *MetadataRefreshService) FailInterruptedRuns(ctx context.Context) error {
+	failed, err := s.refreshRepo.FailRunningRefreshRuns(ctx, "run interrupted by restart")
+	if err != nil {
+		return err
+	}
+
+	if failed > 0 {
+		logrus.WithField("runs", failed).Warn("Marked interrupted metadata refresh runs as failed")
+	}
+
+	return nil
+}
+
+func (s *MetadataRefreshService) refresh(ctx context.Context, run *domain.RefreshRun) (*domain.RefreshRun, error) {
+	songs, err := s.refreshRepo.GetStaleSongs(ctx, time.Now().Add(-s.opts.MaxAge), s.opts.BatchSize)
+	if err != nil {
+		run.Status, run.Error = domain.RefreshRunFailed, err.Error()
+
+		return run, errors.Join(err, s.refreshRepo.FinishRefreshRun(context.WithoutCancel(ctx), run))
+	}
+
+	run.Status = domain.RefreshRunFinished
+
+	for i := range songs {
+		if ctx.Err() != nil {
+			run.Status, run.Error = domain.RefreshRunFailed, "run interrupted"
+
+			break
+		}
+
+		s.refreshSong(ctx, run, &songs[i])
+	}
+
+	if err := s.refreshRepo.FinishRefreshRun(context.WithoutCancel(ctx), run); err != nil {
+		return nil, err
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"run":       run.ID,
+		"checked":   run.Checked,
+		"changed":   run.Changed,
+		"proposed":  run.Proposed,
+		"unchanged": run.Unchanged,
+		"failed":    run.Failed,
+	}).Info("Metadata refresh run finished")
+
+	return run, nil
+}
+
+func (s *MetadataRefreshService) refreshSong(ctx context.Context, run *domain.RefreshRun, song *domain.Song) {
+	run.Checked++
+
+	logger := logrus.WithFields(logrus.Fields{
+		"run": run.ID,
+		"id":  song.ID,
+	})
+
+	metadata, err := s.metadata.Lookup(ctx, song.Group, song.Song)
+	if err != nil {
+		logger.WithField("error", err).Warn("Failed to look up song metadata")
+
+		run.Failed++
+
+		// Songs unknown to every provider wait for the next refresh instead of being
+		// looked up on every run.
+		if errors.As(err, &clientErrors.ErrNotFound{}) {
+			_ = s.refreshRepo.TouchSongMetadata(ctx, song.ID)
+		}
+
+		return
+	}
+
+	var (
+		applied   int
+		proposals []domain.MetadataProposal
+	)
+
+	before := *song
+	before.Sources = maps.Clone(song.Sources)
+
+	for _, change := range domain.MetadataChanges(song, metadata) {
+		if slices.Contains(song.EditedFields, change.Field) {
+			proposals = append(proposals, domain.MetadataProposal{
+				RunID:    run.ID,
+				SongID:   song.ID,
+				Field:    change.Field,
+				Current:  change.Current,
+				Proposed: change.Proposed,
+				Provider: change.Provider,
+			})
+
+			continue
+		}
+
+		domain.ApplyMetadataChange(song, metadata, change)
+		applied++
+	}
+
+	err = s.transactor.WithinTransaction(domain.WithActor(ctx, refreshAuthor), func(ctx context.Context) error {
+		if applied == 0 {
+			if err := s.refreshRepo.TouchSongMetadata(ctx, song.ID); err != nil {
+				return err
+			}
+		} else if err := s.applyRefresh(ctx, &before, song); err != nil {
+			return err
+		}
+
+		if len(proposals) > 0 {
+			return s.refreshRepo.AddMetadataProposals(ctx, proposals)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		logger.WithField("error", err).Error("Failed to store refreshed song metadata")
+
+		run.Failed++
+
+		return
+	}
+
+	switch {
+	case applied > 0:
+		run.Changed++
+	case len(proposals) > 0:
+		run.Proposed++
+	default:
+		run.Unchanged++
+	}
+}
+
+// applyRefresh stores the refreshed song as a new revision and audits the change.
+func (s *MetadataRefreshService) applyRefresh(ctx context.Context, before, song *domain.Song) error {
+	err := s.songsRepo.UpdateSong(ctx, song, domain.RevisionInfo{
+		Author:  refreshAuthor,
+		Reason:  "metadata refresh",
+		Refresh: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	after, err := s.songsRepo.GetSongByID(ctx, song.ID)
+	if err != nil {
+		return err
+	}
+
+	return recordAudit(ctx, s.auditRepo, domain.AuditEntitySong, song.ID, domain.AuditActionRefresh, before, after)
+}
+
+func (s *MetadataRefreshService) GetRefreshRuns(
+	ctx context.Context,
+	pageReq domain.PageRequest,
+) (*domain.Page[domain.RefreshRun], error) {
+	return s.refreshRepo.GetRefreshRuns(ctx, pageReq)
+}
+
+func (s *MetadataRefreshService) GetRefreshRun(ctx context.Context, id int) (*domain.RefreshRun, error) {
+	return s.refreshRepo.GetRefreshRun(ctx, id)
+}
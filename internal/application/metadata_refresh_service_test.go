@@ -0,0 +1,163 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/mashfeii/songs_library/internal/application"
+	"github.com/mashfeii/songs_library/internal/domain"
+	"github.com/mashfeii/songs_library/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	clientErrors "github.com/mashfeii/songs_library/internal/infrastructure/errors"
+)
+
+func TestMetadataRefreshService_RunRefresh(t *testing.T) {
+	mockRefreshRepo := mocks.NewMetadataRefreshRepositoryMock(t)
+	mockSongsRepo := mocks.NewSongsRepositoryMock(t)
+	mockProvider := mocks.NewMetadataProviderMock(t)
+
+	audit := &auditRecorder{}
+	service := application.NewMetadataRefreshService(mockRefreshRepo, mockSongsRepo, mockProvider, &txRecorder{}, audit,
+		application.RefreshOptions{MaxAge: 24 * time.Hour, BatchSize: 10})
+	releaseDate := time.Date(2006, 6, 19, 0, 0, 0, 0, time.UTC)
+	link := "https://www.youtube.com/watch?v=Xsp3_a-PMTw"
+	sources := domain.MetadataSources{
+		domain.MetadataFieldReleaseDate: "music-info",
+		domain.MetadataFieldText:        "music-info",
+		domain.MetadataFieldLink:        "music-info",
+	}
+
+	songs := []domain.Song{
+		{ID: 1, Group: "Muse", Song: "Supermassive Black Hole", ReleaseDate: releaseDate, Text: "Oh baby", Link: "old"},
+		{ID: 2, Group: "Muse", Song: "Starlight", ReleaseDate: releaseDate, Text: "My own", Link: link,
+			EditedFields: []string{domain.MetadataFieldText}},
+		{ID: 3, Group: "Muse", Song: "Uprising", ReleaseDate: releaseDate, Text: "Paranoia", Link: link},
+		{ID: 4, Group: "Muse", Song: "Hysteria", ReleaseDate: releaseDate, Text: "It's bugging me", Link: link},
+	}
+	metadata := func(text string) *domain.SongMetadata {
+		return &domain.SongMetadata{ReleaseDate: releaseDate, Text: text, Link: link, Sources: sources}
+	}
+
+	mockRefreshRepo.On("StartRefreshRun", mock.Anything).
+		Return(&domain.RefreshRun{ID: 7, Status: domain.RefreshRunRunning}, nil).Once()
+	mockRefreshRepo.On("GetStaleSongs", mock.Anything, mock.Anything, 10).Return(songs, nil).Once()
+
+	mockProvider.On("Lookup", mock.Anything, "Muse", "Supermassive Black Hole").Return(metadata("Oh baby"), nil).Once()
+	mockSongsRepo.On("UpdateSong", inTx, mock.MatchedBy(func(song *domain.Song) bool {
+		return song.ID == 1 && song.Link == link && song.Sources[domain.MetadataFieldLink] == "music-info"
+	}), domain.RevisionInfo{Author: "metadata-refresh", Reason: "metadata refresh", Refresh: true}).Return(nil).Once()
+	mockSongsRepo.On("GetSongByID", inTx, 1).Return(&domain.Song{ID: 1, Link: link, Version: 3}, nil).Once()
+
+	mockProvider.On("Lookup", mock.Anything, "Muse", "Starlight").Return(metadata("Far away"), nil).Once()
+	mockRefreshRepo.On("TouchSongMetadata", inTx, 2).Return(nil).Once()
+	mockRefreshRepo.On("AddMetadataProposals", inTx, []domain.MetadataProposal{{
+		RunID:    7,
+		SongID:   2,
+		Field:    domain.MetadataFieldText,
+		Current:  "My own",
+		Proposed: "Far away",
+		Provider: "music-info",
+	}}).Return(nil).Once()
+
+	mockProvider.On("Lookup", mock.Anything, "Muse", "Uprising").Return(metadata("Paranoia"), nil).Once()
+	mockRefreshRepo.On("TouchSongMetadata", mock.Anything, 3).Return(nil).Once()
+
+	mockProvider.On("Lookup", mock.Anything, "Muse", "Hysteria").
+		Return(nil, clientErrors.NewErrExternal(errors.New("status code: 503"))).Once()
+
+	mockRefreshRepo.On("FinishRefreshRun", mock.Anything, mock.MatchedBy(func(run *domain.RefreshRun) bool {
+		return run.Status == domain.RefreshRunFinished && run.Checked == 4 && run.Changed == 1 &&
+			run.Proposed == 1 && run.Unchanged == 1 && run.Failed == 1
+	})).Return(nil).Once()
+
+	run, err := service.RunRefresh(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 7, run.ID)
+	assert.Equal(t, domain.RefreshRunFinished, run.Status)
+
+	if assert.Len(t, audit.events, 1) {
+		event := audit.events[0]
+		assert.Equal(t, "metadata-refresh", event.Actor)
+		assert.Equal(t, domain.AuditActionRefresh, event.Action)
+		assert.Equal(t, 1, *event.EntityID)
+		assert.Contains(t, string(event.Before), `"link":"old"`)
+		assert.Contains(t, string(event.After), `"link":"`+link+`"`)
+	}
+
+	assert.Zero(t, audit.outsideTx)
+}
+
+func TestMetadataRefreshService_StartRefresh(t *testing.T) {
+	mockRefreshRepo := mocks.NewMetadataRefreshRepositoryMock(t)
+	mockSongsRepo := mocks.NewSongsRepositoryMock(t)
+	mockProvider := mocks.NewMetadataProviderMock(t)
+
+	service := application.NewMetadataRefreshService(mockRefreshRepo, mockSongsRepo, mockProvider, &txRecorder{},
+		&auditRecorder{}, application.RefreshOptions{MaxAge: 24 * time.Hour, BatchSize: 10})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	release := make(chan struct{})
+	finished := make(chan struct{})
+
+	mockRefreshRepo.On("StartRefreshRun", mock.Anything).
+		Return(&domain.RefreshRun{ID: 7, Status: domain.RefreshRunRunning}, nil).Once()
+	mockRefreshRepo.On("GetStaleSongs", mock.Anything, mock.Anything, 10).
+		Run(func(mock.Arguments) { <-release }).Return([]domain.Song{{ID: 1, Group: "Muse", Song: "Starlight"}}, nil).Once()
+	mockProvider.On("Lookup", mock.Anything, "Muse", "Starlight").
+		Return(nil, clientErrors.NewErrExternal(errors.New("status code: 503"))).Once()
+	mockRefreshRepo.On("FinishRefreshRun", mock.Anything, mock.MatchedBy(func(run *domain.RefreshRun) bool {
+		return run.ID == 7 && run.Status == domain.RefreshRunFinished && run.Failed == 1
+	})).Run(func(mock.Arguments) { close(finished) }).Return(nil).Once()
+
+	run, err := service.StartRefresh(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 7, run.ID)
+	assert.Equal(t, domain.RefreshRunRunning, run.Status)
+
+	_, err = service.StartRefresh(ctx)
+	assert.True(t, errors.As(err, &clientErrors.ErrConflict{}))
+
+	cancel()
+	close(release)
+
+	select {
+	case <-finished:
+	case <-time.After(time.Second):
+		t.Fatal("run did not finish after the request was cancelled")
+	}
+}
+
+func TestMetadataRefreshService_FailInterruptedRuns(t *testing.T) {
+	mockRefreshRepo := mocks.NewMetadataRefreshRepositoryMock(t)
+
+	service := application.NewMetadataRefreshService(mockRefreshRepo, mocks.NewSongsRepositoryMock(t),
+		mocks.NewMetadataProviderMock(t), &txRecorder{}, &auditRecorder{}, application.RefreshOptions{})
+
+	mockRefreshRepo.On("FailRunningRefreshRuns", mock.Anything, "run interrupted by restart").Return(2, nil).Once()
+
+	assert.NoError(t, service.FailInterruptedRuns(context.Background()))
+}
+
+func TestMetadataChanges(t *testing.T) {
+	releaseDate := time.Date(2006, 6, 19, 0, 0, 0, 0, time.UTC)
+	song := &domain.Song{ReleaseDate: releaseDate, Text: "Oh baby", Link: "old"}
+
+	changes := domain.MetadataChanges(song, &domain.SongMetadata{
+		ReleaseDate: releaseDate.AddDate(0, 0, 1),
+		Link:        "new",
+		Sources: domain.MetadataSources{
+			domain.MetadataFieldReleaseDate: "catalog",
+			domain.MetadataFieldLink:        "music-info",
+		},
+	})
+
+	assert.Equal(t, []domain.MetadataChange{
+		{Field: domain.MetadataFieldReleaseDate, Current: "2006-06-19", Proposed: "2006-06-20", Provider: "catalog"},
+		{Field: domain.MetadataFieldLink, Current: "old", Proposed: "new", Provider: "music-info"},
+	}, changes)
+}
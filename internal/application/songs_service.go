@@ -2,12 +2,13 @@ package application
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
-	"net/http"
+	"slices"
 	"strings"
+	"time"
 
-	client "github.com/mashfeii/songs_library/internal/api"
 	"github.com/mashfeii/songs_library/internal/domain"
 	"github.com/mashfeii/songs_library/internal/infrastructure/database"
 	clientErrors "github.com/mashfeii/songs_library/internal/infrastructure/errors"
@@ -15,140 +16,662 @@ import (
 )
 
 type SongsServiceInterface interface {
-	GetSongs(ctx context.Context, filters map[string]string, page, size int) ([]domain.Song, error)
-	GetSongVerses(ctx context.Context, id, page, size int) ([]string, error)
-	DeleteSong(ctx context.Context, id int) error
-	UpdateSong(ctx context.Context, song *domain.Song) error
+	GetSongs(ctx context.Context, filter *domain.SongFilter, pageReq domain.PageRequest) (*domain.Page[domain.Song], error)
+	GetSongVerses(ctx context.Context, id int, opts domain.VersesOptions) ([]domain.Verse, int, error)
+	SearchSongs(ctx context.Context, query string, page, size int) ([]domain.SongSearchResult, error)
+	GetSong(ctx context.Context, id int) (*domain.Song, error)
+	DeleteSong(ctx context.Context, id, version int) error
+	RestoreSong(ctx context.Context, id int) (*domain.Song, error)
+	GetTrashedSongs(ctx context.Context, pageReq domain.PageRequest) (*domain.Page[domain.Song], error)
+	PurgeDeletedSongs(ctx context.Context, retention time.Duration) (int, error)
+	UpdateSong(ctx context.Context, song *domain.Song, reason string) error
+	PatchSong(ctx context.Context, id, version int, patch []byte, reason string) (*domain.Song, error)
+	GetSongRevisions(ctx context.Context, id int, pageReq domain.PageRequest) (*domain.Page[domain.Revision], error)
+	GetSongRevision(ctx context.Context, id, revision int) (*domain.Revision, error)
+	DiffSongRevisions(ctx context.Context, id, from, to int) (string, error)
+	RestoreSongRevision(ctx context.Context, id, revision int, reason string) (*domain.Song, error)
+	SetSongArtists(ctx context.Context, id int, req *domain.SongArtistsRequest) (*domain.Song, error)
 	AddSong(ctx context.Context, songReq *domain.AddSongRequest) (int, error)
+	QueueSong(ctx context.Context, songReq *domain.AddSongRequest) (int, error)
+	EnrichPendingSong(ctx context.Context, lease time.Duration) (bool, error)
+	GetSongStatus(ctx context.Context, id int) (*domain.Song, error)
 }
 
 type SongsService struct {
-	songsRepo  database.SongsRepository
-	groupsRepo database.GroupsRepository
-	apiClient  client.ClientWithResponsesInterface
+	songsRepo        database.SongsRepository
+	groupsRepo       database.GroupsRepository
+	albumsRepo       database.AlbumsRepository
+	lyricsRepo       database.LyricsRepository
+	annotationsRepo  database.AnnotationsRepository
+	translationsRepo database.TranslationsRepository
+	metadata         MetadataProvider
+	transactor       database.Transactor
+	auditRepo        database.AuditRepository
 }
 
 func NewSongsService(
 	songsRepo database.SongsRepository,
 	groupsRepo database.GroupsRepository,
-	apiClient client.ClientWithResponsesInterface,
+	albumsRepo database.AlbumsRepository,
+	lyricsRepo database.LyricsRepository,
+	annotationsRepo database.AnnotationsRepository,
+	translationsRepo database.TranslationsRepository,
+	metadata MetadataProvider,
+	transactor database.Transactor,
+	auditRepo database.AuditRepository,
 ) *SongsService {
 	return &SongsService{
-		songsRepo:  songsRepo,
-		groupsRepo: groupsRepo,
-		apiClient:  apiClient,
+		songsRepo:        songsRepo,
+		groupsRepo:       groupsRepo,
+		albumsRepo:       albumsRepo,
+		lyricsRepo:       lyricsRepo,
+		annotationsRepo:  annotationsRepo,
+		translationsRepo: translationsRepo,
+		metadata:         metadata,
+		transactor:       transactor,
+		auditRepo:        auditRepo,
 	}
 }
 
-func (s *SongsService) GetSongs(ctx context.Context, filters map[string]string, page, size int) ([]domain.Song, error) {
-	for key, value := range filters {
-		if value == "" {
-			delete(filters, key)
+func (s *SongsService) GetSongs(
+	ctx context.Context,
+	filter *domain.SongFilter,
+	pageReq domain.PageRequest,
+) (*domain.Page[domain.Song], error) {
+	groups := make([]string, 0, len(filter.Groups))
+
+	for _, group := range filter.Groups {
+		if group = strings.TrimSpace(group); group != "" {
+			groups = append(groups, group)
 		}
 	}
 
-	songs, err := s.songsRepo.GetSongs(ctx, filters, page, size)
+	filter.Groups = groups
+
+	tags := make([]string, 0, len(filter.Tags))
+
+	for _, tag := range filter.Tags {
+		if tag = domain.NormalizeTagName(tag); tag != "" && !slices.Contains(tags, tag) {
+			tags = append(tags, tag)
+		}
+	}
+
+	filter.Tags = tags
+
+	if filter.ReleasedFrom != nil && filter.ReleasedTo != nil && filter.ReleasedFrom.After(*filter.ReleasedTo) {
+		return nil, clientErrors.NewErrInvalidInput("releasedFrom")
+	}
+
+	page, err := s.songsRepo.GetSongs(ctx, filter, pageReq)
 	if err != nil {
-		if errors.As(err, &clientErrors.ErrNotFound{}) || len(songs) == 0 {
+		if errors.As(err, &clientErrors.ErrInvalidInput{}) {
 			return nil, err
 		}
 
 		return nil, fmt.Errorf("getting songs: %w", err)
 	}
 
-	if len(songs) == 0 {
+	if len(page.Items) == 0 {
 		logrus.WithFields(logrus.Fields{
-			"filters": filters,
+			"filter": filter,
 		}).Warn("No songs found")
-
-		return nil, clientErrors.NewErrNotFound("songs")
 	}
 
-	return songs, nil
+	return page, nil
 }
 
-func (s *SongsService) GetSongVerses(ctx context.Context, id, page, size int) ([]string, error) {
+// GetSongVerses splits the song text into stanzas or lines and returns the requested page
+// along with the total number of verses. Lines carry their times when synced lyrics exist
+// and the annotations overlapping them when requested. A language other than the original one
+// replaces the verses with the translation, or pairs both line by line side by side.
+func (s *SongsService) GetSongVerses(ctx context.Context, id int, opts domain.VersesOptions) ([]domain.Verse, int, error) {
 	song, err := s.songsRepo.GetSongByID(ctx, id)
 	if err != nil {
 		if errors.As(err, &clientErrors.ErrNotFound{}) {
-			return nil, err
+			return nil, 0, err
 		}
 
-		return nil, fmt.Errorf("getting song: %w", err)
+		return nil, 0, fmt.Errorf("getting song: %w", err)
+	}
+
+	verses := domain.SplitStanzas(song.Text)
+
+	language := opts.Language
+	if language == song.Language {
+		language = ""
 	}
 
-	verses := strings.Split(song.Text, "\n")
+	if language != "" {
+		translation, err := s.translationsRepo.GetTranslation(ctx, id, language)
+		if err != nil {
+			if errors.As(err, &clientErrors.ErrNotFound{}) {
+				return nil, 0, err
+			}
 
-	start := (page - 1) * size
-	end := start + size
+			return nil, 0, fmt.Errorf("getting translation: %w", err)
+		}
+
+		translated := domain.SplitStanzas(translation.Text)
+
+		if !opts.SideBySide {
+			return paginateVerses(shapeVerses(translated, opts), opts)
+		}
 
-	if start >= len(verses) {
+		domain.PairTranslation(verses, translated)
+	}
+
+	synced, err := syncedLyricsOf(ctx, s.lyricsRepo, id)
+	if err != nil {
+		return nil, 0, fmt.Errorf("getting synced lyrics: %w", err)
+	}
+
+	if synced != nil {
+		domain.AttachTimes(verses, synced)
+	}
+
+	verses = shapeVerses(verses, opts)
+
+	if opts.Annotations {
+		annotations, err := s.annotationsRepo.GetAnnotations(ctx, id)
+		if err != nil {
+			return nil, 0, fmt.Errorf("getting annotations: %w", err)
+		}
+
+		domain.AttachAnnotations(verses, annotations)
+	}
+
+	return paginateVerses(verses, opts)
+}
+
+// shapeVerses collapses repeated stanzas and splits stanzas into lines as requested.
+func shapeVerses(verses []domain.Verse, opts domain.VersesOptions) []domain.Verse {
+	if opts.Collapse {
+		verses = domain.CollapseRepeats(verses)
+	}
+
+	if opts.Unit != domain.VerseUnitStanza {
+		verses = domain.StanzaLines(verses)
+	}
+
+	return verses
+}
+
+func paginateVerses(verses []domain.Verse, opts domain.VersesOptions) ([]domain.Verse, int, error) {
+	start := (opts.Page - 1) * opts.Size
+	end := start + opts.Size
+
+	if start >= len(verses) && opts.Page > 1 {
 		logrus.WithFields(logrus.Fields{
 			"start":  start,
 			"end":    end,
 			"length": len(verses),
 		}).Error("Invalid page number")
 
-		return nil, clientErrors.NewErrInvalidInput("page")
+		return nil, 0, clientErrors.NewErrInvalidInput("page")
 	}
 
-	if end > len(verses) {
-		end = len(verses)
-	}
+	end = min(end, len(verses))
+	start = min(start, end)
 
 	logrus.WithFields(logrus.Fields{
 		"start":  start,
 		"end":    end,
 		"length": len(verses),
+		"unit":   opts.Unit,
 	}).Info("Verses retrieved from the song")
 
-	return verses[start:end], nil
+	return verses[start:end], len(verses), nil
 }
 
-func (s *SongsService) DeleteSong(ctx context.Context, id int) error {
-	return s.songsRepo.DeleteSong(ctx, id)
+func (s *SongsService) SearchSongs(ctx context.Context, query string, page, size int) ([]domain.SongSearchResult, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, clientErrors.NewErrInvalidInput("q")
+	}
+
+	results, err := s.songsRepo.SearchSongs(ctx, query, page, size)
+	if err != nil {
+		return nil, fmt.Errorf("searching songs: %w", err)
+	}
+
+	return results, nil
 }
 
-func (s *SongsService) UpdateSong(ctx context.Context, song *domain.Song) error {
-	return s.songsRepo.UpdateSong(ctx, song)
+func (s *SongsService) GetSong(ctx context.Context, id int) (*domain.Song, error) {
+	return s.songsRepo.GetSongByID(ctx, id)
 }
 
-func (s *SongsService) AddSong(ctx context.Context, songReq *domain.AddSongRequest) (int, error) {
-	response, err := s.apiClient.GetInfoWithResponse(ctx,
-		&client.GetInfoParams{
-			Group: songReq.Group,
-			Song:  songReq.Song,
-		},
-	)
+// DeleteSong moves the song to the trash, a non-zero version has to be the current one.
+func (s *SongsService) DeleteSong(ctx context.Context, id, version int) error {
+	return s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		before, err := s.songsRepo.GetSongByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := s.songsRepo.DeleteSong(ctx, id, version); err != nil {
+			return err
+		}
+
+		return recordAudit(ctx, s.auditRepo, domain.AuditEntitySong, id, domain.AuditActionDelete, before, nil)
+	})
+}
+
+// RestoreSong takes the song out of the trash and returns it.
+func (s *SongsService) RestoreSong(ctx context.Context, id int) (*domain.Song, error) {
+	var song *domain.Song
+
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.songsRepo.RestoreSong(ctx, id); err != nil {
+			return err
+		}
+
+		var err error
+
+		song, err = s.songsRepo.GetSongByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		return recordAudit(ctx, s.auditRepo, domain.AuditEntitySong, id, domain.AuditActionRestore, nil, song)
+	})
 	if err != nil {
-		return 0, clientErrors.NewErrExternal(err)
+		return nil, err
 	}
 
-	if response.StatusCode() != http.StatusOK {
-		logrus.WithFields(logrus.Fields{
-			"status_code": response.StatusCode(),
-			"group":       songReq.Group,
-			"song":        songReq.Song,
-		}).Error("Failed to get song info from the external API")
+	return song, nil
+}
 
-		return 0, clientErrors.NewErrExternal(fmt.Errorf("status code: %d", response.StatusCode()))
+func (s *SongsService) GetTrashedSongs(ctx context.Context, pageReq domain.PageRequest) (*domain.Page[domain.Song], error) {
+	page, err := s.songsRepo.GetTrashedSongs(ctx, pageReq)
+	if err != nil {
+		return nil, fmt.Errorf("getting trashed songs: %w", err)
 	}
 
-	groupID, err := s.groupsRepo.UpsertGroup(ctx, songReq.Group)
+	return page, nil
+}
+
+// PurgeDeletedSongs removes songs that have been in the trash for longer than the retention,
+// each purged song is audited.
+func (s *SongsService) PurgeDeletedSongs(ctx context.Context, retention time.Duration) (int, error) {
+	ctx = domain.WithActor(ctx, trashPurgeActor)
+
+	var purged []domain.Song
+
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+
+		if purged, err = s.songsRepo.PurgeDeletedSongs(ctx, time.Now().Add(-retention)); err != nil {
+			return err
+		}
+
+		for i := range purged {
+			err := recordAudit(ctx, s.auditRepo, domain.AuditEntitySong, purged[i].ID, domain.AuditActionPurge, &purged[i], nil)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("purging deleted songs: %w", err)
+	}
+
+	return len(purged), nil
+}
+
+// UpdateSong resolves the group name of the song and stores the change as a new revision
+// authored by the actor of the request. A non-zero song version has to be the current one.
+// The song is refreshed with its stored state afterwards.
+func (s *SongsService) UpdateSong(ctx context.Context, song *domain.Song, reason string) error {
+	song.Group = strings.TrimSpace(song.Group)
+	if song.Group == "" {
+		return clientErrors.NewErrInvalidInput("group")
+	}
+
+	language, err := domain.ParseLanguage(song.Language)
+	if err != nil {
+		return clientErrors.NewErrInvalidInput("language")
+	}
+
+	song.Language = language
+
+	return s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		before, err := s.songsRepo.GetSongByID(ctx, song.ID)
+		if err != nil {
+			return err
+		}
+
+		groupID, err := s.groupsRepo.UpsertGroup(ctx, song.Group)
+		if err != nil {
+			return err
+		}
+
+		song.GroupID = groupID
+
+		err = s.songsRepo.UpdateSong(ctx, song, domain.RevisionInfo{
+			Author: domain.ActorFrom(ctx),
+			Reason: strings.TrimSpace(reason),
+		})
+		if err != nil {
+			return err
+		}
+
+		after, err := s.songsRepo.GetSongByID(ctx, song.ID)
+		if err != nil {
+			return err
+		}
+
+		*song = *after
+
+		return recordAudit(ctx, s.auditRepo, domain.AuditEntitySong, song.ID, domain.AuditActionUpdate, before, after)
+	})
+}
+
+// PatchSong applies a JSON merge patch to the editable fields of the song and stores the
+// result like UpdateSong, returning the song as stored. An empty patch changes nothing.
+// The update expects the version the patch was applied to, so concurrent changes are not
+// overwritten, and a non-zero version has to be the current one beforehand.
+func (s *SongsService) PatchSong(ctx context.Context, id, version int, patch []byte, reason string) (*domain.Song, error) {
+	var changes map[string]any
+	if err := json.Unmarshal(patch, &changes); err != nil || changes == nil {
+		return nil, clientErrors.NewErrInvalidInput("patch")
+	}
+
+	for name, value := range changes {
+		if !slices.Contains(domain.PatchableSongFields, name) {
+			return nil, clientErrors.NewErrInvalidInput(name)
+		}
+
+		if value == nil && slices.Contains(domain.RequiredSongFields, name) {
+			return nil, clientErrors.NewErrInvalidInput(name)
+		}
+	}
+
+	if len(changes) == 0 {
+		song, err := s.songsRepo.GetSongByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if version != 0 && song.Version != version {
+			return nil, songChanged(id, version)
+		}
+
+		return song, nil
+	}
+
+	var song *domain.Song
+
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		current, err := s.songsRepo.GetSongByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if version != 0 && current.Version != version {
+			return songChanged(id, version)
+		}
+
+		document, err := patchSongDocument(domain.NewSongDocument(current), changes)
+		if err != nil {
+			return err
+		}
+
+		song = &domain.Song{
+			ID:          id,
+			Group:       document.Group,
+			Song:        document.Song,
+			ReleaseDate: document.ReleaseDate,
+			Text:        document.Text,
+			Link:        document.Link,
+			Language:    document.Language,
+			Version:     current.Version,
+		}
+
+		return s.UpdateSong(ctx, song, reason)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return song, nil
+}
+
+func songChanged(id, version int) error {
+	return clientErrors.NewErrPreconditionFailed(fmt.Sprintf("song with id %d changed since version %d", id, version))
+}
+
+// patchSongDocument merges changes into the document through its JSON form, so members are
+// decoded with the same rules as in a full update.
+func patchSongDocument(document domain.SongDocument, changes map[string]any) (*domain.SongDocument, error) {
+	encoded, err := json.Marshal(document)
 	if err != nil {
+		return nil, fmt.Errorf("encoding song document: %w", err)
+	}
+
+	var target map[string]any
+	if err := json.Unmarshal(encoded, &target); err != nil {
+		return nil, fmt.Errorf("decoding song document: %w", err)
+	}
+
+	encoded, err = json.Marshal(domain.MergePatch(target, changes))
+	if err != nil {
+		return nil, fmt.Errorf("encoding patched song document: %w", err)
+	}
+
+	var patched domain.SongDocument
+	if err := json.Unmarshal(encoded, &patched); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) && typeErr.Field != "" {
+			return nil, clientErrors.NewErrInvalidInput(typeErr.Field)
+		}
+
+		var timeErr *time.ParseError
+		if errors.As(err, &timeErr) {
+			return nil, clientErrors.NewErrInvalidInput("release_date")
+		}
+
+		return nil, clientErrors.NewErrInvalidInput("patch")
+	}
+
+	return &patched, nil
+}
+
+func (s *SongsService) GetSongRevisions(
+	ctx context.Context,
+	id int,
+	pageReq domain.PageRequest,
+) (*domain.Page[domain.Revision], error) {
+	page, err := s.songsRepo.GetSongRevisions(ctx, id, pageReq)
+	if err != nil {
+		if errors.As(err, &clientErrors.ErrNotFound{}) {
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("getting song revisions: %w", err)
+	}
+
+	return page, nil
+}
+
+func (s *SongsService) GetSongRevision(ctx context.Context, id, revision int) (*domain.Revision, error) {
+	return s.songsRepo.GetSongRevision(ctx, id, revision)
+}
+
+// DiffSongRevisions returns the unified diff of the lyrics between two revisions.
+func (s *SongsService) DiffSongRevisions(ctx context.Context, id, from, to int) (string, error) {
+	fromRevision, err := s.songsRepo.GetSongRevision(ctx, id, from)
+	if err != nil {
+		return "", err
+	}
+
+	toRevision, err := s.songsRepo.GetSongRevision(ctx, id, to)
+	if err != nil {
+		return "", err
+	}
+
+	return domain.UnifiedDiff(
+		fmt.Sprintf("revision %d", from),
+		fmt.Sprintf("revision %d", to),
+		domain.SplitLines(fromRevision.Text),
+		domain.SplitLines(toRevision.Text),
+	), nil
+}
+
+// RestoreSongRevision brings the song back to the state of a revision, the restore itself
+// is recorded as a new revision.
+func (s *SongsService) RestoreSongRevision(ctx context.Context, id, revision int, reason string) (*domain.Song, error) {
+	restored, err := s.songsRepo.GetSongRevision(ctx, id, revision)
+	if err != nil {
+		return nil, err
+	}
+
+	if reason = strings.TrimSpace(reason); reason == "" {
+		reason = fmt.Sprintf("restore of revision %d", revision)
+	}
+
+	song := domain.Song{
+		ID:          id,
+		Group:       restored.Group,
+		Song:        restored.Song,
+		ReleaseDate: restored.ReleaseDate,
+		Text:        restored.Text,
+		Link:        restored.Link,
+		Language:    restored.Language,
+	}
+
+	if err := s.UpdateSong(ctx, &song, reason); err != nil {
+		return nil, err
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"id":       id,
+		"revision": revision,
+	}).Info("Song revision restored")
+
+	return &song, nil
+}
+
+// SetSongArtists replaces the credited artists of a song, groups are resolved through their
+// aliases and created when missing. The first primary artist becomes the group of the song.
+func (s *SongsService) SetSongArtists(ctx context.Context, id int, req *domain.SongArtistsRequest) (*domain.Song, error) {
+	artists := make([]domain.SongArtist, 0, len(req.Artists))
+
+	var song *domain.Song
+
+	// Groups are created for new artist names, the transaction drops them again when the
+	// credits turn out to be invalid.
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		hasPrimary := false
+
+		for _, credit := range req.Artists {
+			role, err := domain.ParseArtistRole(string(credit.Role))
+			if err != nil || role == "" {
+				return clientErrors.NewErrInvalidInput("role")
+			}
+
+			name := strings.TrimSpace(credit.Group)
+			if name == "" {
+				return clientErrors.NewErrInvalidInput("group")
+			}
+
+			groupID, err := s.groupsRepo.UpsertGroup(ctx, name)
+			if err != nil {
+				return err
+			}
+
+			artist := domain.SongArtist{GroupID: groupID, Name: name, Role: role}
+			if slices.ContainsFunc(artists, func(a domain.SongArtist) bool {
+				return a.GroupID == artist.GroupID && a.Role == artist.Role
+			}) {
+				continue
+			}
+
+			hasPrimary = hasPrimary || role == domain.RolePrimary
+			artists = append(artists, artist)
+		}
+
+		if !hasPrimary {
+			return clientErrors.NewErrInvalidInput("artists")
+		}
+
+		before, err := s.songsRepo.GetSongByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := s.songsRepo.SetSongArtists(ctx, id, artists); err != nil {
+			return err
+		}
+
+		if song, err = s.songsRepo.GetSongByID(ctx, id); err != nil {
+			return err
+		}
+
+		return recordAudit(ctx, s.auditRepo, domain.AuditEntitySong, id, domain.AuditActionSetArtists, before, song)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"id":      id,
+		"artists": artists,
+	}).Info("Song artists replaced")
+
+	return song, nil
+}
+
+func (s *SongsService) AddSong(ctx context.Context, songReq *domain.AddSongRequest) (int, error) {
+	language, err := domain.ParseLanguage(songReq.Language)
+	if err != nil {
+		return 0, clientErrors.NewErrInvalidInput("language")
+	}
+
+	metadata, err := s.metadata.Lookup(ctx, songReq.Group, songReq.Song)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"error": err,
+			"group": songReq.Group,
+			"song":  songReq.Song,
+		}).Error("Failed to get song metadata")
+
 		return 0, err
 	}
 
 	song := domain.Song{
-		GroupID:     groupID,
-		Group:       songReq.Group,
-		Song:        songReq.Song,
-		ReleaseDate: response.JSON200.ReleaseDate.Time,
-		Text:        response.JSON200.Text,
-		Link:        response.JSON200.Link,
+		Group:    songReq.Group,
+		Song:     songReq.Song,
+		Language: language,
+		Status:   domain.SongStatusEnriched,
 	}
 
-	songID, err := s.songsRepo.AddSong(ctx, &song)
+	// The metadata is looked up beforehand so the transaction is not held open during the
+	// external calls.
+	var songID int
+
+	err = s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		groupID, err := s.groupsRepo.UpsertGroup(ctx, songReq.Group)
+		if err != nil {
+			return err
+		}
+
+		song.GroupID = groupID
+
+		if err := s.applyMetadata(ctx, &song, metadata); err != nil {
+			return err
+		}
+
+		if songID, err = s.songsRepo.AddSong(ctx, &song); err != nil {
+			return err
+		}
+
+		song.ID = songID
+
+		return recordAudit(ctx, s.auditRepo, domain.AuditEntitySong, songID, domain.AuditActionCreate, nil, &song)
+	})
 	if err != nil {
 		return 0, err
 	}
@@ -157,3 +680,163 @@ func (s *SongsService) AddSong(ctx context.Context, songReq *domain.AddSongReque
 
 	return songID, nil
 }
+
+// QueueSong adds the song as pending without asking the metadata providers, it is filled in
+// later by EnrichPendingSong.
+func (s *SongsService) QueueSong(ctx context.Context, songReq *domain.AddSongRequest) (int, error) {
+	language, err := domain.ParseLanguage(songReq.Language)
+	if err != nil {
+		return 0, clientErrors.NewErrInvalidInput("language")
+	}
+
+	var songID int
+
+	err = s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		groupID, err := s.groupsRepo.UpsertGroup(ctx, songReq.Group)
+		if err != nil {
+			return err
+		}
+
+		song := domain.Song{
+			GroupID:  groupID,
+			Group:    songReq.Group,
+			Song:     songReq.Song,
+			Language: language,
+			Status:   domain.SongStatusPending,
+		}
+
+		if songID, err = s.songsRepo.AddSong(ctx, &song); err != nil {
+			return err
+		}
+
+		song.ID = songID
+
+		return recordAudit(ctx, s.auditRepo, domain.AuditEntitySong, songID, domain.AuditActionCreate, nil, &song)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	logrus.WithField("id", songID).Info("New song queued for enrichment")
+
+	return songID, nil
+}
+
+// EnrichPendingSong claims the oldest pending song and fills it in from the metadata providers,
+// returning false when there is none. The lease is how long the claim keeps other workers off
+// the song. Provider failures are recorded on the song rather than returned.
+func (s *SongsService) EnrichPendingSong(ctx context.Context, lease time.Duration) (bool, error) {
+	ctx = domain.WithActor(ctx, enrichmentActor)
+
+	song, err := s.songsRepo.ClaimPendingSong(ctx, lease)
+	if err != nil || song == nil {
+		return false, err
+	}
+
+	// The lookup may take a while with retries, it runs outside of any transaction.
+	metadata, enrichErr := s.metadata.Lookup(ctx, song.Group, song.Song)
+	if ctx.Err() != nil {
+		logrus.WithField("id", song.ID).Info("Song enrichment interrupted, it is claimed again once its lease expires")
+
+		return false, nil
+	}
+
+	before := *song
+
+	err = s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		// The enriched song is stored in a savepoint, so the failure of any of its writes
+		// can still be recorded on the song.
+		if enrichErr == nil {
+			enrichErr = s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+				if err := s.applyMetadata(ctx, song, metadata); err != nil {
+					return err
+				}
+
+				if err := s.songsRepo.CompleteEnrichment(ctx, song); err != nil {
+					return err
+				}
+
+				return recordAudit(ctx, s.auditRepo, domain.AuditEntitySong, song.ID, domain.AuditActionEnrich, before, song)
+			})
+		}
+
+		if enrichErr == nil {
+			return nil
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"error": enrichErr,
+			"id":    song.ID,
+		}).Warn("Failed to enrich song")
+
+		failed := before
+
+		if err := s.songsRepo.FailEnrichment(ctx, &failed, enrichmentError(enrichErr).Error()); err != nil {
+			return err
+		}
+
+		return recordAudit(ctx, s.auditRepo, domain.AuditEntitySong, song.ID, domain.AuditActionEnrich, before, failed)
+	})
+	if err != nil {
+		return true, fmt.Errorf("storing song enrichment: %w", err)
+	}
+
+	if enrichErr == nil {
+		logrus.WithField("id", song.ID).Info("Song enriched")
+	}
+
+	return true, nil
+}
+
+func (s *SongsService) GetSongStatus(ctx context.Context, id int) (*domain.Song, error) {
+	return s.songsRepo.GetSongByID(ctx, id)
+}
+
+// applyMetadata copies the looked up details into the song and links its album.
+func (s *SongsService) applyMetadata(ctx context.Context, song *domain.Song, metadata *domain.SongMetadata) error {
+	song.ReleaseDate = metadata.ReleaseDate
+	song.Text = metadata.Text
+	song.Link = metadata.Link
+	song.Sources = metadata.Sources
+
+	if album := metadata.Album; album != nil {
+		return s.attachAlbum(ctx, song, album)
+	}
+
+	return nil
+}
+
+// enrichmentError is the reason recorded on a song that could not be enriched.
+func enrichmentError(err error) error {
+	switch err := err.(type) {
+	case clientErrors.ErrNotFound:
+		return errors.New("song not found by any metadata provider")
+	case clientErrors.ErrExternal:
+		return fmt.Errorf("metadata providers unavailable: %w", err.Err)
+	default:
+		return err
+	}
+}
+
+// attachAlbum links the song to the album reported by the metadata providers, creating it
+// for the song's group when it is not known yet.
+func (s *SongsService) attachAlbum(ctx context.Context, song *domain.Song, info *domain.AlbumMetadata) error {
+	album := domain.Album{
+		GroupID:     song.GroupID,
+		Group:       song.Group,
+		Title:       info.Title,
+		ReleaseDate: info.ReleaseDate,
+		CoverLink:   info.CoverLink,
+	}
+
+	albumID, err := s.albumsRepo.UpsertAlbum(ctx, &album)
+	if err != nil {
+		return err
+	}
+
+	song.AlbumID = &albumID
+	song.Album = album.Title
+	song.TrackNumber = info.TrackNumber
+
+	return nil
+}
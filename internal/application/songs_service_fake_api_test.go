@@ -0,0 +1,82 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	client "github.com/mashfeii/songs_library/internal/api"
+	"github.com/mashfeii/songs_library/internal/application"
+	"github.com/mashfeii/songs_library/internal/domain"
+	"github.com/mashfeii/songs_library/internal/fakemusicinfo"
+	"github.com/mashfeii/songs_library/internal/infrastructure/musicinfo"
+	"github.com/mashfeii/songs_library/internal/mocks"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	clientErrors "github.com/mashfeii/songs_library/internal/infrastructure/errors"
+)
+
+// TestSongsService_AddSong_FakeMusicInfo adds songs through the generated client and the
+// resilient client talking to the in-process fake Music Info API.
+func TestSongsService_AddSong_FakeMusicInfo(t *testing.T) {
+	releaseDate := time.Date(1997, 8, 25, 0, 0, 0, 0, time.UTC)
+	server, fake := fakemusicinfo.NewTestServer(t, []fakemusicinfo.Fixture{{
+		Group: "Radiohead",
+		Song:  "Karma Police",
+		SongDetail: client.SongDetail{
+			ReleaseDate: openapi_types.Date{Time: releaseDate},
+			Text:        "Karma police, arrest this man",
+			Link:        "https://www.youtube.com/watch?v=IBH4g_ua5es",
+		},
+	}}, fakemusicinfo.Options{})
+
+	generated, err := client.NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+
+	resilient := musicinfo.NewResilientClient(generated, musicinfo.Options{
+		Timeout:          time.Second,
+		MaxRetries:       1,
+		BackoffBase:      time.Millisecond,
+		BackoffMax:       time.Millisecond,
+		FailureThreshold: 10,
+		Cooldown:         time.Minute,
+	})
+
+	mockSongsRepo := mocks.NewSongsRepositoryMock(t)
+	mockGroupsRepo := mocks.NewGroupsRepositoryMock(t)
+	service := application.NewSongsService(mockSongsRepo, mockGroupsRepo, nil, nil, nil, nil,
+		application.NewProviderChain(musicinfo.NewAPIProvider(resilient)), &txRecorder{}, &auditRecorder{})
+	req := &domain.AddSongRequest{Group: "Radiohead", Song: "Karma Police"}
+
+	t.Run("Success", func(t *testing.T) {
+		mockGroupsRepo.On("UpsertGroup", mock.Anything, "Radiohead").Return(3, nil).Once()
+		mockSongsRepo.On("AddSong", mock.Anything, mock.MatchedBy(func(song *domain.Song) bool {
+			return song.GroupID == 3 && song.ReleaseDate.Equal(releaseDate) &&
+				song.Link == "https://www.youtube.com/watch?v=IBH4g_ua5es" &&
+				song.Sources[domain.MetadataFieldText] == musicinfo.APIProviderName
+		})).Return(12, nil).Once()
+
+		id, err := service.AddSong(context.Background(), req)
+		require.NoError(t, err)
+		assert.Equal(t, 12, id)
+	})
+
+	t.Run("UnknownSong", func(t *testing.T) {
+		_, err := service.AddSong(context.Background(), &domain.AddSongRequest{Group: "Radiohead", Song: "Creep"})
+		assert.True(t, errors.As(err, &clientErrors.ErrNotFound{}))
+	})
+
+	t.Run("ProviderDown", func(t *testing.T) {
+		fake.SetOptions(fakemusicinfo.Options{Status: http.StatusServiceUnavailable})
+		calls := fake.Calls()
+
+		_, err := service.AddSong(context.Background(), req)
+		assert.True(t, errors.As(err, &clientErrors.ErrExternal{}))
+		assert.Equal(t, 2, fake.Calls()-calls, "the failed lookup is retried once")
+	})
+}
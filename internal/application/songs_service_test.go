@@ -2,7 +2,9 @@ package application_test
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -15,14 +17,63 @@ import (
 	clientErrors "github.com/mashfeii/songs_library/internal/infrastructure/errors"
 )
 
+// txRecorder runs the work of a transaction directly and counts commits and rollbacks, the
+// context of the work is marked so repository calls can be checked to take part in it.
+type txRecorder struct {
+	committed  int
+	rolledBack int
+}
+
+type inTxKey struct{}
+
+var inTx = mock.MatchedBy(func(ctx context.Context) bool { return ctx.Value(inTxKey{}) != nil })
+
+func (r *txRecorder) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if err := fn(context.WithValue(ctx, inTxKey{}, true)); err != nil {
+		r.rolledBack++
+
+		return err
+	}
+
+	r.committed++
+
+	return nil
+}
+
+// auditRecorder keeps the audit events of a test, outsideTx counts those recorded outside
+// of a transaction.
+type auditRecorder struct {
+	events    []domain.AuditEvent
+	outsideTx int
+}
+
+func (r *auditRecorder) AddAuditEvent(ctx context.Context, event *domain.AuditEvent) error {
+	if ctx.Value(inTxKey{}) == nil {
+		r.outsideTx++
+	}
+
+	r.events = append(r.events, *event)
+
+	return nil
+}
+
+func (r *auditRecorder) GetAuditEvents(
+	context.Context,
+	domain.AuditFilter,
+	domain.PageRequest,
+) (*domain.Page[domain.AuditEvent], error) {
+	return &domain.Page[domain.AuditEvent]{Items: []domain.AuditEvent{}}, nil
+}
+
 func TestSongsService_GetSongs(t *testing.T) {
 	mockSongsRepo := mocks.NewSongsRepositoryMock(t)
 	mockGroupsRepo := mocks.NewGroupsRepositoryMock(t)
 
-	service := application.NewSongsService(mockSongsRepo, mockGroupsRepo, nil)
+	service := application.NewSongsService(mockSongsRepo, mockGroupsRepo, nil, nil, nil, nil, nil, &txRecorder{}, &auditRecorder{})
+	pageReq := domain.PageRequest{Page: 1, Size: 10}
 
 	t.Run("Success", func(t *testing.T) {
-		mockSongsRepo.On("GetSongs", mock.Anything, mock.Anything, 1, 10).Return([]domain.Song{
+		mockSongsRepo.On("GetSongs", mock.Anything, mock.Anything, pageReq).Return(&domain.Page[domain.Song]{Items: []domain.Song{
 			{
 				ID:          1,
 				GroupID:     1,
@@ -32,22 +83,867 @@ func TestSongsService_GetSongs(t *testing.T) {
 				Text:        "Oh baby dont you know I suffer",
 				Link:        "https://www.youtube.com/watch?v=UqLRqzTp6Rk",
 			},
-		}, nil).Once()
+		}, Total: 1, Page: 1, Size: 10}, nil).Once()
 
-		songs, err := service.GetSongs(context.Background(), map[string]string{}, 1, 10)
+		songs, err := service.GetSongs(context.Background(), &domain.SongFilter{}, pageReq)
 		assert.NoError(t, err)
-		assert.Len(t, songs, 1)
-		assert.Equal(t, "Muse", songs[0].Group)
+		assert.Len(t, songs.Items, 1)
+		assert.Equal(t, 1, songs.Total)
+		assert.Equal(t, "Muse", songs.Items[0].Group)
 		mockSongsRepo.AssertExpectations(t)
 	})
 
 	t.Run("NoSongsFound", func(t *testing.T) {
-		mockSongsRepo.On("GetSongs", mock.Anything, mock.Anything, 1, 10).Return([]domain.Song{}, nil).Once()
+		mockSongsRepo.On("GetSongs", mock.Anything, mock.Anything, pageReq).
+			Return(&domain.Page[domain.Song]{Items: []domain.Song{}, Page: 1, Size: 10}, nil).Once()
 
-		songs, err := service.GetSongs(context.Background(), map[string]string{}, 1, 10)
+		songs, err := service.GetSongs(context.Background(), &domain.SongFilter{}, pageReq)
+		assert.NoError(t, err)
+		assert.Empty(t, songs.Items)
+		assert.Equal(t, 0, songs.Total)
+		mockSongsRepo.AssertExpectations(t)
+	})
+}
+
+func TestSongsService_GetSongs_Filter(t *testing.T) {
+	mockSongsRepo := mocks.NewSongsRepositoryMock(t)
+	mockGroupsRepo := mocks.NewGroupsRepositoryMock(t)
+
+	service := application.NewSongsService(mockSongsRepo, mockGroupsRepo, nil, nil, nil, nil, nil, &txRecorder{}, &auditRecorder{})
+	pageReq := domain.PageRequest{Page: 1, Size: 10}
+
+	t.Run("DropsEmptyGroups", func(t *testing.T) {
+		mockSongsRepo.On("GetSongs", mock.Anything, mock.MatchedBy(func(filter *domain.SongFilter) bool {
+			return assert.ObjectsAreEqual([]string{"Muse", "Queen"}, filter.Groups)
+		}), pageReq).Return(&domain.Page[domain.Song]{Items: []domain.Song{{ID: 1, Group: "Muse"}}, Total: 1}, nil).Once()
+
+		songs, err := service.GetSongs(context.Background(), &domain.SongFilter{Groups: []string{" Muse ", "", "Queen"}}, pageReq)
+		assert.NoError(t, err)
+		assert.Len(t, songs.Items, 1)
+	})
+
+	t.Run("NormalizesTags", func(t *testing.T) {
+		mockSongsRepo.On("GetSongs", mock.Anything, mock.MatchedBy(func(filter *domain.SongFilter) bool {
+			return assert.ObjectsAreEqual([]string{"workout", "indie rock"}, filter.Tags)
+		}), pageReq).Return(&domain.Page[domain.Song]{Items: []domain.Song{{ID: 2}}, Total: 1}, nil).Once()
+
+		songs, err := service.GetSongs(context.Background(), &domain.SongFilter{
+			Tags:     []string{"Workout", " ", "Indie  Rock", "workout"},
+			TagMatch: domain.TagMatchAll,
+		}, pageReq)
+		assert.NoError(t, err)
+		assert.Len(t, songs.Items, 1)
+	})
+
+	t.Run("InvalidReleaseRange", func(t *testing.T) {
+		from := time.Date(2010, 1, 1, 0, 0, 0, 0, time.UTC)
+		to := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		songs, err := service.GetSongs(context.Background(), &domain.SongFilter{ReleasedFrom: &from, ReleasedTo: &to}, pageReq)
 		assert.Error(t, err)
 		assert.Nil(t, songs)
-		assert.True(t, errors.As(err, &clientErrors.ErrNotFound{}))
+		assert.True(t, errors.As(err, &clientErrors.ErrInvalidInput{}))
+	})
+}
+
+func TestSongsService_GetSongVerses(t *testing.T) {
+	mockSongsRepo := mocks.NewSongsRepositoryMock(t)
+	mockLyricsRepo := mocks.NewLyricsRepositoryMock(t)
+
+	service := application.NewSongsService(mockSongsRepo, nil, nil, mockLyricsRepo, nil, nil, nil, &txRecorder{}, &auditRecorder{})
+	notSynced := clientErrors.NewErrNotFound("synced lyrics")
+
+	text := "[Verse 1]\nFirst line\nSecond line\n\n[Chorus]\nSing along\nOh oh\n\nThird line\n\nSing along\nOh oh\n\n[Chorus]"
+
+	t.Run("Stanzas", func(t *testing.T) {
+		mockSongsRepo.On("GetSongByID", mock.Anything, 1).Return(&domain.Song{ID: 1, Text: text}, nil).Once()
+		mockLyricsRepo.On("GetSyncedLyrics", mock.Anything, 1).Return(nil, notSynced).Once()
+
+		verses, total, err := service.GetSongVerses(context.Background(), 1, domain.VersesOptions{
+			Unit: domain.VerseUnitStanza,
+			Page: 1,
+			Size: 10,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 5, total)
+		encoded, _ := json.Marshal(verses)
+		assert.JSONEq(t, `[
+			{"label":"Verse 1","line":2,"lines":["First line","Second line"]},
+			{"label":"Chorus","line":6,"lines":["Sing along","Oh oh"]},
+			{"line":9,"lines":["Third line"]},
+			{"label":"Chorus","line":11,"lines":["Sing along","Oh oh"],"repeatOf":2},
+			{"label":"Chorus","line":14,"lines":["Sing along","Oh oh"],"repeatOf":2}
+		]`, string(encoded))
+	})
+
+	t.Run("Annotations", func(t *testing.T) {
+		mockAnnotationsRepo := mocks.NewAnnotationsRepositoryMock(t)
+		service := application.NewSongsService(mockSongsRepo, nil, nil, mockLyricsRepo, mockAnnotationsRepo, nil, nil, &txRecorder{}, &auditRecorder{})
+
+		mockSongsRepo.On("GetSongByID", mock.Anything, 1).Return(&domain.Song{ID: 1, Text: text}, nil).Once()
+		mockLyricsRepo.On("GetSyncedLyrics", mock.Anything, 1).Return(nil, notSynced).Once()
+		mockAnnotationsRepo.On("GetAnnotations", mock.Anything, 1).Return([]domain.Annotation{
+			{ID: 1, SongID: 1, StartLine: 3, EndLine: 6, Body: "Bridge", Anchor: "Second line\n\n[Chorus]\nSing along"},
+		}, nil).Once()
+
+		verses, _, err := service.GetSongVerses(context.Background(), 1, domain.VersesOptions{
+			Unit:        domain.VerseUnitStanza,
+			Annotations: true,
+			Page:        1,
+			Size:        3,
+		})
+		assert.NoError(t, err)
+		assert.Len(t, verses[0].Annotations, 1)
+		assert.Len(t, verses[1].Annotations, 1)
+		assert.Empty(t, verses[2].Annotations)
+	})
+
+	t.Run("Translation", func(t *testing.T) {
+		mockTranslationsRepo := mocks.NewTranslationsRepositoryMock(t)
+		service := application.NewSongsService(mockSongsRepo, nil, nil, mockLyricsRepo, nil, mockTranslationsRepo, nil, &txRecorder{}, &auditRecorder{})
+
+		song := &domain.Song{ID: 1, Language: "en", Text: "First line\nSecond line\n\nThird line"}
+		translation := &domain.Translation{SongID: 1, Language: "de", Text: "Erste Zeile\nZweite Zeile\n\nDritte Zeile"}
+
+		mockSongsRepo.On("GetSongByID", mock.Anything, 1).Return(song, nil).Twice()
+		mockTranslationsRepo.On("GetTranslation", mock.Anything, 1, "de").Return(translation, nil).Twice()
+		mockLyricsRepo.On("GetSyncedLyrics", mock.Anything, 1).Return(nil, notSynced).Once()
+
+		verses, total, err := service.GetSongVerses(context.Background(), 1, domain.VersesOptions{
+			Unit:     domain.VerseUnitLine,
+			Language: "de",
+			Page:     1,
+			Size:     2,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 3, total)
+		encoded, _ := json.Marshal(verses)
+		assert.JSONEq(t, `[{"line":1,"lines":["Erste Zeile"]},{"line":2,"lines":["Zweite Zeile"]}]`, string(encoded))
+
+		verses, total, err = service.GetSongVerses(context.Background(), 1, domain.VersesOptions{
+			Unit:       domain.VerseUnitLine,
+			Language:   "de",
+			SideBySide: true,
+			Page:       2,
+			Size:       2,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 3, total)
+		encoded, _ = json.Marshal(verses)
+		assert.JSONEq(t, `[{"line":4,"lines":["Third line"],"translation":["Dritte Zeile"]}]`, string(encoded))
+	})
+
+	t.Run("OriginalLanguage", func(t *testing.T) {
+		mockSongsRepo.On("GetSongByID", mock.Anything, 1).Return(&domain.Song{ID: 1, Language: "en", Text: "Line"}, nil).Once()
+		mockLyricsRepo.On("GetSyncedLyrics", mock.Anything, 1).Return(nil, notSynced).Once()
+
+		verses, _, err := service.GetSongVerses(context.Background(), 1, domain.VersesOptions{
+			Language: "en",
+			Page:     1,
+			Size:     1,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Line"}, verses[0].Lines)
+	})
+
+	t.Run("CollapsedLines", func(t *testing.T) {
+		mockSongsRepo.On("GetSongByID", mock.Anything, 1).Return(&domain.Song{ID: 1, Text: text}, nil).Once()
+		mockLyricsRepo.On("GetSyncedLyrics", mock.Anything, 1).Return(nil, notSynced).Once()
+
+		verses, total, err := service.GetSongVerses(context.Background(), 1, domain.VersesOptions{
+			Unit:     domain.VerseUnitLine,
+			Collapse: true,
+			Page:     2,
+			Size:     3,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 5, total)
+		assert.Equal(t, []domain.Verse{
+			{Label: "Chorus", Line: 7, Lines: []string{"Oh oh"}},
+			{Line: 9, Lines: []string{"Third line"}},
+		}, verses)
+	})
+
+	t.Run("Timestamps", func(t *testing.T) {
+		mockSongsRepo.On("GetSongByID", mock.Anything, 1).Return(&domain.Song{ID: 1, Text: text}, nil).Once()
+		mockLyricsRepo.On("GetSyncedLyrics", mock.Anything, 1).Return(&domain.SyncedLyrics{SongID: 1, Lines: []domain.SyncedLine{
+			{TimeMs: 1000, Text: "First line"},
+			{TimeMs: 4000, Text: "Second line"},
+			{TimeMs: 8000, Text: "Sing along"},
+			{TimeMs: 11000, Text: "Oh oh"},
+			{TimeMs: 20000, Text: "Sing along"},
+		}}, nil).Once()
+
+		verses, _, err := service.GetSongVerses(context.Background(), 1, domain.VersesOptions{
+			Unit: domain.VerseUnitStanza,
+			Page: 1,
+			Size: 4,
+		})
+		assert.NoError(t, err)
+
+		encoded, _ := json.Marshal(verses)
+		assert.JSONEq(t, `[
+			{"label":"Verse 1","line":2,"lines":["First line","Second line"],"times":[1000,4000]},
+			{"label":"Chorus","line":6,"lines":["Sing along","Oh oh"],"times":[8000,11000]},
+			{"line":9,"lines":["Third line"]},
+			{"label":"Chorus","line":11,"lines":["Sing along","Oh oh"],"times":[20000,null],"repeatOf":2}
+		]`, string(encoded))
+	})
+
+	t.Run("PageOutOfRange", func(t *testing.T) {
+		mockSongsRepo.On("GetSongByID", mock.Anything, 1).Return(&domain.Song{ID: 1, Text: text}, nil).Once()
+		mockLyricsRepo.On("GetSyncedLyrics", mock.Anything, 1).Return(nil, notSynced).Once()
+
+		verses, _, err := service.GetSongVerses(context.Background(), 1, domain.VersesOptions{
+			Unit: domain.VerseUnitStanza,
+			Page: 3,
+			Size: 5,
+		})
+		assert.Nil(t, verses)
+		assert.True(t, errors.As(err, &clientErrors.ErrInvalidInput{}))
+	})
+}
+
+func TestSongsService_SearchSongs(t *testing.T) {
+	mockSongsRepo := mocks.NewSongsRepositoryMock(t)
+	mockGroupsRepo := mocks.NewGroupsRepositoryMock(t)
+
+	service := application.NewSongsService(mockSongsRepo, mockGroupsRepo, nil, nil, nil, nil, nil, &txRecorder{}, &auditRecorder{})
+
+	t.Run("Success", func(t *testing.T) {
+		mockSongsRepo.On("SearchSongs", mock.Anything, "suffer", 1, 10).Return([]domain.SongSearchResult{
+			{
+				Song:     domain.Song{ID: 1, Group: "Muse", Song: "Supermassive Black Hole"},
+				Rank:     0.6,
+				Headline: "Oh baby dont you know I <b>suffer</b>",
+			},
+		}, nil).Once()
+
+		results, err := service.SearchSongs(context.Background(), "  suffer ", 1, 10)
+		assert.NoError(t, err)
+		assert.Len(t, results, 1)
+		assert.Equal(t, "Muse", results[0].Group)
+		mockSongsRepo.AssertExpectations(t)
+	})
+
+	t.Run("EmptyQuery", func(t *testing.T) {
+		results, err := service.SearchSongs(context.Background(), "   ", 1, 10)
+		assert.Error(t, err)
+		assert.Nil(t, results)
+		assert.True(t, errors.As(err, &clientErrors.ErrInvalidInput{}))
+	})
+
+	t.Run("NoSongsFound", func(t *testing.T) {
+		mockSongsRepo.On("SearchSongs", mock.Anything, "nothing", 1, 10).Return([]domain.SongSearchResult{}, nil).Once()
+
+		results, err := service.SearchSongs(context.Background(), "nothing", 1, 10)
+		assert.NoError(t, err)
+		assert.Empty(t, results)
 		mockSongsRepo.AssertExpectations(t)
 	})
 }
+
+func TestSongsService_AddSong(t *testing.T) {
+	mockSongsRepo := mocks.NewSongsRepositoryMock(t)
+	mockGroupsRepo := mocks.NewGroupsRepositoryMock(t)
+	mockAlbumsRepo := mocks.NewAlbumsRepositoryMock(t)
+	mockProvider := mocks.NewMetadataProviderMock(t)
+
+	service := application.NewSongsService(mockSongsRepo, mockGroupsRepo, mockAlbumsRepo, nil, nil, nil, mockProvider, &txRecorder{}, &auditRecorder{})
+	req := &domain.AddSongRequest{Group: "Muse", Song: "Supermassive Black Hole"}
+	releaseDate := time.Date(2006, 6, 19, 0, 0, 0, 0, time.UTC)
+
+	t.Run("AttachesAlbum", func(t *testing.T) {
+		track := 3
+		sources := domain.MetadataSources{
+			domain.MetadataFieldReleaseDate: "music-info",
+			domain.MetadataFieldText:        "music-info",
+			domain.MetadataFieldLink:        "catalog",
+			domain.MetadataFieldAlbum:       "music-info",
+		}
+
+		mockProvider.On("Lookup", mock.Anything, "Muse", "Supermassive Black Hole").
+			Return(&domain.SongMetadata{
+				ReleaseDate: releaseDate,
+				Text:        "Oh baby dont you know I suffer",
+				Link:        "https://www.youtube.com/watch?v=Xsp3_a-PMTw",
+				Album:       &domain.AlbumMetadata{Title: "Black Holes and Revelations", TrackNumber: &track},
+				Sources:     sources,
+			}, nil).Once()
+		mockGroupsRepo.On("UpsertGroup", mock.Anything, "Muse").Return(1, nil).Once()
+		mockAlbumsRepo.On("UpsertAlbum", mock.Anything, mock.MatchedBy(func(album *domain.Album) bool {
+			return album.GroupID == 1 && album.Title == "Black Holes and Revelations"
+		})).Return(7, nil).Once()
+		mockSongsRepo.On("AddSong", mock.Anything, mock.MatchedBy(func(song *domain.Song) bool {
+			return song.GroupID == 1 && *song.AlbumID == 7 && *song.TrackNumber == 3 &&
+				song.Sources[domain.MetadataFieldLink] == "catalog"
+		})).Return(10, nil).Once()
+
+		id, err := service.AddSong(context.Background(), req)
+		assert.NoError(t, err)
+		assert.Equal(t, 10, id)
+	})
+
+	t.Run("ExternalError", func(t *testing.T) {
+		mockProvider.On("Lookup", mock.Anything, "Muse", "Supermassive Black Hole").
+			Return(nil, clientErrors.NewErrExternal(errors.New("status code: 500"))).Once()
+
+		id, err := service.AddSong(context.Background(), req)
+		assert.Zero(t, id)
+		assert.True(t, errors.As(err, &clientErrors.ErrExternal{}))
+	})
+}
+
+func TestSongsService_Enrichment(t *testing.T) {
+	mockSongsRepo := mocks.NewSongsRepositoryMock(t)
+	mockGroupsRepo := mocks.NewGroupsRepositoryMock(t)
+	mockProvider := mocks.NewMetadataProviderMock(t)
+
+	audit := &auditRecorder{}
+	service := application.NewSongsService(mockSongsRepo, mockGroupsRepo, nil, nil, nil, nil, mockProvider, &txRecorder{}, audit)
+	releaseDate := time.Date(2006, 6, 19, 0, 0, 0, 0, time.UTC)
+
+	claimedAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	claimed := func(id int, name string) *domain.Song {
+		return &domain.Song{
+			ID:        id,
+			GroupID:   1,
+			Group:     "Muse",
+			Song:      name,
+			Status:    domain.SongStatusProcessing,
+			Version:   2,
+			ClaimedAt: &claimedAt,
+		}
+	}
+
+	t.Run("QueueSong", func(t *testing.T) {
+		mockGroupsRepo.On("UpsertGroup", mock.Anything, "Muse").Return(1, nil).Once()
+		mockSongsRepo.On("AddSong", mock.Anything, mock.MatchedBy(func(song *domain.Song) bool {
+			return song.GroupID == 1 && song.Status == domain.SongStatusPending && song.Text == ""
+		})).Return(10, nil).Once()
+
+		id, err := service.QueueSong(context.Background(), &domain.AddSongRequest{Group: "Muse", Song: "Starlight"})
+		assert.NoError(t, err)
+		assert.Equal(t, 10, id)
+
+		if assert.Len(t, audit.events, 1) {
+			assert.Equal(t, domain.AuditActionCreate, audit.events[0].Action)
+			assert.Equal(t, 10, *audit.events[0].EntityID)
+			assert.Contains(t, string(audit.events[0].After), `"status":"pending"`)
+		}
+	})
+
+	t.Run("Enriched", func(t *testing.T) {
+		tx := &txRecorder{}
+		service := application.NewSongsService(mockSongsRepo, mockGroupsRepo, nil, nil, nil, nil, mockProvider, tx, audit)
+
+		mockSongsRepo.On("ClaimPendingSong", mock.Anything, time.Minute).Return(claimed(10, "Starlight"), nil).Once()
+		mockProvider.On("Lookup", mock.MatchedBy(func(ctx context.Context) bool {
+			return ctx.Value(inTxKey{}) == nil
+		}), "Muse", "Starlight").Return(&domain.SongMetadata{
+			ReleaseDate: releaseDate,
+			Text:        "Far away, this ship is taking me far away",
+			Sources:     domain.MetadataSources{domain.MetadataFieldText: "music-info"},
+		}, nil).Once()
+		mockSongsRepo.On("CompleteEnrichment", inTx, mock.MatchedBy(func(song *domain.Song) bool {
+			return song.ID == 10 && song.ReleaseDate.Equal(releaseDate) &&
+				song.Sources[domain.MetadataFieldText] == "music-info" && song.ClaimedAt.Equal(claimedAt)
+		})).Run(func(args mock.Arguments) {
+			song := args.Get(1).(*domain.Song)
+			song.Status, song.ClaimedAt = domain.SongStatusEnriched, nil
+		}).Return(nil).Once()
+
+		enriched, err := service.EnrichPendingSong(context.Background(), time.Minute)
+		assert.NoError(t, err)
+		assert.True(t, enriched)
+		assert.Equal(t, 2, tx.committed)
+
+		if assert.Len(t, audit.events, 2) {
+			event := audit.events[1]
+			assert.Equal(t, "enrichment", event.Actor)
+			assert.Equal(t, domain.AuditActionEnrich, event.Action)
+			assert.Contains(t, string(event.Before), `"status":"processing"`)
+			assert.Contains(t, string(event.After), `"status":"enriched"`)
+		}
+	})
+
+	t.Run("Failed", func(t *testing.T) {
+		mockSongsRepo.On("ClaimPendingSong", mock.Anything, time.Minute).Return(claimed(11, "Unknown"), nil).Once()
+		mockProvider.On("Lookup", mock.Anything, "Muse", "Unknown").
+			Return(nil, clientErrors.NewErrNotFound("song metadata")).Once()
+		mockSongsRepo.On("FailEnrichment", inTx, mock.MatchedBy(func(song *domain.Song) bool {
+			return song.ID == 11 && song.ClaimedAt.Equal(claimedAt)
+		}), "song not found by any metadata provider").Return(nil).Once()
+
+		enriched, err := service.EnrichPendingSong(context.Background(), time.Minute)
+		assert.NoError(t, err)
+		assert.True(t, enriched)
+		assert.Len(t, audit.events, 3)
+	})
+
+	t.Run("FailedWriteIsRecorded", func(t *testing.T) {
+		tx := &txRecorder{}
+		service := application.NewSongsService(mockSongsRepo, mockGroupsRepo, nil, nil, nil, nil, mockProvider, tx, audit)
+
+		mockSongsRepo.On("ClaimPendingSong", mock.Anything, time.Minute).Return(claimed(12, "Uprising"), nil).Once()
+		mockProvider.On("Lookup", mock.Anything, "Muse", "Uprising").
+			Return(&domain.SongMetadata{ReleaseDate: releaseDate}, nil).Once()
+		mockSongsRepo.On("CompleteEnrichment", inTx, mock.Anything).
+			Return(fmt.Errorf("complete enrichment: %w", clientErrors.NewErrDatabase())).Once()
+		mockSongsRepo.On("FailEnrichment", inTx, mock.MatchedBy(func(song *domain.Song) bool {
+			return song.ID == 12
+		}), mock.Anything).Return(nil).Once()
+
+		enriched, err := service.EnrichPendingSong(context.Background(), time.Minute)
+		assert.NoError(t, err)
+		assert.True(t, enriched)
+		assert.Equal(t, 1, tx.rolledBack)
+		assert.Equal(t, 1, tx.committed)
+	})
+
+	t.Run("ClaimExpired", func(t *testing.T) {
+		expired := clientErrors.NewErrConflict("claim of song with id 13 expired")
+
+		mockSongsRepo.On("ClaimPendingSong", mock.Anything, time.Minute).Return(claimed(13, "Hysteria"), nil).Once()
+		mockProvider.On("Lookup", mock.Anything, "Muse", "Hysteria").
+			Return(&domain.SongMetadata{ReleaseDate: releaseDate}, nil).Once()
+		mockSongsRepo.On("CompleteEnrichment", inTx, mock.Anything).Return(expired).Once()
+		mockSongsRepo.On("FailEnrichment", inTx, mock.Anything, mock.Anything).Return(expired).Once()
+
+		enriched, err := service.EnrichPendingSong(context.Background(), time.Minute)
+		assert.True(t, enriched)
+		assert.True(t, errors.As(err, &clientErrors.ErrConflict{}))
+	})
+
+	t.Run("Interrupted", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		mockSongsRepo.On("ClaimPendingSong", mock.Anything, time.Minute).Return(claimed(14, "Madness"), nil).Once()
+		mockProvider.On("Lookup", mock.Anything, "Muse", "Madness").Run(func(mock.Arguments) {
+			cancel()
+		}).Return(nil, context.Canceled).Once()
+
+		enriched, err := service.EnrichPendingSong(ctx, time.Minute)
+		assert.NoError(t, err)
+		assert.False(t, enriched)
+	})
+
+	t.Run("NothingPending", func(t *testing.T) {
+		mockSongsRepo.On("ClaimPendingSong", mock.Anything, time.Minute).Return(nil, nil).Once()
+
+		enriched, err := service.EnrichPendingSong(context.Background(), time.Minute)
+		assert.NoError(t, err)
+		assert.False(t, enriched)
+	})
+}
+
+func TestSongsService_Transactions(t *testing.T) {
+	mockSongsRepo := mocks.NewSongsRepositoryMock(t)
+	mockGroupsRepo := mocks.NewGroupsRepositoryMock(t)
+	mockProvider := mocks.NewMetadataProviderMock(t)
+
+	t.Run("AddSongRollsBack", func(t *testing.T) {
+		tx := &txRecorder{}
+		service := application.NewSongsService(mockSongsRepo, mockGroupsRepo, nil, nil, nil, nil, mockProvider, tx, &auditRecorder{})
+
+		mockProvider.On("Lookup", mock.Anything, "Muse", "Starlight").
+			Return(&domain.SongMetadata{Text: "Far away"}, nil).Once()
+		mockGroupsRepo.On("UpsertGroup", inTx, "Muse").Return(1, nil).Once()
+		mockSongsRepo.On("AddSong", inTx, mock.Anything).
+			Return(0, fmt.Errorf("repo adding song: %w", clientErrors.NewErrDatabase())).Once()
+
+		id, err := service.AddSong(context.Background(), &domain.AddSongRequest{Group: "Muse", Song: "Starlight"})
+		assert.Zero(t, id)
+		assert.True(t, errors.As(err, &clientErrors.ErrDatabase{}))
+		assert.Equal(t, 1, tx.rolledBack)
+		assert.Zero(t, tx.committed)
+	})
+
+	t.Run("LookupFailsBeforeTransaction", func(t *testing.T) {
+		tx := &txRecorder{}
+		service := application.NewSongsService(mockSongsRepo, mockGroupsRepo, nil, nil, nil, nil, mockProvider, tx, &auditRecorder{})
+
+		mockProvider.On("Lookup", mock.Anything, "Muse", "Unknown").
+			Return(nil, clientErrors.NewErrNotFound("song metadata")).Once()
+
+		_, err := service.AddSong(context.Background(), &domain.AddSongRequest{Group: "Muse", Song: "Unknown"})
+		assert.Error(t, err)
+		assert.Zero(t, tx.rolledBack+tx.committed)
+	})
+
+	t.Run("UpdateSongRollsBack", func(t *testing.T) {
+		tx := &txRecorder{}
+		service := application.NewSongsService(mockSongsRepo, mockGroupsRepo, nil, nil, nil, nil, nil, tx, &auditRecorder{})
+
+		mockSongsRepo.On("GetSongByID", inTx, 5).Return(&domain.Song{ID: 5, Group: "Radiohead"}, nil).Once()
+		mockGroupsRepo.On("UpsertGroup", inTx, "Radiohead").Return(2, nil).Once()
+		mockSongsRepo.On("UpdateSong", inTx, mock.MatchedBy(func(song *domain.Song) bool {
+			return song.GroupID == 2
+		}), mock.Anything).Return(clientErrors.NewErrNotFound("song with id: 5")).Once()
+
+		err := service.UpdateSong(context.Background(), &domain.Song{ID: 5, Group: "Radiohead", Song: "Creep"}, "")
+		assert.True(t, errors.As(err, &clientErrors.ErrNotFound{}))
+		assert.Equal(t, 1, tx.rolledBack)
+	})
+
+	t.Run("SetSongArtistsWithoutPrimaryRollsBack", func(t *testing.T) {
+		tx := &txRecorder{}
+		service := application.NewSongsService(mockSongsRepo, mockGroupsRepo, nil, nil, nil, nil, nil, tx, &auditRecorder{})
+
+		mockGroupsRepo.On("UpsertGroup", inTx, "Rihanna").Return(3, nil).Once()
+
+		_, err := service.SetSongArtists(context.Background(), 5, &domain.SongArtistsRequest{
+			Artists: []domain.SongArtistRequest{{Group: "Rihanna", Role: domain.RoleFeatured}},
+		})
+		assert.True(t, errors.As(err, &clientErrors.ErrInvalidInput{}))
+		assert.Equal(t, 1, tx.rolledBack)
+	})
+}
+
+func TestSongsService_SetSongArtists(t *testing.T) {
+	mockSongsRepo := mocks.NewSongsRepositoryMock(t)
+	mockGroupsRepo := mocks.NewGroupsRepositoryMock(t)
+
+	audit := &auditRecorder{}
+	service := application.NewSongsService(mockSongsRepo, mockGroupsRepo, nil, nil, nil, nil, nil, &txRecorder{}, audit)
+
+	t.Run("Success", func(t *testing.T) {
+		mockSongsRepo.On("GetSongByID", mock.Anything, 5).Return(&domain.Song{ID: 5, Group: "Dr. Dre"}, nil).Once()
+		mockGroupsRepo.On("UpsertGroup", mock.Anything, "Eminem").Return(1, nil).Twice()
+		mockGroupsRepo.On("UpsertGroup", mock.Anything, "Rihanna").Return(2, nil).Once()
+		mockSongsRepo.On("SetSongArtists", mock.Anything, 5, []domain.SongArtist{
+			{GroupID: 1, Name: "Eminem", Role: domain.RolePrimary},
+			{GroupID: 2, Name: "Rihanna", Role: domain.RoleFeatured},
+		}).Return(nil).Once()
+		mockSongsRepo.On("GetSongByID", mock.Anything, 5).Return(&domain.Song{ID: 5, Group: "Eminem"}, nil).Once()
+
+		song, err := service.SetSongArtists(context.Background(), 5, &domain.SongArtistsRequest{
+			Artists: []domain.SongArtistRequest{
+				{Group: "Eminem", Role: domain.RolePrimary},
+				{Group: " Rihanna ", Role: domain.RoleFeatured},
+				{Group: "Eminem", Role: domain.RolePrimary},
+			},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 5, song.ID)
+
+		if assert.Len(t, audit.events, 1) {
+			assert.Equal(t, domain.AuditActionSetArtists, audit.events[0].Action)
+			assert.Contains(t, string(audit.events[0].Before), `"group":"Dr. Dre"`)
+			assert.Contains(t, string(audit.events[0].After), `"group":"Eminem"`)
+		}
+	})
+
+	t.Run("UnknownRole", func(t *testing.T) {
+		song, err := service.SetSongArtists(context.Background(), 5, &domain.SongArtistsRequest{
+			Artists: []domain.SongArtistRequest{{Group: "Eminem", Role: "singer"}},
+		})
+		assert.Nil(t, song)
+		assert.True(t, errors.As(err, &clientErrors.ErrInvalidInput{}))
+	})
+
+	t.Run("MissingPrimary", func(t *testing.T) {
+		mockGroupsRepo.On("UpsertGroup", mock.Anything, "Rihanna").Return(2, nil).Once()
+
+		song, err := service.SetSongArtists(context.Background(), 5, &domain.SongArtistsRequest{
+			Artists: []domain.SongArtistRequest{{Group: "Rihanna", Role: domain.RoleFeatured}},
+		})
+		assert.Nil(t, song)
+		assert.True(t, errors.As(err, &clientErrors.ErrInvalidInput{}))
+	})
+}
+
+func TestSongsService_UpdateSong(t *testing.T) {
+	mockSongsRepo := mocks.NewSongsRepositoryMock(t)
+	mockGroupsRepo := mocks.NewGroupsRepositoryMock(t)
+
+	audit := &auditRecorder{}
+	service := application.NewSongsService(mockSongsRepo, mockGroupsRepo, nil, nil, nil, nil, nil, &txRecorder{}, audit)
+
+	t.Run("RecordsRevision", func(t *testing.T) {
+		ctx := domain.WithRequestID(domain.WithActor(context.Background(), "editor"), "req-1")
+
+		mockSongsRepo.On("GetSongByID", inTx, 1).Return(&domain.Song{ID: 1, Group: "Muse", Song: "Uprsing"}, nil).Once()
+		mockGroupsRepo.On("UpsertGroup", inTx, "Muse").Return(3, nil).Once()
+		mockSongsRepo.On("UpdateSong", inTx, mock.MatchedBy(func(song *domain.Song) bool {
+			return song.ID == 1 && song.GroupID == 3
+		}), domain.RevisionInfo{Author: "editor", Reason: "fix typo"}).Return(nil).Once()
+		mockSongsRepo.On("GetSongByID", inTx, 1).Return(&domain.Song{
+			ID:      1,
+			Group:   "Muse",
+			Song:    "Uprising",
+			Tags:    []domain.Tag{{Name: "rock", Kind: domain.TagKindGenre}},
+			Version: 2,
+		}, nil).Once()
+
+		song := &domain.Song{ID: 1, Group: " Muse ", Song: "Uprising"}
+
+		err := service.UpdateSong(ctx, song, " fix typo ")
+		assert.NoError(t, err)
+		assert.Equal(t, 2, song.Version)
+
+		if assert.Len(t, audit.events, 1) {
+			event := audit.events[0]
+			assert.Equal(t, "editor", event.Actor)
+			assert.Equal(t, "req-1", event.RequestID)
+			assert.Equal(t, domain.AuditActionUpdate, event.Action)
+			assert.Equal(t, domain.AuditEntitySong, event.Entity)
+			assert.Contains(t, string(event.Before), `"song":"Uprsing"`)
+			assert.Contains(t, string(event.After), `"song":"Uprising"`)
+			assert.Contains(t, string(event.After), `"name":"rock"`)
+		}
+
+		assert.Zero(t, audit.outsideTx)
+	})
+
+	t.Run("BlankGroup", func(t *testing.T) {
+		err := service.UpdateSong(context.Background(), &domain.Song{ID: 1, Group: " "}, "")
+		assert.True(t, errors.As(err, &clientErrors.ErrInvalidInput{}))
+	})
+}
+
+func TestSongsService_PatchSong(t *testing.T) {
+	mockSongsRepo := mocks.NewSongsRepositoryMock(t)
+	mockGroupsRepo := mocks.NewGroupsRepositoryMock(t)
+
+	service := application.NewSongsService(mockSongsRepo, mockGroupsRepo, nil, nil, nil, nil, nil, &txRecorder{}, &auditRecorder{})
+
+	releaseDate := time.Date(2006, 7, 3, 0, 0, 0, 0, time.UTC)
+	current := func() *domain.Song {
+		return &domain.Song{
+			ID:          1,
+			GroupID:     3,
+			Group:       "Muse",
+			Song:        "Starlight",
+			ReleaseDate: releaseDate,
+			Text:        "Far away",
+			Link:        "https://example.com/starlight",
+			Language:    "en",
+			Version:     4,
+		}
+	}
+
+	t.Run("KeepsOmittedFields", func(t *testing.T) {
+		stored := current()
+		stored.Song = "Starlight (Live)"
+
+		mockSongsRepo.On("GetSongByID", inTx, 1).Return(current(), nil).Twice()
+		mockGroupsRepo.On("UpsertGroup", inTx, "Muse").Return(3, nil).Once()
+		mockSongsRepo.On("UpdateSong", inTx, &domain.Song{
+			ID:          1,
+			GroupID:     3,
+			Group:       "Muse",
+			Song:        "Starlight (Live)",
+			ReleaseDate: releaseDate,
+			Text:        "Far away",
+			Link:        "https://example.com/starlight",
+			Language:    "en",
+			Version:     4,
+		}, domain.RevisionInfo{Reason: "live version"}).Return(nil).Once()
+		mockSongsRepo.On("GetSongByID", inTx, 1).Return(stored, nil).Once()
+
+		song, err := service.PatchSong(context.Background(), 1, 0, []byte(`{"song":"Starlight (Live)"}`), "live version")
+		assert.NoError(t, err)
+		assert.Equal(t, stored, song)
+	})
+
+	t.Run("NullClearsAndGroupIsResolved", func(t *testing.T) {
+		mockSongsRepo.On("GetSongByID", inTx, 1).Return(current(), nil).Twice()
+		mockGroupsRepo.On("UpsertGroup", inTx, "Radiohead").Return(5, nil).Once()
+		mockSongsRepo.On("UpdateSong", inTx, mock.MatchedBy(func(song *domain.Song) bool {
+			return song.GroupID == 5 && song.Link == "" && song.Language == "" &&
+				song.Text == "Far away" && song.ReleaseDate.Equal(releaseDate)
+		}), mock.Anything).Return(nil).Once()
+		mockSongsRepo.On("GetSongByID", inTx, 1).Return(&domain.Song{ID: 1, Group: "Radiohead"}, nil).Once()
+
+		song, err := service.PatchSong(context.Background(), 1, 0,
+			[]byte(`{"group":"Radiohead","link":null,"language":null}`), "")
+		assert.NoError(t, err)
+		assert.Equal(t, "Radiohead", song.Group)
+	})
+
+	t.Run("EmptyPatch", func(t *testing.T) {
+		mockSongsRepo.On("GetSongByID", mock.Anything, 1).Return(current(), nil).Once()
+
+		song, err := service.PatchSong(context.Background(), 1, 0, []byte(`{}`), "")
+		assert.NoError(t, err)
+		assert.Equal(t, current(), song)
+	})
+
+	t.Run("InvalidPatches", func(t *testing.T) {
+		for _, tc := range []struct {
+			patch  string
+			field  string
+			merged bool
+		}{
+			{patch: `[]`, field: "patch"},
+			{patch: `null`, field: "patch"},
+			{patch: `{"id":2}`, field: "id"},
+			{patch: `{"song":null}`, field: "song"},
+			{patch: `{"release_date":null}`, field: "release_date"},
+			{patch: `{"text":5}`, field: "text", merged: true},
+			{patch: `{"release_date":"now"}`, field: "release_date", merged: true},
+		} {
+			if tc.merged {
+				mockSongsRepo.On("GetSongByID", inTx, 1).Return(current(), nil).Once()
+			}
+
+			_, err := service.PatchSong(context.Background(), 1, 0, []byte(tc.patch), "")
+			assert.Equal(t, clientErrors.NewErrInvalidInput(tc.field), err, tc.patch)
+		}
+	})
+
+	t.Run("VersionMismatch", func(t *testing.T) {
+		mockSongsRepo.On("GetSongByID", inTx, 1).Return(current(), nil).Once()
+
+		_, err := service.PatchSong(context.Background(), 1, 3, []byte(`{"text":"new"}`), "")
+		assert.True(t, errors.As(err, &clientErrors.ErrPreconditionFailed{}))
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mockSongsRepo.On("GetSongByID", inTx, 9).Return(nil, clientErrors.NewErrNotFound("song with id: 9")).Once()
+
+		_, err := service.PatchSong(context.Background(), 9, 0, []byte(`{"text":"new"}`), "")
+		assert.True(t, errors.As(err, &clientErrors.ErrNotFound{}))
+	})
+}
+
+func TestSongsService_Trash(t *testing.T) {
+	mockSongsRepo := mocks.NewSongsRepositoryMock(t)
+
+	t.Run("Delete", func(t *testing.T) {
+		audit := &auditRecorder{}
+		service := application.NewSongsService(mockSongsRepo, nil, nil, nil, nil, nil, nil, &txRecorder{}, audit)
+
+		mockSongsRepo.On("GetSongByID", inTx, 1).Return(&domain.Song{ID: 1, Song: "Creep", Version: 4}, nil).Once()
+		mockSongsRepo.On("DeleteSong", inTx, 1, 4).Return(nil).Once()
+
+		err := service.DeleteSong(context.Background(), 1, 4)
+		assert.NoError(t, err)
+
+		if assert.Len(t, audit.events, 1) {
+			assert.Equal(t, domain.AuditActionDelete, audit.events[0].Action)
+			assert.Contains(t, string(audit.events[0].Before), `"song":"Creep"`)
+			assert.Nil(t, audit.events[0].After)
+		}
+	})
+
+	t.Run("DeleteVersionMismatch", func(t *testing.T) {
+		audit := &auditRecorder{}
+		service := application.NewSongsService(mockSongsRepo, nil, nil, nil, nil, nil, nil, &txRecorder{}, audit)
+
+		mockSongsRepo.On("GetSongByID", inTx, 1).Return(&domain.Song{ID: 1, Version: 5}, nil).Once()
+		mockSongsRepo.On("DeleteSong", inTx, 1, 4).Return(clientErrors.NewErrPreconditionFailed("song changed")).Once()
+
+		err := service.DeleteSong(context.Background(), 1, 4)
+		assert.True(t, errors.As(err, &clientErrors.ErrPreconditionFailed{}))
+		assert.Empty(t, audit.events)
+	})
+
+	t.Run("Restore", func(t *testing.T) {
+		tx := &txRecorder{}
+		audit := &auditRecorder{}
+		service := application.NewSongsService(mockSongsRepo, nil, nil, nil, nil, nil, nil, tx, audit)
+
+		mockSongsRepo.On("RestoreSong", inTx, 1).Return(nil).Once()
+		mockSongsRepo.On("GetSongByID", inTx, 1).Return(&domain.Song{ID: 1, Version: 5}, nil).Once()
+
+		song, err := service.RestoreSong(context.Background(), 1)
+		assert.NoError(t, err)
+		assert.Equal(t, 5, song.Version)
+		assert.Equal(t, 1, tx.committed)
+
+		if assert.Len(t, audit.events, 1) {
+			assert.Equal(t, domain.AuditActionRestore, audit.events[0].Action)
+			assert.Nil(t, audit.events[0].Before)
+		}
+	})
+
+	t.Run("RestoreNotTrashed", func(t *testing.T) {
+		tx := &txRecorder{}
+		service := application.NewSongsService(mockSongsRepo, nil, nil, nil, nil, nil, nil, tx, &auditRecorder{})
+
+		mockSongsRepo.On("RestoreSong", inTx, 2).Return(clientErrors.NewErrNotFound("trashed song with id: 2")).Once()
+
+		_, err := service.RestoreSong(context.Background(), 2)
+		assert.True(t, errors.As(err, &clientErrors.ErrNotFound{}))
+		assert.Equal(t, 1, tx.rolledBack)
+	})
+
+	t.Run("PurgeUsesRetention", func(t *testing.T) {
+		audit := &auditRecorder{}
+		service := application.NewSongsService(mockSongsRepo, nil, nil, nil, nil, nil, nil, &txRecorder{}, audit)
+		cutoff := time.Now().Add(-72 * time.Hour)
+
+		mockSongsRepo.On("PurgeDeletedSongs", inTx, mock.MatchedBy(func(before time.Time) bool {
+			return !before.Before(cutoff) && before.Before(cutoff.Add(time.Minute))
+		})).Return([]domain.Song{{ID: 3, Song: "Creep"}, {ID: 4, Song: "Karma Police"}}, nil).Once()
+
+		purged, err := service.PurgeDeletedSongs(context.Background(), 72*time.Hour)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, purged)
+
+		if assert.Len(t, audit.events, 2) {
+			event := audit.events[1]
+			assert.Equal(t, "trash-purge", event.Actor)
+			assert.Equal(t, domain.AuditActionPurge, event.Action)
+			assert.Equal(t, 4, *event.EntityID)
+			assert.Contains(t, string(event.Before), `"song":"Karma Police"`)
+			assert.Nil(t, event.After)
+		}
+	})
+
+	t.Run("PurgeNothing", func(t *testing.T) {
+		audit := &auditRecorder{}
+		service := application.NewSongsService(mockSongsRepo, nil, nil, nil, nil, nil, nil, &txRecorder{}, audit)
+
+		mockSongsRepo.On("PurgeDeletedSongs", inTx, mock.Anything).Return([]domain.Song{}, nil).Once()
+
+		purged, err := service.PurgeDeletedSongs(context.Background(), time.Hour)
+		assert.NoError(t, err)
+		assert.Zero(t, purged)
+		assert.Empty(t, audit.events)
+	})
+}
+
+func TestSongsService_SongRevisions(t *testing.T) {
+	mockSongsRepo := mocks.NewSongsRepositoryMock(t)
+	mockGroupsRepo := mocks.NewGroupsRepositoryMock(t)
+
+	service := application.NewSongsService(mockSongsRepo, mockGroupsRepo, nil, nil, nil, nil, nil, &txRecorder{}, &auditRecorder{})
+
+	first := &domain.Revision{Number: 1, SongID: 1, Group: "Muse", Song: "Uprising", Text: "one\ntwo\nthree\nfour\nfive"}
+	second := &domain.Revision{Number: 2, SongID: 1, Group: "Muse", Song: "Uprising", Text: "one\ntwo\n3\nfour\nfive\nsix"}
+
+	t.Run("Diff", func(t *testing.T) {
+		mockSongsRepo.On("GetSongRevision", mock.Anything, 1, 1).Return(first, nil).Once()
+		mockSongsRepo.On("GetSongRevision", mock.Anything, 1, 2).Return(second, nil).Once()
+
+		diff, err := service.DiffSongRevisions(context.Background(), 1, 1, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, "--- revision 1\n+++ revision 2\n@@ -1,5 +1,6 @@\n one\n two\n-three\n+3\n four\n five\n+six\n", diff)
+	})
+
+	t.Run("DiffMissingRevision", func(t *testing.T) {
+		mockSongsRepo.On("GetSongRevision", mock.Anything, 1, 1).Return(first, nil).Once()
+		mockSongsRepo.On("GetSongRevision", mock.Anything, 1, 7).Return(nil, clientErrors.NewErrNotFound("revision")).Once()
+
+		diff, err := service.DiffSongRevisions(context.Background(), 1, 1, 7)
+		assert.Empty(t, diff)
+		assert.True(t, errors.As(err, &clientErrors.ErrNotFound{}))
+	})
+
+	t.Run("Restore", func(t *testing.T) {
+		mockSongsRepo.On("GetSongRevision", mock.Anything, 1, 1).Return(first, nil).Once()
+		mockSongsRepo.On("GetSongByID", mock.Anything, 1).Return(&domain.Song{ID: 1, Text: second.Text}, nil).Once()
+		mockGroupsRepo.On("UpsertGroup", mock.Anything, "Muse").Return(3, nil).Once()
+		mockSongsRepo.On("UpdateSong", mock.Anything, mock.MatchedBy(func(song *domain.Song) bool {
+			return song.ID == 1 && song.GroupID == 3 && song.Text == first.Text
+		}), domain.RevisionInfo{Reason: "restore of revision 1"}).Return(nil).Once()
+		mockSongsRepo.On("GetSongByID", mock.Anything, 1).Return(&domain.Song{ID: 1, Text: first.Text}, nil).Once()
+
+		song, err := service.RestoreSongRevision(context.Background(), 1, 1, "")
+		assert.NoError(t, err)
+		assert.Equal(t, first.Text, song.Text)
+	})
+}
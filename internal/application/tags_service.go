@@ -0,0 +1,90 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/mashfeii/songs_library/internal/domain"
+	"github.com/mashfeii/songs_library/internal/infrastructure/database"
+	"github.com/sirupsen/logrus"
+)
+
+type TagsServiceInterface interface {
+	GetTags(ctx context.Context, kind domain.TagKind) ([]domain.TagCount, error)
+	SetSongTags(ctx context.Context, songID int, req *domain.SongTagsRequest) ([]domain.Tag, error)
+}
+
+type TagsService struct {
+	tagsRepo   database.TagsRepository
+	songsRepo  database.SongsRepository
+	transactor database.Transactor
+	auditRepo  database.AuditRepository
+}
+
+func NewTagsService(
+	tagsRepo database.TagsRepository,
+	songsRepo database.SongsRepository,
+	transactor database.Transactor,
+	auditRepo database.AuditRepository,
+) *TagsService {
+	return &TagsService{
+		tagsRepo:   tagsRepo,
+		songsRepo:  songsRepo,
+		transactor: transactor,
+		auditRepo:  auditRepo,
+	}
+}
+
+func (s *TagsService) GetTags(ctx context.Context, kind domain.TagKind) ([]domain.TagCount, error) {
+	tags, err := s.tagsRepo.GetTags(ctx, kind)
+	if err != nil {
+		return nil, fmt.Errorf("getting tags: %w", err)
+	}
+
+	return tags, nil
+}
+
+// SetSongTags replaces the genres and tags of a song, names are normalized and deduplicated
+// so an empty request clears every tag of the song.
+func (s *TagsService) SetSongTags(ctx context.Context, songID int, req *domain.SongTagsRequest) ([]domain.Tag, error) {
+	tags := make([]domain.Tag, 0, len(req.Genres)+len(req.Tags))
+	tags = appendTags(tags, domain.TagKindGenre, req.Genres)
+	tags = appendTags(tags, domain.TagKindTag, req.Tags)
+
+	var stored []domain.Tag
+
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		song, err := s.songsRepo.GetSongByID(ctx, songID)
+		if err != nil {
+			return err
+		}
+
+		if stored, err = s.tagsRepo.SetSongTags(ctx, songID, tags); err != nil {
+			return err
+		}
+
+		return recordAudit(ctx, s.auditRepo, domain.AuditEntitySong, songID, domain.AuditActionSetTags, song.Tags, stored)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"song_id": songID,
+		"amount":  len(stored),
+	}).Info("Song tags replaced")
+
+	return stored, nil
+}
+
+func appendTags(tags []domain.Tag, kind domain.TagKind, names []string) []domain.Tag {
+	for _, name := range names {
+		tag := domain.Tag{Name: domain.NormalizeTagName(name), Kind: kind}
+		if tag.Name != "" && !slices.Contains(tags, tag) {
+			tags = append(tags, tag)
+		}
+	}
+
+	return tags
+}
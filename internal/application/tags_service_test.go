@@ -0,0 +1,61 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mashfeii/songs_library/internal/application"
+	"github.com/mashfeii/songs_library/internal/domain"
+	"github.com/mashfeii/songs_library/internal/mocks"
+	"github.com/stretchr/testify/assert"
+
+	clientErrors "github.com/mashfeii/songs_library/internal/infrastructure/errors"
+)
+
+func TestTagsService_SetSongTags(t *testing.T) {
+	mockTagsRepo := mocks.NewTagsRepositoryMock(t)
+	mockSongsRepo := mocks.NewSongsRepositoryMock(t)
+
+	audit := &auditRecorder{}
+	service := application.NewTagsService(mockTagsRepo, mockSongsRepo, &txRecorder{}, audit)
+
+	t.Run("NormalizesAndDeduplicates", func(t *testing.T) {
+		expected := []domain.Tag{
+			{Name: "rock", Kind: domain.TagKindGenre},
+			{Name: "work out", Kind: domain.TagKindTag},
+			{Name: "rock", Kind: domain.TagKindTag},
+		}
+
+		mockSongsRepo.On("GetSongByID", inTx, 3).
+			Return(&domain.Song{ID: 3, Tags: []domain.Tag{{ID: 7, Name: "pop", Kind: domain.TagKindGenre}}}, nil).Once()
+		mockTagsRepo.On("SetSongTags", inTx, 3, expected).Return(expected, nil).Once()
+
+		tags, err := service.SetSongTags(context.Background(), 3, &domain.SongTagsRequest{
+			Genres: []string{"Rock", " rock "},
+			Tags:   []string{"Work  Out", "", "work out", "ROCK"},
+		})
+		assert.NoError(t, err)
+		assert.Len(t, tags, 3)
+
+		if assert.Len(t, audit.events, 1) {
+			event := audit.events[0]
+			assert.Equal(t, domain.AuditActionSetTags, event.Action)
+			assert.Equal(t, domain.AuditEntitySong, event.Entity)
+			assert.Equal(t, 3, *event.EntityID)
+			assert.JSONEq(t, `[{"id":7,"name":"pop","kind":"genre"}]`, string(event.Before))
+			assert.NotNil(t, event.After)
+		}
+
+		assert.Zero(t, audit.outsideTx)
+	})
+
+	t.Run("SongNotFound", func(t *testing.T) {
+		mockSongsRepo.On("GetSongByID", inTx, 9).Return(nil, clientErrors.NewErrNotFound("song")).Once()
+
+		tags, err := service.SetSongTags(context.Background(), 9, &domain.SongTagsRequest{})
+		assert.Nil(t, tags)
+		assert.True(t, errors.As(err, &clientErrors.ErrNotFound{}))
+		assert.Len(t, audit.events, 1)
+	})
+}
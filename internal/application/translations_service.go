@@ -0,0 +1,171 @@
+package application
+
+import (
+	"context"
+	"strings"
+
+	"github.com/mashfeii/songs_library/internal/domain"
+	"github.com/mashfeii/songs_library/internal/infrastructure/database"
+	clientErrors "github.com/mashfeii/songs_library/internal/infrastructure/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type TranslationsServiceInterface interface {
+	GetTranslations(ctx context.Context, songID int) ([]domain.Translation, error)
+	GetTranslation(ctx context.Context, songID int, language string) (*domain.Translation, error)
+	AddTranslation(ctx context.Context, songID int, req *domain.TranslationRequest) (*domain.Translation, error)
+	UpdateTranslation(
+		ctx context.Context,
+		songID int,
+		language string,
+		req *domain.UpdateTranslationRequest,
+	) (*domain.Translation, error)
+}
+
+type TranslationsService struct {
+	translationsRepo database.TranslationsRepository
+	songsRepo        database.SongsRepository
+	transactor       database.Transactor
+	auditRepo        database.AuditRepository
+}
+
+func NewTranslationsService(
+	translationsRepo database.TranslationsRepository,
+	songsRepo database.SongsRepository,
+	transactor database.Transactor,
+	auditRepo database.AuditRepository,
+) *TranslationsService {
+	return &TranslationsService{
+		translationsRepo: translationsRepo,
+		songsRepo:        songsRepo,
+		transactor:       transactor,
+		auditRepo:        auditRepo,
+	}
+}
+
+func (s *TranslationsService) GetTranslations(ctx context.Context, songID int) ([]domain.Translation, error) {
+	if _, err := s.songsRepo.GetSongByID(ctx, songID); err != nil {
+		return nil, err
+	}
+
+	return s.translationsRepo.GetTranslations(ctx, songID)
+}
+
+func (s *TranslationsService) GetTranslation(
+	ctx context.Context,
+	songID int,
+	language string,
+) (*domain.Translation, error) {
+	code, err := parseTranslationLanguage(language)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.translationsRepo.GetTranslation(ctx, songID, code)
+}
+
+// AddTranslation stores a translation in a language the song has none in yet, the translator
+// defaults to the author of the request.
+func (s *TranslationsService) AddTranslation(
+	ctx context.Context,
+	songID int,
+	req *domain.TranslationRequest,
+) (*domain.Translation, error) {
+	code, err := parseTranslationLanguage(req.Language)
+	if err != nil {
+		return nil, err
+	}
+
+	translation, err := newTranslation(ctx, songID, code, req.Text, req.Translator)
+	if err != nil {
+		return nil, err
+	}
+
+	song, err := s.songsRepo.GetSongByID(ctx, songID)
+	if err != nil {
+		return nil, err
+	}
+
+	if song.Language == code {
+		return nil, clientErrors.NewErrConflict("translation language is the original language of the song")
+	}
+
+	err = s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.translationsRepo.AddTranslation(ctx, translation); err != nil {
+			return err
+		}
+
+		return recordAudit(ctx, s.auditRepo, domain.AuditEntitySong, songID, domain.AuditActionAddTranslation, nil, translation)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"song_id":  songID,
+		"language": code,
+	}).Info("Translation added")
+
+	return translation, nil
+}
+
+func (s *TranslationsService) UpdateTranslation(
+	ctx context.Context,
+	songID int,
+	language string,
+	req *domain.UpdateTranslationRequest,
+) (*domain.Translation, error) {
+	code, err := parseTranslationLanguage(language)
+	if err != nil {
+		return nil, err
+	}
+
+	translation, err := newTranslation(ctx, songID, code, req.Text, req.Translator)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		before, err := s.translationsRepo.GetTranslation(ctx, songID, code)
+		if err != nil {
+			return err
+		}
+
+		if err := s.translationsRepo.UpdateTranslation(ctx, translation); err != nil {
+			return err
+		}
+
+		return recordAudit(ctx, s.auditRepo, domain.AuditEntitySong, songID, domain.AuditActionUpdateTranslation, before, translation)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return translation, nil
+}
+
+func parseTranslationLanguage(language string) (string, error) {
+	code, err := domain.ParseLanguage(language)
+	if err != nil || code == "" {
+		return "", clientErrors.NewErrInvalidInput("language")
+	}
+
+	return code, nil
+}
+
+func newTranslation(ctx context.Context, songID int, language, text, translator string) (*domain.Translation, error) {
+	if strings.TrimSpace(text) == "" {
+		return nil, clientErrors.NewErrInvalidInput("text")
+	}
+
+	if translator = strings.TrimSpace(translator); translator == "" {
+		translator = domain.ActorFrom(ctx)
+	}
+
+	return &domain.Translation{
+		SongID:     songID,
+		Language:   language,
+		Text:       text,
+		Translator: translator,
+	}, nil
+}
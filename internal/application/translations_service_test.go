@@ -0,0 +1,109 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mashfeii/songs_library/internal/application"
+	"github.com/mashfeii/songs_library/internal/domain"
+	"github.com/mashfeii/songs_library/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	clientErrors "github.com/mashfeii/songs_library/internal/infrastructure/errors"
+)
+
+func TestTranslationsService_AddTranslation(t *testing.T) {
+	mockTranslationsRepo := mocks.NewTranslationsRepositoryMock(t)
+	mockSongsRepo := mocks.NewSongsRepositoryMock(t)
+
+	audit := &auditRecorder{}
+	service := application.NewTranslationsService(mockTranslationsRepo, mockSongsRepo, &txRecorder{}, audit)
+	song := &domain.Song{ID: 1, Language: "en", Text: "Sing along"}
+
+	t.Run("Success", func(t *testing.T) {
+		mockSongsRepo.On("GetSongByID", mock.Anything, 1).Return(song, nil).Once()
+		mockTranslationsRepo.On("AddTranslation", inTx, &domain.Translation{
+			SongID:     1,
+			Language:   "pt-br",
+			Text:       "Cante junto",
+			Translator: "editor",
+		}).Return(nil).Once()
+
+		ctx := domain.WithActor(context.Background(), "editor")
+		translation, err := service.AddTranslation(ctx, 1, &domain.TranslationRequest{
+			Language: "pt_BR",
+			Text:     "Cante junto",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "pt-br", translation.Language)
+
+		if assert.Len(t, audit.events, 1) {
+			event := audit.events[0]
+			assert.Equal(t, domain.AuditActionAddTranslation, event.Action)
+			assert.Equal(t, domain.AuditEntitySong, event.Entity)
+			assert.Nil(t, event.Before)
+			assert.Contains(t, string(event.After), `"translator":"editor"`)
+		}
+
+		assert.Zero(t, audit.outsideTx)
+	})
+
+	t.Run("OriginalLanguage", func(t *testing.T) {
+		mockSongsRepo.On("GetSongByID", mock.Anything, 1).Return(song, nil).Once()
+
+		translation, err := service.AddTranslation(context.Background(), 1, &domain.TranslationRequest{
+			Language: "EN",
+			Text:     "Sing along",
+		})
+		assert.Nil(t, translation)
+		assert.IsType(t, clientErrors.ErrConflict{}, err)
+	})
+
+	t.Run("InvalidLanguage", func(t *testing.T) {
+		translation, err := service.AddTranslation(context.Background(), 1, &domain.TranslationRequest{
+			Language: "German",
+			Text:     "Sing mit",
+		})
+		assert.Nil(t, translation)
+		assert.Equal(t, clientErrors.NewErrInvalidInput("language"), err)
+	})
+}
+
+func TestTranslationsService_UpdateTranslation(t *testing.T) {
+	mockTranslationsRepo := mocks.NewTranslationsRepositoryMock(t)
+
+	audit := &auditRecorder{}
+	service := application.NewTranslationsService(mockTranslationsRepo, nil, &txRecorder{}, audit)
+
+	t.Run("KeepsTranslator", func(t *testing.T) {
+		mockTranslationsRepo.On("GetTranslation", inTx, 1, "de").
+			Return(&domain.Translation{SongID: 1, Language: "de", Text: "Singe mit", Translator: "Anna"}, nil).Once()
+		mockTranslationsRepo.On("UpdateTranslation", inTx, &domain.Translation{
+			SongID:     1,
+			Language:   "de",
+			Text:       "Sing mit",
+			Translator: "Anna",
+		}).Return(nil).Once()
+
+		_, err := service.UpdateTranslation(context.Background(), 1, "de", &domain.UpdateTranslationRequest{
+			Text:       "Sing mit",
+			Translator: " Anna ",
+		})
+		assert.NoError(t, err)
+
+		if assert.Len(t, audit.events, 1) {
+			assert.Equal(t, domain.AuditActionUpdateTranslation, audit.events[0].Action)
+			assert.Contains(t, string(audit.events[0].Before), "Singe mit")
+			assert.Contains(t, string(audit.events[0].After), "Sing mit")
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mockTranslationsRepo.On("GetTranslation", inTx, 1, "fr").
+			Return(nil, clientErrors.NewErrNotFound("fr translation of song with id: 1")).Once()
+
+		_, err := service.UpdateTranslation(context.Background(), 1, "fr", &domain.UpdateTranslationRequest{Text: "Chante"})
+		assert.IsType(t, clientErrors.ErrNotFound{}, err)
+	})
+}
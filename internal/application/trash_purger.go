@@ -0,0 +1,54 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// TrashPurger removes songs trashed longer than the retention ago every interval, a zero
+// interval or retention disables it and keeps trashed songs until they are restored.
+type TrashPurger struct {
+	service   SongsServiceInterface
+	interval  time.Duration
+	retention time.Duration
+}
+
+func NewTrashPurger(service SongsServiceInterface, interval, retention time.Duration) *TrashPurger {
+	return &TrashPurger{service: service, interval: interval, retention: retention}
+}
+
+// Run blocks until ctx is done.
+func (p *TrashPurger) Run(ctx context.Context) {
+	if p.interval <= 0 || p.retention <= 0 {
+		logrus.Info("Trash purge disabled")
+
+		return
+	}
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		purged, err := p.service.PurgeDeletedSongs(ctx, p.retention)
+		if err != nil {
+			logrus.WithField("error", err).Error("Scheduled trash purge failed")
+
+			continue
+		}
+
+		if purged > 0 {
+			logrus.WithFields(logrus.Fields{
+				"purged":    purged,
+				"retention": p.retention,
+			}).Info("Purged trashed songs")
+		}
+	}
+}
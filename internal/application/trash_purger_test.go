@@ -0,0 +1,46 @@
+package application_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/mashfeii/songs_library/internal/application"
+	"github.com/mashfeii/songs_library/internal/mocks"
+	"github.com/stretchr/testify/mock"
+
+	clientErrors "github.com/mashfeii/songs_library/internal/infrastructure/errors"
+)
+
+func TestTrashPurger_Run(t *testing.T) {
+	mockService := mocks.NewSongsServiceInterfaceMock(t)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// The purger keeps going after a failure and stops with the context.
+	mockService.On("PurgeDeletedSongs", mock.Anything, 48*time.Hour).
+		Return(0, fmt.Errorf("purging deleted songs: %w", clientErrors.NewErrDatabase())).Once()
+	mockService.On("PurgeDeletedSongs", mock.Anything, 48*time.Hour).Return(2, nil).Run(func(mock.Arguments) {
+		cancel()
+	}).Once()
+
+	done := make(chan struct{})
+
+	go func() {
+		application.NewTrashPurger(mockService, time.Millisecond, 48*time.Hour).Run(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		cancel()
+		t.Fatal("purger did not stop")
+	}
+}
+
+func TestTrashPurger_Disabled(t *testing.T) {
+	mockService := mocks.NewSongsServiceInterfaceMock(t)
+
+	application.NewTrashPurger(mockService, time.Hour, 0).Run(context.Background())
+}
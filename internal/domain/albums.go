@@ -0,0 +1,12 @@
+package domain
+
+import "time"
+
+type Album struct {
+	ID          int        `json:"id"`
+	GroupID     int        `json:"group_id"`
+	Group       string     `json:"group"`
+	Title       string     `json:"title"`
+	ReleaseDate *time.Time `json:"release_date,omitempty"`
+	CoverLink   string     `json:"cover_link,omitempty"`
+}
@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+// Annotation explains the lines StartLine to EndLine of a song, numbered from 1 as in
+// Verse.Line. Anchor keeps the annotated lines, the annotation becomes stale when they change.
+type Annotation struct {
+	ID        int       `json:"id"`
+	SongID    int       `json:"song_id"`
+	StartLine int       `json:"start_line"`
+	EndLine   int       `json:"end_line"`
+	Body      string    `json:"body"`
+	Anchor    string    `json:"anchor"`
+	Author    string    `json:"author,omitempty"`
+	Stale     bool      `json:"stale"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// AnchorLines returns the lines start to end of the text joined by newlines, ok is false
+// when the range lies outside the text.
+func AnchorLines(text string, start, end int) (string, bool) {
+	lines := SplitLines(text)
+	if start < 1 || end < start || end > len(lines) {
+		return "", false
+	}
+
+	return strings.Join(lines[start-1:end], "\n"), true
+}
+
+// AttachAnnotations adds the annotations overlapping the lines of each verse. Lines repeated
+// from another stanza are not part of the text, so such verses get no annotations.
+func AttachAnnotations(verses []Verse, annotations []Annotation) {
+	for i := range verses {
+		if verses[i].copied || len(verses[i].Lines) == 0 {
+			continue
+		}
+
+		first, last := verses[i].Line, verses[i].Line+len(verses[i].Lines)-1
+
+		for _, annotation := range annotations {
+			if annotation.StartLine <= last && annotation.EndLine >= first {
+				verses[i].Annotations = append(verses[i].Annotations, annotation)
+			}
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package domain
+
+import "fmt"
+
+type ArtistRole string
+
+const (
+	RolePrimary  ArtistRole = "primary"
+	RoleFeatured ArtistRole = "featured"
+	RoleComposer ArtistRole = "composer"
+	RoleProducer ArtistRole = "producer"
+)
+
+func ParseArtistRole(value string) (ArtistRole, error) {
+	switch role := ArtistRole(value); role {
+	case "", RolePrimary, RoleFeatured, RoleComposer, RoleProducer:
+		return role, nil
+	default:
+		return "", fmt.Errorf("unknown artist role %q", value)
+	}
+}
+
+// SongArtist credits a group with a role on a song.
+type SongArtist struct {
+	GroupID int        `json:"group_id"`
+	Name    string     `json:"name"`
+	Role    ArtistRole `json:"role"`
+}
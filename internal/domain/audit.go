@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Audited entities.
+const (
+	AuditEntitySong  = "song"
+	AuditEntityGroup = "group"
+	AuditEntityAlbum = "album"
+)
+
+// Audited actions.
+const (
+	AuditActionCreate      = "create"
+	AuditActionUpdate      = "update"
+	AuditActionDelete      = "delete"
+	AuditActionRestore     = "restore"
+	AuditActionPurge       = "purge"
+	AuditActionEnrich      = "enrich"
+	AuditActionRefresh     = "refresh"
+	AuditActionSetArtists  = "set_artists"
+	AuditActionMerge       = "merge"
+	AuditActionAddAlias    = "add_alias"
+	AuditActionDeleteAlias = "delete_alias"
+
+	// Changes of the tags, lyrics, annotations and translations of a song are recorded on the
+	// song with the changed part as their states.
+	AuditActionSetTags           = "set_tags"
+	AuditActionSetLyrics         = "set_lyrics"
+	AuditActionDeleteLyrics      = "delete_lyrics"
+	AuditActionAddAnnotation     = "add_annotation"
+	AuditActionUpdateAnnotation  = "update_annotation"
+	AuditActionDeleteAnnotation  = "delete_annotation"
+	AuditActionAddTranslation    = "add_translation"
+	AuditActionUpdateTranslation = "update_translation"
+)
+
+// AuditEvent records a change of an entity with its state before and after the change, a
+// missing state stands for an entity created or removed by it.
+type AuditEvent struct {
+	ID        int64           `json:"id"`
+	Actor     string          `json:"actor,omitempty"`
+	Action    string          `json:"action"`
+	Entity    string          `json:"entity"`
+	EntityID  *int            `json:"entity_id,omitempty"`
+	Before    json.RawMessage `json:"before,omitempty" swaggertype:"object"`
+	After     json.RawMessage `json:"after,omitempty" swaggertype:"object"`
+	RequestID string          `json:"request_id,omitempty"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+// AuditFilter selects audit events, zero fields match any event.
+type AuditFilter struct {
+	Entity   string
+	EntityID int
+	Actor    string
+	From     *time.Time
+	To       *time.Time
+}
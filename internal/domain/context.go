@@ -0,0 +1,36 @@
+package domain
+
+import "context"
+
+type contextKey struct {
+	name string
+}
+
+var (
+	actorKey     = &contextKey{name: "actor"}
+	requestIDKey = &contextKey{name: "request_id"}
+)
+
+// WithActor returns a context carrying the name of whoever performs the request.
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey, actor)
+}
+
+// ActorFrom returns the actor stored by WithActor, or an empty string for anonymous requests.
+func ActorFrom(ctx context.Context) string {
+	actor, _ := ctx.Value(actorKey).(string)
+
+	return actor
+}
+
+// WithRequestID returns a context carrying the ID of the request it serves.
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey, requestID)
+}
+
+// RequestIDFrom returns the request ID stored by WithRequestID, or an empty string outside of requests.
+func RequestIDFrom(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey).(string)
+
+	return requestID
+}
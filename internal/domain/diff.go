@@ -0,0 +1,235 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// diffContext is the number of unchanged lines shown around changes in a unified diff.
+	diffContext = 3
+	// diffEditLimit bounds the search for a shortest edit script, texts differing in more
+	// lines are shown as replaced instead of aligned line by line.
+	diffEditLimit = 1000
+)
+
+type diffOp struct {
+	kind byte
+	line string
+}
+
+// SplitLines splits text into lines, an empty text has no lines.
+func SplitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+
+	return strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+}
+
+// UnifiedDiff returns a line-level unified diff turning a into b, or an empty string when
+// both are equal.
+func UnifiedDiff(fromName, toName string, a, b []string) string {
+	ops := diffLines(a, b)
+
+	var changes []int
+
+	for i, op := range ops {
+		if op.kind != ' ' {
+			changes = append(changes, i)
+		}
+	}
+
+	if len(changes) == 0 {
+		return ""
+	}
+
+	var out strings.Builder
+
+	fmt.Fprintf(&out, "--- %s\n+++ %s\n", fromName, toName)
+
+	for i := 0; i < len(changes); {
+		last := i
+		for last+1 < len(changes) && changes[last+1]-changes[last] <= 2*diffContext {
+			last++
+		}
+
+		writeHunk(&out, ops, max(changes[i]-diffContext, 0), min(changes[last]+diffContext+1, len(ops)))
+
+		i = last + 1
+	}
+
+	return out.String()
+}
+
+func writeHunk(out *strings.Builder, ops []diffOp, start, end int) {
+	aBefore, bBefore := 0, 0
+
+	for _, op := range ops[:start] {
+		if op.kind != '+' {
+			aBefore++
+		}
+
+		if op.kind != '-' {
+			bBefore++
+		}
+	}
+
+	aLen, bLen := 0, 0
+
+	for _, op := range ops[start:end] {
+		if op.kind != '+' {
+			aLen++
+		}
+
+		if op.kind != '-' {
+			bLen++
+		}
+	}
+
+	fmt.Fprintf(out, "@@ -%s +%s @@\n", hunkRange(aBefore, aLen), hunkRange(bBefore, bLen))
+
+	for _, op := range ops[start:end] {
+		out.WriteByte(op.kind)
+		out.WriteString(op.line)
+		out.WriteByte('\n')
+	}
+}
+
+func hunkRange(before, length int) string {
+	switch length {
+	case 0:
+		return fmt.Sprintf("%d,0", before)
+	case 1:
+		return fmt.Sprintf("%d", before+1)
+	default:
+		return fmt.Sprintf("%d,%d", before+1, length)
+	}
+}
+
+// diffLines aligns both texts on a longest common subsequence of lines. It uses the linear
+// space variant of Myers' algorithm, so memory stays proportional to the length of the texts
+// and time to their length times the number of changed lines.
+func diffLines(a, b []string) []diffOp {
+	return appendDiff(make([]diffOp, 0, len(a)+len(b)), a, b)
+}
+
+// appendDiff strips the common prefix and suffix, then splits the rest at the middle snake
+// of a shortest edit script and diffs both halves.
+func appendDiff(ops []diffOp, a, b []string) []diffOp {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		ops = append(ops, diffOp{' ', a[prefix]})
+		prefix++
+	}
+
+	a, b = a[prefix:], b[prefix:]
+
+	suffix := 0
+	for suffix < len(a) && suffix < len(b) && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	common := a[len(a)-suffix:]
+	a, b = a[:len(a)-suffix], b[:len(b)-suffix]
+
+	switch {
+	case len(a) == 0:
+		for _, line := range b {
+			ops = append(ops, diffOp{'+', line})
+		}
+	case len(b) == 0:
+		for _, line := range a {
+			ops = append(ops, diffOp{'-', line})
+		}
+	default:
+		x, y, u, v, ok := middleSnake(a, b)
+		if !ok {
+			for _, line := range a {
+				ops = append(ops, diffOp{'-', line})
+			}
+
+			for _, line := range b {
+				ops = append(ops, diffOp{'+', line})
+			}
+
+			break
+		}
+
+		ops = appendDiff(ops, a[:x], b[:y])
+
+		for _, line := range a[x:u] {
+			ops = append(ops, diffOp{' ', line})
+		}
+
+		ops = appendDiff(ops, a[u:], b[v:])
+	}
+
+	for _, line := range common {
+		ops = append(ops, diffOp{' ', line})
+	}
+
+	return ops
+}
+
+// middleSnake searches shortest edit scripts from both ends at once and returns the snake
+// where they meet, running from a[x], b[y] to a[u], b[v]. It gives up once the script
+// needs more than diffEditLimit edits.
+func middleSnake(a, b []string) (x, y, u, v int, ok bool) {
+	n, m := len(a), len(b)
+	delta := n - m
+	odd := delta%2 != 0
+	limit := min((n+m+1)/2, diffEditLimit/2)
+	offset := limit + 1
+
+	// forward[offset+k] and backward[offset+k] hold the furthest x reached on diagonal k
+	// from the start and from the end respectively.
+	forward := make([]int, 2*limit+3)
+	backward := make([]int, 2*limit+3)
+
+	for d := 0; d <= limit; d++ {
+		for k := -d; k <= d; k += 2 {
+			x = forward[offset+k-1] + 1
+			if k == -d || (k != d && forward[offset+k-1] < forward[offset+k+1]) {
+				x = forward[offset+k+1]
+			}
+
+			y = x - k
+			u, v = x, y
+
+			for u < n && v < m && a[u] == b[v] {
+				u++
+				v++
+			}
+
+			forward[offset+k] = u
+
+			if back := delta - k; odd && back >= -(d-1) && back <= d-1 && u+backward[offset+back] >= n {
+				return x, y, u, v, true
+			}
+		}
+
+		for k := -d; k <= d; k += 2 {
+			x = backward[offset+k-1] + 1
+			if k == -d || (k != d && backward[offset+k-1] < backward[offset+k+1]) {
+				x = backward[offset+k+1]
+			}
+
+			y = x - k
+			u, v = x, y
+
+			for u < n && v < m && a[n-1-u] == b[m-1-v] {
+				u++
+				v++
+			}
+
+			backward[offset+k] = u
+
+			if front := delta - k; !odd && front >= -d && front <= d && u+forward[offset+front] >= n {
+				return n - u, m - v, n - x, m - y, true
+			}
+		}
+	}
+
+	return 0, 0, 0, 0, false
+}
@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+type MatchMode string
+
+const (
+	MatchExact  MatchMode = "exact"
+	MatchPrefix MatchMode = "prefix"
+	MatchFuzzy  MatchMode = "fuzzy"
+)
+
+func ParseMatchMode(value string) (MatchMode, error) {
+	switch mode := MatchMode(value); mode {
+	case "":
+		return MatchExact, nil
+	case MatchExact, MatchPrefix, MatchFuzzy:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("unknown match mode %q", value)
+	}
+}
+
+// SongSortFields lists the keys accepted by the sort parameter of GET /songs.
+var SongSortFields = []string{"id", "group_name", "song_name", "release_date"}
+
+type SortField struct {
+	Field string
+	Desc  bool
+}
+
+// ParseSort parses a comma separated list of sort keys, a leading "-" means descending order.
+func ParseSort(value string, allowed []string) ([]SortField, error) {
+	if strings.TrimSpace(value) == "" {
+		return nil, nil
+	}
+
+	parts := strings.Split(value, ",")
+	fields := make([]SortField, 0, len(parts))
+	seen := make(map[string]bool, len(parts))
+
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		field := SortField{Field: strings.TrimPrefix(part, "-"), Desc: strings.HasPrefix(part, "-")}
+
+		if !slices.Contains(allowed, field.Field) {
+			return nil, fmt.Errorf("unknown sort field %q", field.Field)
+		}
+
+		if seen[field.Field] {
+			return nil, fmt.Errorf("duplicate sort field %q", field.Field)
+		}
+
+		seen[field.Field] = true
+
+		fields = append(fields, field)
+	}
+
+	return fields, nil
+}
+
+type SongFilter struct {
+	GroupID        int
+	Groups         []string
+	Role           ArtistRole
+	Song           string
+	Album          string
+	Text           string
+	Link           string
+	Language       string
+	ReleaseDate    *time.Time
+	ReleasedFrom   *time.Time
+	ReleasedTo     *time.Time
+	Tags           []string
+	TagMatch       TagMatch
+	Match          MatchMode
+	Sort           []SortField
+	IncludeDeleted bool
+}
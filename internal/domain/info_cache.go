@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// InfoCacheEntry is a stored answer of the music info API for a group and song.
+type InfoCacheEntry struct {
+	Group     string          `json:"group"`
+	Song      string          `json:"song"`
+	Status    int             `json:"status"`
+	Payload   json.RawMessage `json:"payload,omitempty" swaggertype:"object"`
+	FetchedAt time.Time       `json:"fetched_at"`
+	ExpiresAt time.Time       `json:"expires_at"`
+	Expired   bool            `json:"expired"`
+}
+
+// InfoCacheFilter selects cache entries, Group and Song are matched after normalization.
+type InfoCacheFilter struct {
+	Group   string
+	Song    string
+	Expired bool
+}
+
+// InfoCachePolicy keeps song details for TTL and missing songs for NegativeTTL, a zero
+// duration disables caching of the kind.
+type InfoCachePolicy struct {
+	TTL         time.Duration
+	NegativeTTL time.Duration
+}
+
+// Lifetime returns how long an answer with the status stays fresh, zero when it is not cached.
+func (p InfoCachePolicy) Lifetime(status int) time.Duration {
+	switch status {
+	case http.StatusOK:
+		return p.TTL
+	case http.StatusNotFound:
+		return p.NegativeTTL
+	default:
+		return 0
+	}
+}
+
+// Annotate sets the expiry of the entry according to the policy.
+func (p InfoCachePolicy) Annotate(entry *InfoCacheEntry, now time.Time) {
+	entry.ExpiresAt = entry.FetchedAt.Add(p.Lifetime(entry.Status))
+	entry.Expired = !now.Before(entry.ExpiresAt)
+}
+
+// NormalizeLookupKey lowercases the name and collapses whitespace, so lookups of "The  Beatles"
+// and "the beatles" share a cache entry. It follows the normalize_group_name SQL function
+// used for group aliases.
+func NormalizeLookupKey(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), " "))
+}
@@ -0,0 +1,251 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type LyricsFormat string
+
+const (
+	LyricsFormatJSON LyricsFormat = "json"
+	LyricsFormatLRC  LyricsFormat = "lrc"
+)
+
+func ParseLyricsFormat(value string) (LyricsFormat, error) {
+	switch format := LyricsFormat(value); format {
+	case "":
+		return LyricsFormatJSON, nil
+	case LyricsFormatJSON, LyricsFormatLRC:
+		return format, nil
+	default:
+		return "", fmt.Errorf("unknown lyrics format %q", value)
+	}
+}
+
+// SyncedLine is a lyrics line shown from TimeMs milliseconds into the song.
+type SyncedLine struct {
+	TimeMs int    `json:"timeMs"`
+	Text   string `json:"text"`
+}
+
+// SyncedLyrics holds time-synced lyrics of a song with LRC metadata tags such as ar or ti.
+type SyncedLyrics struct {
+	SongID int               `json:"song_id"`
+	Tags   map[string]string `json:"tags,omitempty"`
+	Lines  []SyncedLine      `json:"lines"`
+}
+
+// LyricsPosition is the line active at a playback position followed by the upcoming lines.
+type LyricsPosition struct {
+	TimeMs int          `json:"timeMs"`
+	Active *SyncedLine  `json:"active"`
+	Next   []SyncedLine `json:"next"`
+}
+
+var (
+	lrcTimestamp = regexp.MustCompile(`^\[(\d+):(\d{1,2})(?:[.:](\d{1,3}))?\]`)
+	lrcTag       = regexp.MustCompile(`^\[([a-zA-Z#]+):(.*)\]$`)
+	lrcWordTime  = regexp.MustCompile(`<\d+:\d{1,2}(?:[.:]\d{1,3})?>`)
+
+	// lrcTagOrder keeps the well known tags first when writing LRC.
+	lrcTagOrder = []string{"ti", "ar", "al", "au", "by", "length", "re", "ve"}
+)
+
+// MaxSyncedTimeMs is the latest time a synced line can be stored at.
+const MaxSyncedTimeMs = math.MaxInt32
+
+var ErrNoSyncedLines = errors.New("lyrics contain no timestamped lines")
+
+// ParseLRC reads lyrics in the LRC format. Lines may carry several timestamps, the offset tag
+// is applied to the times and dropped, and lines without timestamps are ignored.
+func ParseLRC(data string) (*SyncedLyrics, error) {
+	lyrics := &SyncedLyrics{Tags: map[string]string{}, Lines: []SyncedLine{}}
+	offset := 0
+
+	for _, raw := range strings.Split(strings.ReplaceAll(data, "\r\n", "\n"), "\n") {
+		line := strings.TrimSpace(raw)
+
+		var times []int
+
+		for {
+			match := lrcTimestamp.FindStringSubmatch(line)
+			if match == nil {
+				break
+			}
+
+			t, err := lrcMillis(match[1], match[2], match[3])
+			if err != nil {
+				return nil, err
+			}
+
+			times = append(times, t)
+			line = line[len(match[0]):]
+		}
+
+		if len(times) == 0 {
+			if match := lrcTag.FindStringSubmatch(line); match != nil {
+				key, value := strings.ToLower(match[1]), strings.TrimSpace(match[2])
+
+				if key == "offset" {
+					parsed, err := strconv.Atoi(value)
+					if err != nil {
+						return nil, fmt.Errorf("invalid offset tag %q", value)
+					}
+
+					offset = parsed
+				} else {
+					lyrics.Tags[key] = value
+				}
+			}
+
+			continue
+		}
+
+		text := strings.TrimSpace(lrcWordTime.ReplaceAllString(line, ""))
+		for _, t := range times {
+			lyrics.Lines = append(lyrics.Lines, SyncedLine{TimeMs: t, Text: text})
+		}
+	}
+
+	if len(lyrics.Lines) == 0 {
+		return nil, ErrNoSyncedLines
+	}
+
+	// A positive offset makes lyrics appear sooner.
+	for i := range lyrics.Lines {
+		t := max(int64(lyrics.Lines[i].TimeMs)-int64(offset), 0)
+		if t > MaxSyncedTimeMs {
+			return nil, fmt.Errorf("offset %d moves lines past the latest time", offset)
+		}
+
+		lyrics.Lines[i].TimeMs = int(t)
+	}
+
+	sort.SliceStable(lyrics.Lines, func(i, j int) bool {
+		return lyrics.Lines[i].TimeMs < lyrics.Lines[j].TimeMs
+	})
+
+	return lyrics, nil
+}
+
+// lrcMillis converts the parts of a timestamp to milliseconds, rejecting times that do not
+// fit in MaxSyncedTimeMs.
+func lrcMillis(minutes, seconds, fraction string) (int, error) {
+	m, err := strconv.ParseInt(minutes, 10, 64)
+	if err != nil || m > MaxSyncedTimeMs/60000 {
+		return 0, fmt.Errorf("timestamp minutes %q out of range", minutes)
+	}
+
+	s, err := strconv.Atoi(seconds)
+	if err != nil {
+		return 0, fmt.Errorf("invalid timestamp seconds %q", seconds)
+	}
+
+	ms := 0
+
+	if fraction != "" {
+		if ms, err = strconv.Atoi(fraction); err != nil {
+			return 0, fmt.Errorf("invalid timestamp fraction %q", fraction)
+		}
+
+		for i := len(fraction); i < 3; i++ {
+			ms *= 10
+		}
+	}
+
+	total := (m*60+int64(s))*1000 + int64(ms)
+	if total > MaxSyncedTimeMs {
+		return 0, fmt.Errorf("timestamp %s:%s is past the latest time", minutes, seconds)
+	}
+
+	return int(total), nil
+}
+
+// FormatLRC writes the lyrics in the LRC format with centisecond timestamps.
+func FormatLRC(lyrics *SyncedLyrics) string {
+	var b strings.Builder
+
+	keys := make([]string, 0, len(lyrics.Tags))
+	for key := range lyrics.Tags {
+		keys = append(keys, key)
+	}
+
+	slices.SortFunc(keys, func(a, b string) int {
+		ia, ib := slices.Index(lrcTagOrder, a), slices.Index(lrcTagOrder, b)
+
+		switch {
+		case ia >= 0 && ib >= 0:
+			return ia - ib
+		case ia >= 0:
+			return -1
+		case ib >= 0:
+			return 1
+		default:
+			return strings.Compare(a, b)
+		}
+	})
+
+	for _, key := range keys {
+		fmt.Fprintf(&b, "[%s:%s]\n", key, lyrics.Tags[key])
+	}
+
+	for _, line := range lyrics.Lines {
+		cs := line.TimeMs / 10
+		fmt.Fprintf(&b, "[%02d:%02d.%02d]%s\n", cs/6000, cs/100%60, cs%100, line.Text)
+	}
+
+	return b.String()
+}
+
+// PositionAt returns the line active at timeMs and up to next following lines.
+func (l *SyncedLyrics) PositionAt(timeMs, next int) LyricsPosition {
+	i := sort.Search(len(l.Lines), func(i int) bool {
+		return l.Lines[i].TimeMs > timeMs
+	})
+
+	end := len(l.Lines)
+	if next < end-i {
+		end = i + max(next, 0)
+	}
+
+	position := LyricsPosition{TimeMs: timeMs, Next: l.Lines[i:end]}
+	if i > 0 {
+		position.Active = &l.Lines[i-1]
+	}
+
+	return position
+}
+
+// AttachTimes sets the times of verse lines from the synced lyrics. Lines are matched by text
+// in order, so repeated choruses get the times of their own occurrence.
+func AttachTimes(stanzas []Verse, lyrics *SyncedLyrics) {
+	next := 0
+
+	for i := range stanzas {
+		times := make([]*int, len(stanzas[i].Lines))
+		found := false
+
+		for j, line := range stanzas[i].Lines {
+			for k := next; k < len(lyrics.Lines); k++ {
+				if strings.EqualFold(lyrics.Lines[k].Text, line) {
+					times[j] = &lyrics.Lines[k].TimeMs
+					next = k + 1
+					found = true
+
+					break
+				}
+			}
+		}
+
+		if found {
+			stanzas[i].Times = times
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package domain
+
+import "time"
+
+// Song fields filled in from metadata providers, used as keys of MetadataSources.
+const (
+	MetadataFieldReleaseDate = "release_date"
+	MetadataFieldText        = "text"
+	MetadataFieldLink        = "link"
+	MetadataFieldAlbum       = "album"
+)
+
+// MetadataSources maps a song field to the name of the provider that supplied it.
+type MetadataSources map[string]string
+
+// AlbumMetadata is the album a provider reports for a song.
+type AlbumMetadata struct {
+	Title       string
+	CoverLink   string
+	ReleaseDate *time.Time
+	TrackNumber *int
+}
+
+// SongMetadata is what a provider knows about a song, zero values stand for unknown fields.
+type SongMetadata struct {
+	ReleaseDate time.Time
+	Text        string
+	Link        string
+	Album       *AlbumMetadata
+	Sources     MetadataSources
+}
+
+// Merge fills the fields still unknown with the ones from other and records provider as
+// their source.
+func (m *SongMetadata) Merge(other *SongMetadata, provider string) {
+	if m.Sources == nil {
+		m.Sources = make(MetadataSources)
+	}
+
+	if m.ReleaseDate.IsZero() && !other.ReleaseDate.IsZero() {
+		m.ReleaseDate = other.ReleaseDate
+		m.Sources[MetadataFieldReleaseDate] = provider
+	}
+
+	if m.Text == "" && other.Text != "" {
+		m.Text = other.Text
+		m.Sources[MetadataFieldText] = provider
+	}
+
+	if m.Link == "" && other.Link != "" {
+		m.Link = other.Link
+		m.Sources[MetadataFieldLink] = provider
+	}
+
+	if m.Album == nil && other.Album != nil && other.Album.Title != "" {
+		m.Album = other.Album
+		m.Sources[MetadataFieldAlbum] = provider
+	}
+}
+
+// Complete reports whether every field is known, so no further provider has to be asked.
+func (m *SongMetadata) Complete() bool {
+	return !m.ReleaseDate.IsZero() && m.Text != "" && m.Link != "" && m.Album != nil
+}
+
+// Empty reports whether no provider supplied any field.
+func (m *SongMetadata) Empty() bool {
+	return len(m.Sources) == 0
+}
@@ -0,0 +1,101 @@
+package domain
+
+import "time"
+
+// States of a metadata refresh run.
+const (
+	RefreshRunRunning  = "running"
+	RefreshRunFinished = "finished"
+	RefreshRunFailed   = "failed"
+)
+
+// RefreshableFields are the song fields the metadata refresh compares with the providers.
+var RefreshableFields = []string{MetadataFieldReleaseDate, MetadataFieldText, MetadataFieldLink}
+
+// RefreshRun is a pass of the metadata refresh over songs whose metadata got old, counting
+// songs with applied changes, with proposals only, without changes and failed lookups.
+type RefreshRun struct {
+	ID         int                `json:"id"`
+	Status     string             `json:"status"`
+	Checked    int                `json:"checked"`
+	Changed    int                `json:"changed"`
+	Proposed   int                `json:"proposed"`
+	Unchanged  int                `json:"unchanged"`
+	Failed     int                `json:"failed"`
+	Error      string             `json:"error,omitempty"`
+	StartedAt  time.Time          `json:"started_at"`
+	FinishedAt *time.Time         `json:"finished_at,omitempty"`
+	Proposals  []MetadataProposal `json:"proposals,omitempty"`
+}
+
+// MetadataProposal is a provider value left unapplied because the field was edited manually.
+type MetadataProposal struct {
+	ID        int       `json:"id"`
+	RunID     int       `json:"run_id"`
+	SongID    int       `json:"song_id"`
+	Field     string    `json:"field"`
+	Current   string    `json:"current"`
+	Proposed  string    `json:"proposed"`
+	Provider  string    `json:"provider,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// MetadataChange is a refreshable field whose provider value differs from the stored one.
+type MetadataChange struct {
+	Field    string
+	Current  string
+	Proposed string
+	Provider string
+}
+
+// MetadataChanges compares the refreshable fields of the song with the looked up metadata,
+// fields unknown to the providers are left out.
+func MetadataChanges(song *Song, metadata *SongMetadata) []MetadataChange {
+	var changes []MetadataChange
+
+	add := func(field, current, proposed string) {
+		if proposed != "" && proposed != current {
+			changes = append(changes, MetadataChange{
+				Field:    field,
+				Current:  current,
+				Proposed: proposed,
+				Provider: metadata.Sources[field],
+			})
+		}
+	}
+
+	add(MetadataFieldReleaseDate, formatMetadataDate(song.ReleaseDate), formatMetadataDate(metadata.ReleaseDate))
+	add(MetadataFieldText, song.Text, metadata.Text)
+	add(MetadataFieldLink, song.Link, metadata.Link)
+
+	return changes
+}
+
+// ApplyMetadataChange copies the field of the change from metadata into the song and records
+// its provider.
+func ApplyMetadataChange(song *Song, metadata *SongMetadata, change MetadataChange) {
+	switch change.Field {
+	case MetadataFieldReleaseDate:
+		song.ReleaseDate = metadata.ReleaseDate
+	case MetadataFieldText:
+		song.Text = metadata.Text
+	case MetadataFieldLink:
+		song.Link = metadata.Link
+	default:
+		return
+	}
+
+	if song.Sources == nil {
+		song.Sources = make(MetadataSources)
+	}
+
+	song.Sources[change.Field] = change.Provider
+}
+
+func formatMetadataDate(date time.Time) string {
+	if date.IsZero() {
+		return ""
+	}
+
+	return date.Format("2006-01-02")
+}
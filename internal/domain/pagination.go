@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+// Page is the response envelope for paginated listings. Page is only set when the
+// listing was requested by page number, Next and Prev are opaque keyset cursors.
+type Page[T any] struct {
+	Items []T    `json:"items"`
+	Total int    `json:"total"`
+	Page  int    `json:"page,omitempty"`
+	Size  int    `json:"size"`
+	Next  string `json:"next,omitempty"`
+	Prev  string `json:"prev,omitempty"`
+}
+
+// PageRequest selects either offset pagination by Page or keyset pagination by Cursor.
+type PageRequest struct {
+	Page   int
+	Size   int
+	Cursor *Cursor
+}
+
+func (p PageRequest) Offset() int {
+	return (p.Page - 1) * p.Size
+}
+
+// Cursor points at a row by the values of its sort keys, the last one being the row ID.
+type Cursor struct {
+	Values   []string `json:"v"`
+	Backward bool     `json:"b,omitempty"`
+}
+
+func EncodeCursor(cursor *Cursor) string {
+	raw, _ := json.Marshal(cursor)
+
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func DecodeCursor(value string) (*Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, errors.New("malformed cursor")
+	}
+
+	var cursor Cursor
+	if err := json.Unmarshal(raw, &cursor); err != nil || len(cursor.Values) == 0 {
+		return nil, errors.New("malformed cursor")
+	}
+
+	return &cursor, nil
+}
@@ -3,8 +3,9 @@ package domain
 import "time"
 
 type AddSongRequest struct {
-	Group string `json:"group"`
-	Song  string `json:"song"`
+	Group    string `json:"group"`
+	Song     string `json:"song"`
+	Language string `json:"language"`
 }
 
 type UpdateSongRequest struct {
@@ -13,4 +14,60 @@ type UpdateSongRequest struct {
 	ReleaseDate time.Time `json:"releaseDate"`
 	Text        string    `json:"text"`
 	Link        string    `json:"link"`
+	Language    string    `json:"language"`
+	Reason      string    `json:"reason"`
+}
+
+type GroupRequest struct {
+	Name string `json:"name" binding:"required"`
+}
+
+type MergeGroupsRequest struct {
+	Sources []int `json:"sources" binding:"required,min=1"`
+}
+
+type GroupAliasRequest struct {
+	Alias string `json:"alias" binding:"required"`
+}
+
+type AlbumRequest struct {
+	Group       string     `json:"group" binding:"required"`
+	Title       string     `json:"title" binding:"required"`
+	ReleaseDate *time.Time `json:"releaseDate"`
+	CoverLink   string     `json:"coverLink"`
+}
+
+type SongTagsRequest struct {
+	Genres []string `json:"genres"`
+	Tags   []string `json:"tags"`
+}
+
+type SongArtistRequest struct {
+	Group string     `json:"group" binding:"required"`
+	Role  ArtistRole `json:"role" binding:"required"`
+}
+
+type SongArtistsRequest struct {
+	Artists []SongArtistRequest `json:"artists" binding:"required,min=1,dive"`
+}
+
+type RestoreRevisionRequest struct {
+	Reason string `json:"reason"`
+}
+
+type AnnotationRequest struct {
+	StartLine int    `json:"startLine" binding:"required,min=1"`
+	EndLine   int    `json:"endLine" binding:"omitempty,min=1"`
+	Body      string `json:"body" binding:"required"`
+}
+
+type TranslationRequest struct {
+	Language   string `json:"language" binding:"required"`
+	Text       string `json:"text" binding:"required"`
+	Translator string `json:"translator"`
+}
+
+type UpdateTranslationRequest struct {
+	Text       string `json:"text" binding:"required"`
+	Translator string `json:"translator"`
 }
@@ -4,10 +4,35 @@ type AddSongResponse struct {
 	ID int `json:"id"`
 }
 
+// SongStatusResponse reports the enrichment state of a song, StatusURL is where it can be polled.
+type SongStatusResponse struct {
+	ID        int    `json:"id"`
+	Status    string `json:"status"`
+	Error     string `json:"error,omitempty"`
+	StatusURL string `json:"statusUrl"`
+}
+
 type GetSongVersesResponse struct {
-	Verses []string `json:"verses"`
-	Page   int      `json:"page"`
-	Size   int      `json:"size"`
+	Unit       VerseUnit `json:"unit"`
+	Language   string    `json:"language,omitempty"`
+	SideBySide bool      `json:"sideBySide,omitempty"`
+	Verses     []Verse   `json:"verses"`
+	Total      int       `json:"total"`
+	Page       int       `json:"page"`
+	Size       int       `json:"size"`
+}
+
+type MergeGroupsResponse struct {
+	Group      Group `json:"group"`
+	MovedSongs int   `json:"movedSongs"`
+}
+
+type GroupAliasesResponse struct {
+	Aliases []string `json:"aliases"`
+}
+
+type PurgeInfoCacheResponse struct {
+	Purged int `json:"purged"`
 }
 
 type ErrorResponse struct {
@@ -0,0 +1,26 @@
+package domain
+
+import "time"
+
+// Revision is a snapshot of song fields stored whenever the song is changed.
+type Revision struct {
+	Number      int       `json:"revision"`
+	SongID      int       `json:"song_id"`
+	Group       string    `json:"group"`
+	Song        string    `json:"song"`
+	ReleaseDate time.Time `json:"release_date"`
+	Text        string    `json:"text,omitempty"`
+	Link        string    `json:"link"`
+	Language    string    `json:"language,omitempty"`
+	Author      string    `json:"author,omitempty"`
+	Reason      string    `json:"reason,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// RevisionInfo describes who changed a song and why. Refresh marks changes made by the
+// metadata refresh, they are not counted as manual edits.
+type RevisionInfo struct {
+	Author  string
+	Reason  string
+	Refresh bool
+}
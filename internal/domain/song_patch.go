@@ -0,0 +1,59 @@
+package domain
+
+import "time"
+
+// SongDocument holds the editable fields of a song. PATCH /songs/{id} takes any subset of
+// them as a JSON merge patch (RFC 7396), where null removes the value of optional fields.
+type SongDocument struct {
+	Group       string    `json:"group"`
+	Song        string    `json:"song"`
+	ReleaseDate time.Time `json:"release_date"`
+	Text        string    `json:"text,omitempty"`
+	Link        string    `json:"link,omitempty"`
+	Language    string    `json:"language,omitempty"`
+}
+
+var (
+	// PatchableSongFields are the members a song merge patch may contain.
+	PatchableSongFields = []string{"group", "song", "release_date", "text", "link", "language"}
+	// RequiredSongFields are the members a song merge patch must not remove.
+	RequiredSongFields = []string{"group", "song", "release_date"}
+)
+
+func NewSongDocument(song *Song) SongDocument {
+	return SongDocument{
+		Group:       song.Group,
+		Song:        song.Song,
+		ReleaseDate: song.ReleaseDate,
+		Text:        song.Text,
+		Link:        song.Link,
+		Language:    song.Language,
+	}
+}
+
+// MergePatch applies a merge patch to a decoded JSON value as described in RFC 7396: members
+// of patch objects replace those of target objects recursively, null members remove them and
+// anything other than an object replaces the target as a whole.
+func MergePatch(target, patch any) any {
+	patchObject, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+
+	targetObject, ok := target.(map[string]any)
+	if !ok {
+		targetObject = make(map[string]any, len(patchObject))
+	}
+
+	for name, value := range patchObject {
+		if value == nil {
+			delete(targetObject, name)
+
+			continue
+		}
+
+		targetObject[name] = MergePatch(targetObject[name], value)
+	}
+
+	return targetObject
+}
@@ -2,14 +2,37 @@ package domain
 
 import "time"
 
+// Enrichment states of a song. Songs added asynchronously stay pending until a worker claims
+// them, and processing while it fills them in from the metadata providers.
+const (
+	SongStatusPending    = "pending"
+	SongStatusProcessing = "processing"
+	SongStatusEnriched   = "enriched"
+	SongStatusFailed     = "failed"
+)
+
 type Song struct {
-	ID          int       `json:"id"`
-	GroupID     int       `json:"-"`
-	Group       string    `json:"group"`
-	Song        string    `json:"song"`
-	ReleaseDate time.Time `json:"release_date"`
-	Text        string    `json:"text"`
-	Link        string    `json:"link"`
+	ID           int             `json:"id"`
+	GroupID      int             `json:"-"`
+	Group        string          `json:"group"`
+	Song         string          `json:"song"`
+	ReleaseDate  time.Time       `json:"release_date"`
+	Text         string          `json:"text"`
+	Link         string          `json:"link"`
+	Language     string          `json:"language,omitempty"`
+	AlbumID      *int            `json:"album_id,omitempty"`
+	Album        string          `json:"album,omitempty"`
+	TrackNumber  *int            `json:"track_number,omitempty"`
+	Tags         []Tag           `json:"tags,omitempty"`
+	Artists      []SongArtist    `json:"artists,omitempty"`
+	Sources      MetadataSources `json:"sources,omitempty"`
+	Status       string          `json:"status,omitempty"`
+	StatusError  string          `json:"status_error,omitempty"`
+	EnrichedAt   *time.Time      `json:"enriched_at,omitempty"`
+	EditedFields []string        `json:"edited_fields,omitempty"`
+	Version      int             `json:"version,omitempty"`
+	DeletedAt    *time.Time      `json:"deleted_at,omitempty"`
+	ClaimedAt    *time.Time      `json:"-"`
 }
 
 type SongDetail struct {
@@ -17,3 +40,9 @@ type SongDetail struct {
 	Text        string `json:"text"`
 	Link        string `json:"link"`
 }
+
+type SongSearchResult struct {
+	Song
+	Rank     float32 `json:"rank"`
+	Headline string  `json:"headline"`
+}
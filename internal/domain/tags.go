@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+type TagKind string
+
+const (
+	TagKindGenre TagKind = "genre"
+	TagKindTag   TagKind = "tag"
+)
+
+func ParseTagKind(value string) (TagKind, error) {
+	switch kind := TagKind(value); kind {
+	case "", TagKindGenre, TagKindTag:
+		return kind, nil
+	default:
+		return "", fmt.Errorf("unknown tag kind %q", value)
+	}
+}
+
+type Tag struct {
+	ID   int     `json:"id"`
+	Name string  `json:"name"`
+	Kind TagKind `json:"kind"`
+}
+
+type TagCount struct {
+	Tag
+	Songs int `json:"songs"`
+}
+
+// NormalizeTagName lowercases the name and collapses whitespace, so "Work  Out" and
+// "work out" refer to the same tag.
+func NormalizeTagName(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), " "))
+}
+
+type TagMatch string
+
+const (
+	TagMatchAny TagMatch = "any"
+	TagMatchAll TagMatch = "all"
+)
+
+func ParseTagMatch(value string) (TagMatch, error) {
+	switch match := TagMatch(value); match {
+	case "":
+		return TagMatchAny, nil
+	case TagMatchAny, TagMatchAll:
+		return match, nil
+	default:
+		return "", fmt.Errorf("unknown tag match %q", value)
+	}
+}
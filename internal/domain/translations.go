@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// languageCode matches BCP 47 style codes such as en, de or pt-br once normalized.
+var languageCode = regexp.MustCompile(`^[a-z]{2,3}(-[a-z0-9]{2,8})*$`)
+
+// ParseLanguage lowercases a language code and uses hyphens as separators, so "pt_BR" and
+// "pt-br" refer to the same language. An empty code stands for an unknown language.
+func ParseLanguage(value string) (string, error) {
+	code := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(value), "_", "-"))
+	if code != "" && !languageCode.MatchString(code) {
+		return "", fmt.Errorf("unknown language code %q", value)
+	}
+
+	return code, nil
+}
+
+// Translation is the text of a song translated into another language.
+type Translation struct {
+	SongID     int       `json:"song_id"`
+	Language   string    `json:"language"`
+	Text       string    `json:"text"`
+	Translator string    `json:"translator,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// PairTranslation sets the lines of each stanza of the translation on the stanza at the same
+// position of the original, both split with SplitStanzas.
+func PairTranslation(stanzas, translated []Verse) {
+	for i := range stanzas {
+		if i < len(translated) {
+			stanzas[i].Translation = translated[i].Lines
+		}
+	}
+}
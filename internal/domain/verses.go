@@ -0,0 +1,189 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+type VerseUnit string
+
+const (
+	VerseUnitLine   VerseUnit = "line"
+	VerseUnitStanza VerseUnit = "stanza"
+)
+
+func ParseVerseUnit(value string) (VerseUnit, error) {
+	switch unit := VerseUnit(value); unit {
+	case "":
+		return VerseUnitLine, nil
+	case VerseUnitLine, VerseUnitStanza:
+		return unit, nil
+	default:
+		return "", fmt.Errorf("unknown verse unit %q", value)
+	}
+}
+
+type VersesOptions struct {
+	Unit        VerseUnit
+	Collapse    bool
+	Annotations bool
+	Language    string
+	SideBySide  bool
+	Page        int
+	Size        int
+}
+
+// Verse is a stanza or a single line of lyrics. Line is the 1-based number of its first
+// line in the song text, RepeatOf is the 1-based index of the stanza it repeats. Times
+// holds the synced time in milliseconds of each line when synced lyrics exist.
+type Verse struct {
+	Label    string   `json:"label,omitempty"`
+	Line     int      `json:"line"`
+	Lines    []string `json:"lines,omitempty"`
+	Times    []*int   `json:"times,omitempty"`
+	RepeatOf int      `json:"repeatOf,omitempty"`
+
+	Translation []string `json:"translation,omitempty"`
+
+	Annotations []Annotation `json:"annotations,omitempty"`
+
+	// copied marks lines taken from the repeated stanza rather than from the text.
+	copied bool
+}
+
+var stanzaLabel = regexp.MustCompile(`^\[([^\]]+)\]$`)
+
+// SplitStanzas splits lyrics into stanzas separated by blank lines. A leading "[Chorus]"-style
+// line labels the stanza. A stanza repeating an earlier one refers to it and inherits its label,
+// a stanza made of a label alone repeats the last stanza with that label.
+func SplitStanzas(text string) []Verse {
+	stanzas := []Verse{}
+
+	var current *Verse
+
+	flush := func() {
+		if current != nil {
+			stanzas = append(stanzas, resolveRepeat(stanzas, *current))
+			current = nil
+		}
+	}
+
+	for i, line := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			flush()
+
+			continue
+		}
+
+		if match := stanzaLabel.FindStringSubmatch(line); match != nil {
+			flush()
+
+			current = &Verse{Label: strings.TrimSpace(match[1]), Line: i + 1}
+
+			continue
+		}
+
+		if current == nil {
+			current = &Verse{}
+		}
+
+		if len(current.Lines) == 0 {
+			current.Line = i + 1
+		}
+
+		current.Lines = append(current.Lines, line)
+	}
+
+	flush()
+
+	return stanzas
+}
+
+func resolveRepeat(stanzas []Verse, stanza Verse) Verse {
+	if len(stanza.Lines) == 0 {
+		for i := len(stanzas) - 1; i >= 0; i-- {
+			if strings.EqualFold(stanzas[i].Label, stanza.Label) && len(stanzas[i].Lines) > 0 {
+				stanza.Lines = stanzas[i].Lines
+				stanza.RepeatOf = originOf(stanzas, i)
+				stanza.copied = true
+
+				break
+			}
+		}
+
+		return stanza
+	}
+
+	for i, prev := range stanzas {
+		if prev.RepeatOf == 0 && slices.Equal(prev.Lines, stanza.Lines) {
+			stanza.RepeatOf = i + 1
+
+			if stanza.Label == "" {
+				stanza.Label = prev.Label
+			}
+
+			break
+		}
+	}
+
+	return stanza
+}
+
+func originOf(stanzas []Verse, i int) int {
+	if stanzas[i].RepeatOf != 0 {
+		return stanzas[i].RepeatOf
+	}
+
+	return i + 1
+}
+
+// CollapseRepeats drops the lines of stanzas repeating an earlier one, keeping the reference.
+func CollapseRepeats(stanzas []Verse) []Verse {
+	collapsed := make([]Verse, 0, len(stanzas))
+
+	for _, stanza := range stanzas {
+		if stanza.RepeatOf != 0 {
+			stanza.Lines, stanza.Times, stanza.Translation = nil, nil, nil
+		}
+
+		collapsed = append(collapsed, stanza)
+	}
+
+	return collapsed
+}
+
+// StanzaLines flattens stanzas into single line verses labelled with their stanza,
+// stanzas without lines are skipped.
+func StanzaLines(stanzas []Verse) []Verse {
+	lines := []Verse{}
+
+	for _, stanza := range stanzas {
+		for i, line := range stanza.Lines {
+			verse := Verse{
+				Label:    stanza.Label,
+				Line:     stanza.Line,
+				Lines:    []string{line},
+				RepeatOf: stanza.RepeatOf,
+				copied:   stanza.copied,
+			}
+			if !stanza.copied {
+				verse.Line += i
+			}
+
+			if stanza.Times != nil {
+				verse.Times = stanza.Times[i : i+1]
+			}
+
+			if i < len(stanza.Translation) {
+				verse.Translation = stanza.Translation[i : i+1]
+			}
+
+			lines = append(lines, verse)
+		}
+	}
+
+	return lines
+}
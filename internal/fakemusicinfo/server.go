@@ -0,0 +1,259 @@
+// Package fakemusicinfo serves the /info contract of the Music Info API from fixtures, for
+// local development and as an in-process server in tests. Latency, random errors and forced
+// status codes can be switched on to exercise the client resilience.
+package fakemusicinfo
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	client "github.com/mashfeii/songs_library/internal/api"
+	"github.com/mashfeii/songs_library/internal/domain"
+)
+
+// Fixture is a song known to the fake, Status forces the answer for this song only.
+type Fixture struct {
+	Group  string `json:"group"`
+	Song   string `json:"song"`
+	Status int    `json:"status,omitempty"`
+	client.SongDetail
+}
+
+// Options are the switches of the fake. Status answers every request with the status,
+// otherwise ErrorRate of the requests get ErrorStatus (500 by default). Latency delays
+// every answer.
+type Options struct {
+	Latency     time.Duration
+	ErrorRate   float64
+	ErrorStatus int
+	Status      int
+}
+
+// optionsBody is the JSON form of Options served on /options, latency is given as "250ms".
+type optionsBody struct {
+	Latency     string  `json:"latency"`
+	ErrorRate   float64 `json:"errorRate"`
+	ErrorStatus int     `json:"errorStatus,omitempty"`
+	Status      int     `json:"status,omitempty"`
+}
+
+func (o Options) body() optionsBody {
+	return optionsBody{
+		Latency:     o.Latency.String(),
+		ErrorRate:   o.ErrorRate,
+		ErrorStatus: o.ErrorStatus,
+		Status:      o.Status,
+	}
+}
+
+func (b optionsBody) options() (Options, error) {
+	opts := Options{ErrorRate: b.ErrorRate, ErrorStatus: b.ErrorStatus, Status: b.Status}
+
+	if b.Latency != "" {
+		latency, err := time.ParseDuration(b.Latency)
+		if err != nil {
+			return Options{}, err
+		}
+
+		opts.Latency = latency
+	}
+
+	if err := opts.Validate(); err != nil {
+		return Options{}, err
+	}
+
+	return opts, nil
+}
+
+// Validate checks the error rate and that the set statuses are valid HTTP status codes.
+func (o Options) Validate() error {
+	if o.ErrorRate < 0 || o.ErrorRate > 1 {
+		return fmt.Errorf("errorRate must be between 0 and 1")
+	}
+
+	if !validStatus(o.ErrorStatus) {
+		return fmt.Errorf("errorStatus %d is not an HTTP status code", o.ErrorStatus)
+	}
+
+	if !validStatus(o.Status) {
+		return fmt.Errorf("status %d is not an HTTP status code", o.Status)
+	}
+
+	return nil
+}
+
+// validStatus accepts unset statuses and codes WriteHeader can send.
+func validStatus(status int) bool {
+	return status == 0 || (status >= 100 && status <= 599)
+}
+
+type fixtureKey struct {
+	group string
+	song  string
+}
+
+// Server is the fake Music Info API. It serves GET /info and lets GET and PUT /options
+// read and change the switches while running.
+type Server struct {
+	fixtures map[fixtureKey]Fixture
+	calls    atomic.Int64
+
+	mu   sync.RWMutex
+	opts Options
+	rand *rand.Rand
+}
+
+func New(fixtures []Fixture, opts Options) *Server {
+	s := &Server{
+		fixtures: make(map[fixtureKey]Fixture, len(fixtures)),
+		opts:     opts,
+		rand:     rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64())),
+	}
+
+	for _, fixture := range fixtures {
+		s.fixtures[keyOf(fixture.Group, fixture.Song)] = fixture
+	}
+
+	return s
+}
+
+// LoadFixtures reads a JSON array of fixtures.
+func LoadFixtures(path string) ([]Fixture, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading fixtures: %w", err)
+	}
+
+	var fixtures []Fixture
+	if err := json.Unmarshal(data, &fixtures); err != nil {
+		return nil, fmt.Errorf("decoding fixtures: %w", err)
+	}
+
+	for _, fixture := range fixtures {
+		if !validStatus(fixture.Status) {
+			return nil, fmt.Errorf("fixture %q - %q: status %d is not an HTTP status code", fixture.Group, fixture.Song, fixture.Status)
+		}
+	}
+
+	return fixtures, nil
+}
+
+func (s *Server) Options() Options {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.opts
+}
+
+func (s *Server) SetOptions(opts Options) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.opts = opts
+}
+
+// Calls counts the /info requests served so far.
+func (s *Server) Calls() int {
+	return int(s.calls.Load())
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.URL.Path == "/info" && r.Method == http.MethodGet:
+		s.serveInfo(w, r)
+	case r.URL.Path == "/options" && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, s.Options().body())
+	case r.URL.Path == "/options" && r.Method == http.MethodPut:
+		var body optionsBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, "invalid options", http.StatusBadRequest)
+
+			return
+		}
+
+		opts, err := body.options()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+
+			return
+		}
+
+		s.SetOptions(opts)
+		writeJSON(w, http.StatusOK, opts.body())
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (s *Server) serveInfo(w http.ResponseWriter, r *http.Request) {
+	s.calls.Add(1)
+
+	opts, failed := s.roll()
+
+	if opts.Latency > 0 {
+		select {
+		case <-time.After(opts.Latency):
+		case <-r.Context().Done():
+			return
+		}
+	}
+
+	group, song := r.URL.Query().Get("group"), r.URL.Query().Get("song")
+
+	switch {
+	case opts.Status != 0:
+		s.writeStatus(w, opts.Status, group, song)
+	case failed:
+		w.WriteHeader(cmp.Or(opts.ErrorStatus, http.StatusInternalServerError))
+	case group == "" || song == "":
+		http.Error(w, "group and song are required", http.StatusBadRequest)
+	default:
+		fixture, ok := s.fixtures[keyOf(group, song)]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		s.writeStatus(w, cmp.Or(fixture.Status, http.StatusOK), group, song)
+	}
+}
+
+// roll returns the current options and whether this request is picked to fail.
+func (s *Server) roll() (Options, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.opts, s.opts.ErrorRate > 0 && s.rand.Float64() < s.opts.ErrorRate
+}
+
+// writeStatus answers with the status, a 200 carrying the fixture of the song when known.
+func (s *Server) writeStatus(w http.ResponseWriter, status int, group, song string) {
+	fixture, ok := s.fixtures[keyOf(group, song)]
+
+	switch {
+	case status != http.StatusOK:
+		w.WriteHeader(status)
+	case !ok:
+		w.WriteHeader(http.StatusNotFound)
+	default:
+		writeJSON(w, http.StatusOK, fixture.SongDetail)
+	}
+}
+
+func keyOf(group, song string) fixtureKey {
+	return fixtureKey{group: domain.NormalizeLookupKey(group), song: domain.NormalizeLookupKey(song)}
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
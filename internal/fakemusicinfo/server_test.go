@@ -0,0 +1,152 @@
+package fakemusicinfo_test
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	client "github.com/mashfeii/songs_library/internal/api"
+	"github.com/mashfeii/songs_library/internal/fakemusicinfo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var fixtures = []fakemusicinfo.Fixture{
+	{
+		Group: "Muse",
+		Song:  "Supermassive Black Hole",
+		SongDetail: client.SongDetail{
+			Text: "Ooh baby, don't you know I suffer?",
+			Link: "https://www.youtube.com/watch?v=Xsp3_a-PMTw",
+		},
+	},
+	{Group: "Daft Punk", Song: "Get Lucky", Status: http.StatusServiceUnavailable},
+}
+
+func newClient(t *testing.T, opts fakemusicinfo.Options) (*client.ClientWithResponses, *fakemusicinfo.Server, string) {
+	t.Helper()
+
+	server, fake := fakemusicinfo.NewTestServer(t, fixtures, opts)
+
+	generated, err := client.NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+
+	return generated, fake, server.URL
+}
+
+func TestServer_Info(t *testing.T) {
+	c, fake, _ := newClient(t, fakemusicinfo.Options{})
+	ctx := context.Background()
+
+	t.Run("Fixture", func(t *testing.T) {
+		response, err := c.GetInfoWithResponse(ctx, &client.GetInfoParams{Group: "muse", Song: "Supermassive  Black Hole"})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, response.StatusCode())
+		assert.Equal(t, "https://www.youtube.com/watch?v=Xsp3_a-PMTw", response.JSON200.Link)
+	})
+
+	t.Run("Unknown", func(t *testing.T) {
+		response, err := c.GetInfoWithResponse(ctx, &client.GetInfoParams{Group: "Muse", Song: "Uprising"})
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, response.StatusCode())
+	})
+
+	t.Run("MissingParams", func(t *testing.T) {
+		response, err := c.GetInfoWithResponse(ctx, &client.GetInfoParams{Group: "Muse"})
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+	})
+
+	t.Run("FixtureStatus", func(t *testing.T) {
+		response, err := c.GetInfoWithResponse(ctx, &client.GetInfoParams{Group: "Daft Punk", Song: "Get Lucky"})
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, response.StatusCode())
+	})
+
+	assert.Equal(t, 4, fake.Calls())
+}
+
+func TestServer_Switches(t *testing.T) {
+	c, fake, url := newClient(t, fakemusicinfo.Options{})
+	params := &client.GetInfoParams{Group: "Muse", Song: "Supermassive Black Hole"}
+
+	t.Run("Status", func(t *testing.T) {
+		fake.SetOptions(fakemusicinfo.Options{Status: http.StatusTooManyRequests})
+
+		response, err := c.GetInfoWithResponse(context.Background(), params)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusTooManyRequests, response.StatusCode())
+	})
+
+	t.Run("ErrorRate", func(t *testing.T) {
+		fake.SetOptions(fakemusicinfo.Options{ErrorRate: 1, ErrorStatus: http.StatusBadGateway})
+
+		response, err := c.GetInfoWithResponse(context.Background(), params)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadGateway, response.StatusCode())
+	})
+
+	t.Run("ErrorRateClientStatus", func(t *testing.T) {
+		fake.SetOptions(fakemusicinfo.Options{ErrorRate: 1, ErrorStatus: http.StatusTooManyRequests})
+
+		response, err := c.GetInfoWithResponse(context.Background(), params)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusTooManyRequests, response.StatusCode())
+	})
+
+	t.Run("ErrorRateDefaultStatus", func(t *testing.T) {
+		fake.SetOptions(fakemusicinfo.Options{ErrorRate: 1})
+
+		response, err := c.GetInfoWithResponse(context.Background(), params)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusInternalServerError, response.StatusCode())
+	})
+
+	t.Run("Latency", func(t *testing.T) {
+		fake.SetOptions(fakemusicinfo.Options{Latency: time.Second})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		_, err := c.GetInfoWithResponse(ctx, params)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("OptionsEndpoint", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodPut, url+"/options",
+			strings.NewReader(`{"latency":"10ms","errorRate":0.5,"errorStatus":503}`))
+		require.NoError(t, err)
+
+		response, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer response.Body.Close()
+
+		assert.Equal(t, http.StatusOK, response.StatusCode)
+		assert.Equal(t, fakemusicinfo.Options{Latency: 10 * time.Millisecond, ErrorRate: 0.5, ErrorStatus: 503}, fake.Options())
+	})
+
+	t.Run("InvalidOptions", func(t *testing.T) {
+		for _, body := range []string{`{"errorRate":2}`, `{"status":42}`, `{"errorRate":1,"errorStatus":1000}`} {
+			req, err := http.NewRequest(http.MethodPut, url+"/options", strings.NewReader(body))
+			require.NoError(t, err)
+
+			response, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			response.Body.Close()
+
+			assert.Equal(t, http.StatusBadRequest, response.StatusCode, body)
+		}
+	})
+}
+
+func TestLoadFixtures(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "fixtures.json")
+	require.NoError(t, os.WriteFile(path, []byte(`[{"group":"Muse","song":"Starlight","status":42}]`), 0o600))
+
+	_, err := fakemusicinfo.LoadFixtures(path)
+	assert.ErrorContains(t, err, "status 42")
+}
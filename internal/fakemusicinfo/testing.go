@@ -0,0 +1,18 @@
+package fakemusicinfo
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+// NewTestServer starts the fake in process and closes it when the test ends. The URL of the
+// returned server is the API_ENDPOINT of the generated client.
+func NewTestServer(t testing.TB, fixtures []Fixture, opts Options) (*httptest.Server, *Server) {
+	t.Helper()
+
+	fake := New(fixtures, opts)
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	return server, fake
+}
@@ -0,0 +1,352 @@
+package database_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mashfeii/songs_library/internal/domain"
+	"github.com/mashfeii/songs_library/internal/infrastructure/database"
+	clientErrors "github.com/mashfeii/songs_library/internal/infrastructure/errors"
+)
+
+// testPool connects to the migrated database in TEST_DATABASE_URL, the tests are skipped
+// without it.
+func testPool(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+
+	pool, err := pgxpool.New(context.Background(), dsn)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+
+	return pool
+}
+
+func TestPoolTransactor(t *testing.T) {
+	pool := testPool(t)
+	ctx := context.Background()
+	transactor := database.NewPoolTransactor(pool)
+	groupsRepo := database.NewGroupsPoolRepository(pool)
+
+	t.Run("RollbackOnError", func(t *testing.T) {
+		var groupID int
+
+		errFailed := errors.New("adding song failed")
+
+		err := transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+			var err error
+
+			groupID, err = groupsRepo.UpsertGroup(ctx, fmt.Sprintf("rollback %d", time.Now().UnixNano()))
+			if err != nil {
+				return err
+			}
+
+			_, err = groupsRepo.GetGroupByID(ctx, groupID)
+			require.NoError(t, err)
+
+			return errFailed
+		})
+		assert.ErrorIs(t, err, errFailed)
+
+		_, err = groupsRepo.GetGroupByID(ctx, groupID)
+		assert.True(t, errors.As(err, &clientErrors.ErrNotFound{}))
+	})
+
+	t.Run("CommitOnSuccess", func(t *testing.T) {
+		var groupID int
+
+		err := transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+			var err error
+
+			groupID, err = groupsRepo.UpsertGroup(ctx, fmt.Sprintf("commit %d", time.Now().UnixNano()))
+
+			return err
+		})
+		require.NoError(t, err)
+
+		t.Cleanup(func() { _ = groupsRepo.DeleteGroup(ctx, groupID, true) })
+
+		_, err = groupsRepo.GetGroupByID(ctx, groupID)
+		assert.NoError(t, err)
+	})
+}
+
+func TestSongsPoolRepository_Versions(t *testing.T) {
+	pool := testPool(t)
+	ctx := context.Background()
+	groupsRepo := database.NewGroupsPoolRepository(pool)
+	songsRepo := database.NewSongsPoolRepository(pool)
+
+	groupID, err := groupsRepo.UpsertGroup(ctx, fmt.Sprintf("versions %d", time.Now().UnixNano()))
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = groupsRepo.DeleteGroup(ctx, groupID, true) })
+
+	id, err := songsRepo.AddSong(ctx, &domain.Song{GroupID: groupID, Song: "Versioned"})
+	require.NoError(t, err)
+
+	song, err := songsRepo.GetSongByID(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, 1, song.Version)
+
+	song.Text = "first edit"
+	require.NoError(t, songsRepo.UpdateSong(ctx, song, domain.RevisionInfo{}))
+	assert.Equal(t, 2, song.Version)
+
+	stale := *song
+	stale.Version = 1
+	stale.Text = "lost edit"
+	err = songsRepo.UpdateSong(ctx, &stale, domain.RevisionInfo{})
+	assert.True(t, errors.As(err, &clientErrors.ErrPreconditionFailed{}))
+
+	require.NoError(t, groupsRepo.RenameGroup(ctx, groupID, fmt.Sprintf("renamed versions %d", time.Now().UnixNano())))
+
+	song, err = songsRepo.GetSongByID(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, 3, song.Version)
+
+	require.NoError(t, database.NewMetadataRefreshPoolRepository(pool).TouchSongMetadata(ctx, id))
+
+	song, err = songsRepo.GetSongByID(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, 3, song.Version)
+	assert.NotNil(t, song.EnrichedAt)
+
+	err = songsRepo.DeleteSong(ctx, id, 2)
+	assert.True(t, errors.As(err, &clientErrors.ErrPreconditionFailed{}))
+
+	assert.NoError(t, songsRepo.DeleteSong(ctx, id, 3))
+
+	err = songsRepo.DeleteSong(ctx, id, 0)
+	assert.True(t, errors.As(err, &clientErrors.ErrNotFound{}))
+}
+
+func TestSongsPoolRepository_Trash(t *testing.T) {
+	pool := testPool(t)
+	ctx := context.Background()
+	groupsRepo := database.NewGroupsPoolRepository(pool)
+	songsRepo := database.NewSongsPoolRepository(pool)
+
+	groupID, err := groupsRepo.UpsertGroup(ctx, fmt.Sprintf("trash %d", time.Now().UnixNano()))
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = groupsRepo.DeleteGroup(ctx, groupID, true) })
+
+	id, err := songsRepo.AddSong(ctx, &domain.Song{GroupID: groupID, Song: "Trashed"})
+	require.NoError(t, err)
+
+	require.NoError(t, songsRepo.DeleteSong(ctx, id, 0))
+
+	_, err = songsRepo.GetSongByID(ctx, id)
+	assert.True(t, errors.As(err, &clientErrors.ErrNotFound{}))
+
+	visible, err := songsRepo.GetSongs(ctx, &domain.SongFilter{GroupID: groupID}, domain.PageRequest{Page: 1, Size: 10})
+	require.NoError(t, err)
+	assert.Zero(t, visible.Total)
+
+	all, err := songsRepo.GetSongs(ctx, &domain.SongFilter{GroupID: groupID, IncludeDeleted: true},
+		domain.PageRequest{Page: 1, Size: 10})
+	require.NoError(t, err)
+	require.Len(t, all.Items, 1)
+	assert.NotNil(t, all.Items[0].DeletedAt)
+
+	require.NoError(t, songsRepo.RestoreSong(ctx, id))
+
+	err = songsRepo.RestoreSong(ctx, id)
+	assert.True(t, errors.As(err, &clientErrors.ErrNotFound{}))
+
+	require.NoError(t, songsRepo.DeleteSong(ctx, id, 0))
+
+	purged, err := songsRepo.PurgeDeletedSongs(ctx, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	assert.True(t, slices.ContainsFunc(purged, func(song domain.Song) bool {
+		return song.ID == id && song.Song == "Trashed" && song.DeletedAt != nil
+	}))
+
+	all, err = songsRepo.GetSongs(ctx, &domain.SongFilter{GroupID: groupID, IncludeDeleted: true},
+		domain.PageRequest{Page: 1, Size: 10})
+	require.NoError(t, err)
+	assert.Zero(t, all.Total)
+}
+
+func TestNormalizeGroupName(t *testing.T) {
+	pool := testPool(t)
+
+	for _, name := range []string{"The Beatles", "  The  Beatles ", "\tMuse\n", "AC/DC", "Sigur\t\tRós", "", " "} {
+		var normalized string
+
+		err := pool.QueryRow(context.Background(), `SELECT normalize_group_name($1)`, name).Scan(&normalized)
+		require.NoError(t, err)
+		assert.Equal(t, domain.NormalizeLookupKey(name), normalized, "%q", name)
+	}
+}
+
+func TestSongsPoolRepository_InvalidCursor(t *testing.T) {
+	pool := testPool(t)
+	ctx := context.Background()
+	songsRepo := database.NewSongsPoolRepository(pool)
+
+	filter := &domain.SongFilter{Sort: []domain.SortField{{Field: "release_date"}}}
+
+	for _, values := range [][]string{{"yesterday", "1"}, {"2006-06-19", "one"}, {"1"}} {
+		_, err := songsRepo.GetSongs(ctx, filter, domain.PageRequest{Size: 10, Cursor: &domain.Cursor{Values: values}})
+		assert.True(t, errors.As(err, &clientErrors.ErrInvalidInput{}), values)
+	}
+}
+
+func TestGroupsPoolRepository_DeleteCascade(t *testing.T) {
+	pool := testPool(t)
+	ctx := context.Background()
+	groupsRepo := database.NewGroupsPoolRepository(pool)
+	songsRepo := database.NewSongsPoolRepository(pool)
+
+	groupID, err := groupsRepo.UpsertGroup(ctx, fmt.Sprintf("cascade %d", time.Now().UnixNano()))
+	require.NoError(t, err)
+
+	id, err := songsRepo.AddSong(ctx, &domain.Song{GroupID: groupID, Song: "Cascaded"})
+	require.NoError(t, err)
+
+	err = groupsRepo.DeleteGroup(ctx, groupID, false)
+	assert.True(t, errors.As(err, &clientErrors.ErrConflict{}))
+
+	require.NoError(t, groupsRepo.DeleteGroup(ctx, groupID, true))
+
+	_, err = groupsRepo.GetGroupByID(ctx, groupID)
+	assert.True(t, errors.As(err, &clientErrors.ErrNotFound{}))
+
+	_, err = songsRepo.GetSongByID(ctx, id)
+	assert.True(t, errors.As(err, &clientErrors.ErrNotFound{}))
+
+	require.NoError(t, songsRepo.RestoreSong(ctx, id))
+
+	_, err = groupsRepo.GetGroupByID(ctx, groupID)
+	require.NoError(t, err)
+
+	err = groupsRepo.DeleteGroup(ctx, groupID, false)
+	assert.True(t, errors.As(err, &clientErrors.ErrConflict{}))
+
+	require.NoError(t, groupsRepo.DeleteGroup(ctx, groupID, true))
+
+	_, err = songsRepo.PurgeDeletedSongs(ctx, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+
+	err = groupsRepo.DeleteGroup(ctx, groupID, true)
+	assert.True(t, errors.As(err, &clientErrors.ErrNotFound{}))
+}
+
+// The test database is expected to hold no other pending songs.
+func TestSongsPoolRepository_Enrichment(t *testing.T) {
+	pool := testPool(t)
+	ctx := context.Background()
+	transactor := database.NewPoolTransactor(pool)
+	groupsRepo := database.NewGroupsPoolRepository(pool)
+	songsRepo := database.NewSongsPoolRepository(pool)
+
+	groupID, err := groupsRepo.UpsertGroup(ctx, fmt.Sprintf("enrichment %d", time.Now().UnixNano()))
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = groupsRepo.DeleteGroup(ctx, groupID, true) })
+
+	id, err := songsRepo.AddSong(ctx, &domain.Song{GroupID: groupID, Song: "Pending", Status: domain.SongStatusPending})
+	require.NoError(t, err)
+
+	song, err := songsRepo.ClaimPendingSong(ctx, time.Minute)
+	require.NoError(t, err)
+	require.NotNil(t, song)
+	require.Equal(t, id, song.ID)
+	assert.Equal(t, domain.SongStatusProcessing, song.Status)
+
+	claimedAt := song.ClaimedAt
+
+	again, err := songsRepo.ClaimPendingSong(ctx, time.Minute)
+	require.NoError(t, err)
+	assert.Nil(t, again)
+
+	// A failing write in a savepoint leaves the transaction usable to record the failure.
+	err = transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		invalid := *song
+		invalid.TrackNumber = new(int)
+
+		err := transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+			return songsRepo.CompleteEnrichment(ctx, &invalid)
+		})
+		require.True(t, errors.As(err, &clientErrors.ErrDatabase{}))
+
+		return songsRepo.FailEnrichment(ctx, song, "invalid track number")
+	})
+	require.NoError(t, err)
+
+	stored, err := songsRepo.GetSongByID(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, domain.SongStatusFailed, stored.Status)
+	assert.Equal(t, "invalid track number", stored.StatusError)
+
+	err = songsRepo.FailEnrichment(ctx, &domain.Song{ID: id, ClaimedAt: claimedAt}, "late worker")
+	assert.True(t, errors.As(err, &clientErrors.ErrConflict{}))
+}
+
+func TestAuditPoolRepository(t *testing.T) {
+	pool := testPool(t)
+	ctx := context.Background()
+	transactor := database.NewPoolTransactor(pool)
+	auditRepo := database.NewAuditPoolRepository(pool)
+	actor := fmt.Sprintf("audit %d", time.Now().UnixNano())
+	filter := domain.AuditFilter{Entity: domain.AuditEntityGroup, Actor: actor}
+	pageReq := domain.PageRequest{Page: 1, Size: 10}
+
+	addEvent := func(ctx context.Context, action string) error {
+		return auditRepo.AddAuditEvent(ctx, &domain.AuditEvent{
+			Actor:  actor,
+			Action: action,
+			Entity: domain.AuditEntityGroup,
+			After:  []byte(`{"name":"Muse"}`),
+		})
+	}
+
+	t.Run("RolledBackWithChange", func(t *testing.T) {
+		errFailed := errors.New("change failed")
+
+		err := transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+			require.NoError(t, addEvent(ctx, domain.AuditActionCreate))
+
+			return errFailed
+		})
+		assert.ErrorIs(t, err, errFailed)
+
+		events, err := auditRepo.GetAuditEvents(ctx, filter, pageReq)
+		require.NoError(t, err)
+		assert.Zero(t, events.Total)
+	})
+
+	t.Run("Filters", func(t *testing.T) {
+		require.NoError(t, transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+			return addEvent(ctx, domain.AuditActionCreate)
+		}))
+		require.NoError(t, addEvent(ctx, domain.AuditActionUpdate))
+
+		events, err := auditRepo.GetAuditEvents(ctx, filter, pageReq)
+		require.NoError(t, err)
+		require.Equal(t, 2, events.Total)
+		assert.Equal(t, domain.AuditActionUpdate, events.Items[0].Action)
+		assert.JSONEq(t, `{"name":"Muse"}`, string(events.Items[0].After))
+		assert.Nil(t, events.Items[0].Before)
+
+		future := time.Now().Add(time.Hour)
+		events, err = auditRepo.GetAuditEvents(ctx, domain.AuditFilter{Actor: actor, From: &future}, pageReq)
+		require.NoError(t, err)
+		assert.Zero(t, events.Total)
+	})
+}
@@ -0,0 +1,293 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/mashfeii/songs_library/internal/domain"
+	clientErrors "github.com/mashfeii/songs_library/internal/infrastructure/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type AlbumsRepository interface {
+	GetAlbums(ctx context.Context, groupID int, pageReq domain.PageRequest) (*domain.Page[domain.Album], error)
+	GetAlbumByID(ctx context.Context, id int) (*domain.Album, error)
+	GetAlbumTracks(ctx context.Context, id int) ([]domain.Song, error)
+	AddAlbum(ctx context.Context, album *domain.Album) (int, error)
+	UpsertAlbum(ctx context.Context, album *domain.Album) (int, error)
+	UpdateAlbum(ctx context.Context, album *domain.Album) error
+	DeleteAlbum(ctx context.Context, id int) error
+}
+
+type AlbumsPoolRepository struct {
+	Pool *pgxpool.Pool
+}
+
+func NewAlbumsPoolRepository(pool *pgxpool.Pool) *AlbumsPoolRepository {
+	return &AlbumsPoolRepository{Pool: pool}
+}
+
+const albumColumns = `al.id, al.group_id, g.name AS group_name, al.title, al.release_date, COALESCE(al.cover_link, '')`
+
+func albumDest(album *domain.Album) []any {
+	return []any{&album.ID, &album.GroupID, &album.Group, &album.Title, &album.ReleaseDate, &album.CoverLink}
+}
+
+// GetAlbums lists albums ordered by release date, groupID narrows the listing when non-zero.
+func (r *AlbumsPoolRepository) GetAlbums(ctx context.Context, groupID int, pageReq domain.PageRequest) (*domain.Page[domain.Album], error) {
+	logrus.WithFields(logrus.Fields{
+		"group_id": groupID,
+		"page":     pageReq.Page,
+		"size":     pageReq.Size,
+	}).Debug("Executing get albums query")
+
+	page := &domain.Page[domain.Album]{Items: []domain.Album{}, Page: pageReq.Page, Size: pageReq.Size}
+
+	err := conn(ctx, r.Pool).QueryRow(ctx, `SELECT count(*) FROM albums WHERE $1 = 0 OR group_id = $1`, groupID).Scan(&page.Total)
+	if err != nil {
+		logrus.WithField("error", err).Error("Failed to count albums in database")
+
+		return nil, fmt.Errorf("counting albums: %w", clientErrors.NewErrDatabase())
+	}
+
+	rows, err := conn(ctx, r.Pool).Query(ctx, `
+    SELECT `+albumColumns+`
+    FROM albums AS al
+    JOIN groups AS g ON al.group_id = g.id
+    WHERE $1 = 0 OR al.group_id = $1
+    ORDER BY al.release_date NULLS LAST, al.title, al.id
+    LIMIT $2 OFFSET $3
+    `, groupID, pageReq.Size, pageReq.Offset())
+	if err != nil {
+		logrus.WithField("error", err).Error("Failed to get albums from database")
+
+		return nil, fmt.Errorf("querying albums: %w", clientErrors.NewErrDatabase())
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var album domain.Album
+
+		if err := rows.Scan(albumDest(&album)...); err != nil {
+			return nil, fmt.Errorf("repo scanning albums: %w", clientErrors.NewErrDatabase())
+		}
+
+		page.Items = append(page.Items, album)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("repo reading albums: %w", clientErrors.NewErrDatabase())
+	}
+
+	return page, nil
+}
+
+func (r *AlbumsPoolRepository) GetAlbumByID(ctx context.Context, id int) (*domain.Album, error) {
+	logrus.WithFields(logrus.Fields{
+		"id": id,
+	}).Debug("Executing get album by id query")
+
+	var album domain.Album
+
+	err := conn(ctx, r.Pool).QueryRow(ctx, `
+    SELECT `+albumColumns+`
+    FROM albums AS al
+    JOIN groups AS g ON al.group_id = g.id
+    WHERE al.id = $1
+    `, id).Scan(albumDest(&album)...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, clientErrors.NewErrNotFound(fmt.Sprintf("album with id: %d", id))
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"error": err,
+			"id":    id,
+		}).Error("Failed to get album from database")
+
+		return nil, fmt.Errorf("querying album: %w", clientErrors.NewErrDatabase())
+	}
+
+	return &album, nil
+}
+
+// GetAlbumTracks returns the songs of the album in track order, songs without a track
+// number come last.
+func (r *AlbumsPoolRepository) GetAlbumTracks(ctx context.Context, id int) ([]domain.Song, error) {
+	logrus.WithFields(logrus.Fields{
+		"id": id,
+	}).Debug("Executing get album tracks query")
+
+	rows, err := conn(ctx, r.Pool).Query(ctx, `
+    SELECT `+songColumns+`
+    FROM `+songTables+`
+    WHERE s.album_id = $1 AND s.deleted_at IS NULL
+    ORDER BY s.track_number NULLS LAST, s.id
+    `, id)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"error": err,
+			"id":    id,
+		}).Error("Failed to get album tracks from database")
+
+		return nil, fmt.Errorf("querying album tracks: %w", clientErrors.NewErrDatabase())
+	}
+	defer rows.Close()
+
+	songs := []domain.Song{}
+
+	for rows.Next() {
+		var song domain.Song
+
+		if err := rows.Scan(songDest(&song)...); err != nil {
+			return nil, fmt.Errorf("repo scanning album tracks: %w", clientErrors.NewErrDatabase())
+		}
+
+		songs = append(songs, song)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("repo reading album tracks: %w", clientErrors.NewErrDatabase())
+	}
+
+	return songs, nil
+}
+
+func (r *AlbumsPoolRepository) AddAlbum(ctx context.Context, album *domain.Album) (int, error) {
+	logrus.WithFields(logrus.Fields{
+		"album": album,
+	}).Debug("Executing add album query")
+
+	var id int
+
+	err := conn(ctx, r.Pool).QueryRow(ctx, `
+    INSERT INTO albums (group_id, title, release_date, cover_link)
+    VALUES ($1, $2, $3, NULLIF($4, ''))
+    RETURNING id
+    `, album.GroupID, album.Title, album.ReleaseDate, album.CoverLink).Scan(&id)
+	if err != nil {
+		if isPgError(err, pgUniqueViolation) {
+			return 0, clientErrors.NewErrConflict(fmt.Sprintf("album %q of group %q already exists", album.Title, album.Group))
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"error": err,
+			"album": album,
+		}).Error("Failed to add album to database")
+
+		return 0, fmt.Errorf("repo adding album: %w", clientErrors.NewErrDatabase())
+	}
+
+	return id, nil
+}
+
+// UpsertAlbum returns the album of the group with the same title, creating it when missing.
+// Details already stored are kept, only missing ones are filled in.
+func (r *AlbumsPoolRepository) UpsertAlbum(ctx context.Context, album *domain.Album) (int, error) {
+	var id int
+
+	err := conn(ctx, r.Pool).QueryRow(ctx, `
+    INSERT INTO albums (group_id, title, release_date, cover_link)
+    VALUES ($1, $2, $3, NULLIF($4, ''))
+    ON CONFLICT (group_id, title) DO UPDATE SET
+      release_date = COALESCE(albums.release_date, EXCLUDED.release_date),
+      cover_link = COALESCE(albums.cover_link, EXCLUDED.cover_link)
+    RETURNING id
+    `, album.GroupID, album.Title, album.ReleaseDate, album.CoverLink).Scan(&id)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"error": err,
+			"album": album,
+		}).Error("Failed to upsert album in database")
+
+		return 0, fmt.Errorf("upserting album: %w", clientErrors.NewErrDatabase())
+	}
+
+	return id, nil
+}
+
+// UpdateAlbum changes the album details, the songs of the album get a new version as they
+// carry its title.
+func (r *AlbumsPoolRepository) UpdateAlbum(ctx context.Context, album *domain.Album) error {
+	logrus.WithFields(logrus.Fields{
+		"album": album,
+	}).Debug("Executing update album query")
+
+	tx, err := begin(ctx, r.Pool)
+	if err != nil {
+		return fmt.Errorf("beginning update album: %w", clientErrors.NewErrDatabase())
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	tag, err := tx.Exec(ctx, `
+    UPDATE albums
+    SET group_id = $1, title = $2, release_date = $3, cover_link = NULLIF($4, '')
+    WHERE id = $5
+    `, album.GroupID, album.Title, album.ReleaseDate, album.CoverLink, album.ID)
+	if err != nil {
+		if isPgError(err, pgUniqueViolation) {
+			return clientErrors.NewErrConflict(fmt.Sprintf("album %q of group %q already exists", album.Title, album.Group))
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"error": err,
+			"album": album,
+		}).Error("Failed to update album in database")
+
+		return fmt.Errorf("updating album: %w", clientErrors.NewErrDatabase())
+	}
+
+	if tag.RowsAffected() == 0 {
+		return clientErrors.NewErrNotFound(fmt.Sprintf("album with id: %d", album.ID))
+	}
+
+	if _, err := tx.Exec(ctx, `UPDATE songs SET version = version + 1 WHERE album_id = $1`, album.ID); err != nil {
+		return fmt.Errorf("versioning album songs: %w", clientErrors.NewErrDatabase())
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("committing update album: %w", clientErrors.NewErrDatabase())
+	}
+
+	return nil
+}
+
+// DeleteAlbum removes the album, its songs are kept, detached from it and get a new version.
+func (r *AlbumsPoolRepository) DeleteAlbum(ctx context.Context, id int) error {
+	logrus.WithFields(logrus.Fields{
+		"id": id,
+	}).Debug("Executing delete album query")
+
+	tx, err := begin(ctx, r.Pool)
+	if err != nil {
+		return fmt.Errorf("beginning delete album: %w", clientErrors.NewErrDatabase())
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if _, err := tx.Exec(ctx, `UPDATE songs SET version = version + 1 WHERE album_id = $1`, id); err != nil {
+		return fmt.Errorf("versioning album songs: %w", clientErrors.NewErrDatabase())
+	}
+
+	tag, err := tx.Exec(ctx, `DELETE FROM albums WHERE id = $1`, id)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"error": err,
+			"id":    id,
+		}).Error("Failed to delete album from database")
+
+		return fmt.Errorf("deleting album: %w", clientErrors.NewErrDatabase())
+	}
+
+	if tag.RowsAffected() == 0 {
+		return clientErrors.NewErrNotFound(fmt.Sprintf("album with id: %d", id))
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("committing delete album: %w", clientErrors.NewErrDatabase())
+	}
+
+	return nil
+}
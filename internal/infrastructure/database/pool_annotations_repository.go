@@ -0,0 +1,186 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/mashfeii/songs_library/internal/domain"
+	clientErrors "github.com/mashfeii/songs_library/internal/infrastructure/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type AnnotationsRepository interface {
+	GetAnnotations(ctx context.Context, songID int) ([]domain.Annotation, error)
+	GetAnnotation(ctx context.Context, songID, id int) (*domain.Annotation, error)
+	AddAnnotation(ctx context.Context, annotation *domain.Annotation) error
+	UpdateAnnotation(ctx context.Context, annotation *domain.Annotation) error
+	DeleteAnnotation(ctx context.Context, songID, id int) error
+}
+
+// markStaleAnnotationsQuery compares the anchor of every annotation of the song with the
+// lines currently at its range, lines are split the same way as domain.SplitLines.
+const markStaleAnnotationsQuery = `
+    UPDATE annotations AS an
+    SET stale = an.anchor_text IS DISTINCT FROM array_to_string(
+      (string_to_array(replace(s.text, E'\r\n', E'\n'), E'\n'))[an.start_line:an.end_line], E'\n')
+    FROM songs AS s
+    WHERE s.id = an.song_id AND an.song_id = $1`
+
+const annotationColumns = `id, song_id, start_line, end_line, body, anchor_text, author, stale, created_at, updated_at`
+
+func annotationDest(annotation *domain.Annotation) []any {
+	return []any{
+		&annotation.ID, &annotation.SongID, &annotation.StartLine, &annotation.EndLine, &annotation.Body,
+		&annotation.Anchor, &annotation.Author, &annotation.Stale, &annotation.CreatedAt, &annotation.UpdatedAt,
+	}
+}
+
+type AnnotationsPoolRepository struct {
+	Pool *pgxpool.Pool
+}
+
+func NewAnnotationsPoolRepository(pool *pgxpool.Pool) *AnnotationsPoolRepository {
+	return &AnnotationsPoolRepository{Pool: pool}
+}
+
+// GetAnnotations lists annotations of a song ordered by their first line.
+func (r *AnnotationsPoolRepository) GetAnnotations(ctx context.Context, songID int) ([]domain.Annotation, error) {
+	logrus.WithFields(logrus.Fields{
+		"song_id": songID,
+	}).Debug("Executing get annotations query")
+
+	rows, err := conn(ctx, r.Pool).Query(ctx, `
+    SELECT `+annotationColumns+`
+    FROM annotations
+    WHERE song_id = $1
+    ORDER BY start_line, end_line, id
+    `, songID)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"error":   err,
+			"song_id": songID,
+		}).Error("Failed to get annotations from database")
+
+		return nil, fmt.Errorf("querying annotations: %w", clientErrors.NewErrDatabase())
+	}
+	defer rows.Close()
+
+	annotations := []domain.Annotation{}
+
+	for rows.Next() {
+		var annotation domain.Annotation
+
+		if err := rows.Scan(annotationDest(&annotation)...); err != nil {
+			return nil, fmt.Errorf("repo scanning annotations: %w", clientErrors.NewErrDatabase())
+		}
+
+		annotations = append(annotations, annotation)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("repo reading annotations: %w", clientErrors.NewErrDatabase())
+	}
+
+	return annotations, nil
+}
+
+func (r *AnnotationsPoolRepository) GetAnnotation(ctx context.Context, songID, id int) (*domain.Annotation, error) {
+	logrus.WithFields(logrus.Fields{
+		"song_id": songID,
+		"id":      id,
+	}).Debug("Executing get annotation query")
+
+	var annotation domain.Annotation
+
+	err := conn(ctx, r.Pool).QueryRow(ctx, `
+    SELECT `+annotationColumns+`
+    FROM annotations
+    WHERE song_id = $1 AND id = $2
+    `, songID, id).Scan(annotationDest(&annotation)...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, clientErrors.NewErrNotFound(fmt.Sprintf("annotation with id: %d", id))
+		}
+
+		return nil, fmt.Errorf("querying annotation: %w", clientErrors.NewErrDatabase())
+	}
+
+	return &annotation, nil
+}
+
+func (r *AnnotationsPoolRepository) AddAnnotation(ctx context.Context, annotation *domain.Annotation) error {
+	logrus.WithFields(logrus.Fields{
+		"annotation": annotation,
+	}).Debug("Executing add annotation query")
+
+	err := conn(ctx, r.Pool).QueryRow(ctx, `
+    INSERT INTO annotations (song_id, start_line, end_line, body, anchor_text, author)
+    VALUES ($1, $2, $3, $4, $5, $6)
+    RETURNING id, stale, created_at, updated_at
+    `, annotation.SongID, annotation.StartLine, annotation.EndLine, annotation.Body, annotation.Anchor, annotation.Author).
+		Scan(&annotation.ID, &annotation.Stale, &annotation.CreatedAt, &annotation.UpdatedAt)
+	if err != nil {
+		if isPgError(err, pgForeignKeyViolation) {
+			return clientErrors.NewErrNotFound(fmt.Sprintf("song with id: %d", annotation.SongID))
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"error":      err,
+			"annotation": annotation,
+		}).Error("Failed to add annotation to database")
+
+		return fmt.Errorf("inserting annotation: %w", clientErrors.NewErrDatabase())
+	}
+
+	return nil
+}
+
+// UpdateAnnotation replaces the range, body and anchor of an annotation, which is no longer stale.
+func (r *AnnotationsPoolRepository) UpdateAnnotation(ctx context.Context, annotation *domain.Annotation) error {
+	logrus.WithFields(logrus.Fields{
+		"annotation": annotation,
+	}).Debug("Executing update annotation query")
+
+	err := conn(ctx, r.Pool).QueryRow(ctx, `
+    UPDATE annotations
+    SET start_line = $3, end_line = $4, body = $5, anchor_text = $6, stale = false, updated_at = now()
+    WHERE song_id = $1 AND id = $2
+    RETURNING author, stale, created_at, updated_at
+    `, annotation.SongID, annotation.ID, annotation.StartLine, annotation.EndLine, annotation.Body, annotation.Anchor).
+		Scan(&annotation.Author, &annotation.Stale, &annotation.CreatedAt, &annotation.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return clientErrors.NewErrNotFound(fmt.Sprintf("annotation with id: %d", annotation.ID))
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"error":      err,
+			"annotation": annotation,
+		}).Error("Failed to update annotation in database")
+
+		return fmt.Errorf("updating annotation: %w", clientErrors.NewErrDatabase())
+	}
+
+	return nil
+}
+
+func (r *AnnotationsPoolRepository) DeleteAnnotation(ctx context.Context, songID, id int) error {
+	logrus.WithFields(logrus.Fields{
+		"song_id": songID,
+		"id":      id,
+	}).Debug("Executing delete annotation query")
+
+	tag, err := conn(ctx, r.Pool).Exec(ctx, `DELETE FROM annotations WHERE song_id = $1 AND id = $2`, songID, id)
+	if err != nil {
+		return fmt.Errorf("deleting annotation: %w", clientErrors.NewErrDatabase())
+	}
+
+	if tag.RowsAffected() == 0 {
+		return clientErrors.NewErrNotFound(fmt.Sprintf("annotation with id: %d", id))
+	}
+
+	return nil
+}
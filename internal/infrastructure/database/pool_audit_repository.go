@@ -0,0 +1,134 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/mashfeii/songs_library/internal/domain"
+	clientErrors "github.com/mashfeii/songs_library/internal/infrastructure/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type AuditRepository interface {
+	AddAuditEvent(ctx context.Context, event *domain.AuditEvent) error
+	GetAuditEvents(
+		ctx context.Context,
+		filter domain.AuditFilter,
+		pageReq domain.PageRequest,
+	) (*domain.Page[domain.AuditEvent], error)
+}
+
+const auditColumns = `id, actor, action, entity, entity_id, before, after, request_id, created_at`
+
+func auditDest(event *domain.AuditEvent) []any {
+	return []any{
+		&event.ID, &event.Actor, &event.Action, &event.Entity, &event.EntityID, &event.Before, &event.After,
+		&event.RequestID, &event.CreatedAt,
+	}
+}
+
+// auditWhere matches events by entity, entity ID, actor and a creation time range, zero
+// values matching any event.
+const auditWhere = `
+    WHERE ($1 = '' OR entity = $1) AND ($2 = 0 OR entity_id = $2) AND ($3 = '' OR actor = $3)
+      AND ($4::timestamptz IS NULL OR created_at >= $4) AND ($5::timestamptz IS NULL OR created_at < $5)`
+
+func auditArgs(filter domain.AuditFilter) []any {
+	return []any{filter.Entity, filter.EntityID, filter.Actor, filter.From, filter.To}
+}
+
+type AuditPoolRepository struct {
+	Pool *pgxpool.Pool
+}
+
+func NewAuditPoolRepository(pool *pgxpool.Pool) *AuditPoolRepository {
+	return &AuditPoolRepository{Pool: pool}
+}
+
+// AddAuditEvent stores the event, in the transaction of the audited change when ctx
+// carries one, and fills in its ID and creation time.
+func (r *AuditPoolRepository) AddAuditEvent(ctx context.Context, event *domain.AuditEvent) error {
+	logrus.WithFields(logrus.Fields{
+		"action":    event.Action,
+		"entity":    event.Entity,
+		"entity_id": event.EntityID,
+	}).Debug("Executing add audit event query")
+
+	err := conn(ctx, r.Pool).QueryRow(ctx, `
+    INSERT INTO audit_events (actor, action, entity, entity_id, before, after, request_id)
+    VALUES ($1, $2, $3, $4, $5, $6, $7)
+    RETURNING id, created_at
+    `, event.Actor, event.Action, event.Entity, event.EntityID, jsonOrNull(event.Before), jsonOrNull(event.After),
+		event.RequestID).Scan(&event.ID, &event.CreatedAt)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"error":  err,
+			"action": event.Action,
+			"entity": event.Entity,
+		}).Error("Failed to add audit event to database")
+
+		return fmt.Errorf("inserting audit event: %w", clientErrors.NewErrDatabase())
+	}
+
+	return nil
+}
+
+// GetAuditEvents lists events from the most recent one.
+func (r *AuditPoolRepository) GetAuditEvents(
+	ctx context.Context,
+	filter domain.AuditFilter,
+	pageReq domain.PageRequest,
+) (*domain.Page[domain.AuditEvent], error) {
+	logrus.WithFields(logrus.Fields{
+		"filter": filter,
+		"page":   pageReq.Page,
+		"size":   pageReq.Size,
+	}).Debug("Executing get audit events query")
+
+	args := auditArgs(filter)
+	page := &domain.Page[domain.AuditEvent]{Items: []domain.AuditEvent{}, Page: pageReq.Page, Size: pageReq.Size}
+
+	if err := conn(ctx, r.Pool).QueryRow(ctx, `SELECT count(*) FROM audit_events`+auditWhere, args...).Scan(&page.Total); err != nil {
+		return nil, fmt.Errorf("counting audit events: %w", clientErrors.NewErrDatabase())
+	}
+
+	rows, err := conn(ctx, r.Pool).Query(ctx, `
+    SELECT `+auditColumns+`
+    FROM audit_events`+auditWhere+`
+    ORDER BY created_at DESC, id DESC
+    LIMIT $6 OFFSET $7
+    `, append(args, pageReq.Size, pageReq.Offset())...)
+	if err != nil {
+		logrus.WithField("error", err).Error("Failed to get audit events from database")
+
+		return nil, fmt.Errorf("querying audit events: %w", clientErrors.NewErrDatabase())
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var event domain.AuditEvent
+
+		if err := rows.Scan(auditDest(&event)...); err != nil {
+			return nil, fmt.Errorf("repo scanning audit events: %w", clientErrors.NewErrDatabase())
+		}
+
+		page.Items = append(page.Items, event)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("repo reading audit events: %w", clientErrors.NewErrDatabase())
+	}
+
+	return page, nil
+}
+
+// jsonOrNull stores a missing snapshot as NULL rather than as a JSON null.
+func jsonOrNull(value json.RawMessage) any {
+	if len(value) == 0 {
+		return nil
+	}
+
+	return value
+}
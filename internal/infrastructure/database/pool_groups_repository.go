@@ -2,13 +2,33 @@ package database
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/mashfeii/songs_library/internal/domain"
+	clientErrors "github.com/mashfeii/songs_library/internal/infrastructure/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	pgUniqueViolation     = "23505"
+	pgForeignKeyViolation = "23503"
 )
 
 type GroupsRepository interface {
 	UpsertGroup(ctx context.Context, groupName string) (int, error)
+	GetGroups(ctx context.Context, pageReq domain.PageRequest) (*domain.Page[domain.Group], error)
+	GetGroupByID(ctx context.Context, id int) (*domain.Group, error)
+	AddGroup(ctx context.Context, name string) (int, error)
+	RenameGroup(ctx context.Context, id int, name string) error
+	DeleteGroup(ctx context.Context, id int, cascade bool) error
+	MergeGroups(ctx context.Context, targetID int, sourceIDs []int) (int, error)
+	GetGroupAliases(ctx context.Context, id int) ([]string, error)
+	AddGroupAlias(ctx context.Context, id int, alias string) error
+	DeleteGroupAlias(ctx context.Context, id int, alias string) error
 }
 
 type GroupsPoolRepository struct {
@@ -19,18 +39,481 @@ func NewGroupsPoolRepository(pool *pgxpool.Pool) *GroupsPoolRepository {
 	return &GroupsPoolRepository{Pool: pool}
 }
 
+// UpsertGroup resolves the name through group aliases, so differently spelled names of a
+// merged or existing group map to the canonical one, and creates the group otherwise. A group
+// waiting for its trashed songs to be purged is kept again.
 func (r *GroupsPoolRepository) UpsertGroup(ctx context.Context, groupName string) (int, error) {
+	tx, err := begin(ctx, r.Pool)
+	if err != nil {
+		return 0, fmt.Errorf("beginning upsert group: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
 	var groupID int
 
-	err := r.Pool.QueryRow(ctx, `
+	err = tx.QueryRow(ctx, `
+    SELECT group_id FROM group_aliases WHERE alias = normalize_group_name($1)
+  `, groupName).Scan(&groupID)
+	if err == nil {
+		if _, err := tx.Exec(ctx, `UPDATE groups SET deleted_at = NULL WHERE id = $1 AND deleted_at IS NOT NULL`, groupID); err != nil {
+			return 0, fmt.Errorf("reviving group: %w", err)
+		}
+
+		if err := tx.Commit(ctx); err != nil {
+			return 0, fmt.Errorf("committing upsert group: %w", err)
+		}
+
+		return groupID, nil
+	}
+
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return 0, fmt.Errorf("resolving group alias: %w", err)
+	}
+
+	err = tx.QueryRow(ctx, `
     INSERT INTO groups (name)
     VALUES ($1)
-    ON CONFLICT (name) DO UPDATE SET name = $1
+    ON CONFLICT (name) DO UPDATE SET name = $1, deleted_at = NULL
     RETURNING id
   `, groupName).Scan(&groupID)
 	if err != nil {
 		return 0, fmt.Errorf("upserting group: %w", err)
 	}
 
+	if err := insertGroupAlias(ctx, tx, groupID, groupName); err != nil {
+		return 0, fmt.Errorf("registering group alias: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("committing upsert group: %w", err)
+	}
+
 	return groupID, nil
 }
+
+func (r *GroupsPoolRepository) GetGroups(ctx context.Context, pageReq domain.PageRequest) (*domain.Page[domain.Group], error) {
+	logrus.WithFields(logrus.Fields{
+		"page": pageReq.Page,
+		"size": pageReq.Size,
+	}).Debug("Executing get groups query")
+
+	page := &domain.Page[domain.Group]{Items: []domain.Group{}, Page: pageReq.Page, Size: pageReq.Size}
+
+	if err := conn(ctx, r.Pool).QueryRow(ctx, `SELECT count(*) FROM groups WHERE deleted_at IS NULL`).Scan(&page.Total); err != nil {
+		logrus.WithField("error", err).Error("Failed to count groups in database")
+
+		return nil, fmt.Errorf("counting groups: %w", clientErrors.NewErrDatabase())
+	}
+
+	rows, err := conn(ctx, r.Pool).Query(ctx, `
+    SELECT id, name
+    FROM groups
+    WHERE deleted_at IS NULL
+    ORDER BY name, id
+    LIMIT $1 OFFSET $2
+    `, pageReq.Size, pageReq.Offset())
+	if err != nil {
+		logrus.WithField("error", err).Error("Failed to get groups from database")
+
+		return nil, fmt.Errorf("querying groups: %w", clientErrors.NewErrDatabase())
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var group domain.Group
+
+		if err := rows.Scan(&group.ID, &group.Name); err != nil {
+			return nil, fmt.Errorf("repo scanning groups: %w", clientErrors.NewErrDatabase())
+		}
+
+		page.Items = append(page.Items, group)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("repo reading groups: %w", clientErrors.NewErrDatabase())
+	}
+
+	return page, nil
+}
+
+func (r *GroupsPoolRepository) GetGroupByID(ctx context.Context, id int) (*domain.Group, error) {
+	logrus.WithFields(logrus.Fields{
+		"id": id,
+	}).Debug("Executing get group by id query")
+
+	var group domain.Group
+
+	err := conn(ctx, r.Pool).QueryRow(ctx, `SELECT id, name FROM groups WHERE id = $1 AND deleted_at IS NULL`, id).Scan(&group.ID, &group.Name)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, clientErrors.NewErrNotFound(fmt.Sprintf("group with id: %d", id))
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"error": err,
+			"id":    id,
+		}).Error("Failed to get group from database")
+
+		return nil, fmt.Errorf("querying group: %w", clientErrors.NewErrDatabase())
+	}
+
+	return &group, nil
+}
+
+func (r *GroupsPoolRepository) AddGroup(ctx context.Context, name string) (int, error) {
+	logrus.WithFields(logrus.Fields{
+		"name": name,
+	}).Debug("Executing add group query")
+
+	tx, err := begin(ctx, r.Pool)
+	if err != nil {
+		return 0, fmt.Errorf("beginning add group: %w", clientErrors.NewErrDatabase())
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	var id int
+
+	err = tx.QueryRow(ctx, `INSERT INTO groups (name) VALUES ($1) RETURNING id`, name).Scan(&id)
+	if err == nil {
+		_, err = tx.Exec(ctx, `
+      INSERT INTO group_aliases (alias, group_id) VALUES (normalize_group_name($1), $2)
+    `, name, id)
+	}
+
+	if err != nil {
+		if isPgError(err, pgUniqueViolation) {
+			return 0, clientErrors.NewErrConflict(fmt.Sprintf("group %q already exists", name))
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"error": err,
+			"name":  name,
+		}).Error("Failed to add group to database")
+
+		return 0, fmt.Errorf("repo adding group: %w", clientErrors.NewErrDatabase())
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("committing add group: %w", clientErrors.NewErrDatabase())
+	}
+
+	return id, nil
+}
+
+// RenameGroup changes the canonical name, the previous name stays as an alias. Songs of the
+// group and songs crediting it get a new version.
+func (r *GroupsPoolRepository) RenameGroup(ctx context.Context, id int, name string) error {
+	logrus.WithFields(logrus.Fields{
+		"id":   id,
+		"name": name,
+	}).Debug("Executing rename group query")
+
+	tx, err := begin(ctx, r.Pool)
+	if err != nil {
+		return fmt.Errorf("beginning rename group: %w", clientErrors.NewErrDatabase())
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	tag, err := tx.Exec(ctx, `UPDATE groups SET name = $1 WHERE id = $2 AND deleted_at IS NULL`, name, id)
+	if err == nil && tag.RowsAffected() == 0 {
+		return clientErrors.NewErrNotFound(fmt.Sprintf("group with id: %d", id))
+	}
+
+	if err == nil {
+		tag, err = tx.Exec(ctx, `
+      INSERT INTO group_aliases (alias, group_id)
+      VALUES (normalize_group_name($1), $2)
+      ON CONFLICT (alias) DO UPDATE SET group_id = EXCLUDED.group_id
+      WHERE group_aliases.group_id = EXCLUDED.group_id
+    `, name, id)
+		if err == nil && tag.RowsAffected() == 0 {
+			return clientErrors.NewErrConflict(fmt.Sprintf("alias %q belongs to another group", name))
+		}
+	}
+
+	if err == nil {
+		_, err = tx.Exec(ctx, `
+      UPDATE songs SET version = version + 1
+      WHERE group_id = $1 OR id IN (SELECT song_id FROM song_artists WHERE group_id = $1)
+    `, id)
+	}
+
+	if err != nil {
+		if isPgError(err, pgUniqueViolation) {
+			return clientErrors.NewErrConflict(fmt.Sprintf("group %q already exists", name))
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"error": err,
+			"id":    id,
+		}).Error("Failed to rename group in database")
+
+		return fmt.Errorf("renaming group: %w", clientErrors.NewErrDatabase())
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("committing rename group: %w", clientErrors.NewErrDatabase())
+	}
+
+	return nil
+}
+
+// DeleteGroup removes a group, groups that still have songs or albums are only removed
+// together with them when cascade is set, otherwise ErrConflict is returned. Cascade moves
+// the songs to the trash, so while trashed songs remain the group is only marked as deleted
+// and removed with its albums once the last of them is purged, restoring one keeps it.
+func (r *GroupsPoolRepository) DeleteGroup(ctx context.Context, id int, cascade bool) error {
+	logrus.WithFields(logrus.Fields{
+		"id":      id,
+		"cascade": cascade,
+	}).Debug("Executing delete group query")
+
+	tx, err := begin(ctx, r.Pool)
+	if err != nil {
+		return fmt.Errorf("beginning delete group: %w", clientErrors.NewErrDatabase())
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	var songs, trashed, albums int
+
+	err = tx.QueryRow(ctx, `
+    SELECT
+      (SELECT count(*) FROM songs WHERE group_id = $1 AND deleted_at IS NULL),
+      (SELECT count(*) FROM songs WHERE group_id = $1 AND deleted_at IS NOT NULL),
+      (SELECT count(*) FROM albums WHERE group_id = $1)
+  `, id).Scan(&songs, &trashed, &albums)
+	if err != nil {
+		return fmt.Errorf("counting group songs: %w", clientErrors.NewErrDatabase())
+	}
+
+	if !cascade {
+		if songs > 0 || albums > 0 {
+			return clientErrors.NewErrConflict(fmt.Sprintf("group with id %d has %d songs and %d albums", id, songs, albums))
+		}
+
+		if trashed > 0 {
+			return clientErrors.NewErrConflict(fmt.Sprintf(
+				"group with id %d has %d songs in the trash, restore them or delete with cascade", id, trashed,
+			))
+		}
+	}
+
+	if songs > 0 {
+		_, err := tx.Exec(ctx, `
+      UPDATE songs SET deleted_at = now(), version = version + 1
+      WHERE group_id = $1 AND deleted_at IS NULL
+    `, id)
+		if err != nil {
+			return fmt.Errorf("trashing group songs: %w", clientErrors.NewErrDatabase())
+		}
+	}
+
+	if songs > 0 || trashed > 0 {
+		tag, err := tx.Exec(ctx, `UPDATE groups SET deleted_at = now() WHERE id = $1 AND deleted_at IS NULL`, id)
+		if err != nil {
+			return fmt.Errorf("marking group deleted: %w", clientErrors.NewErrDatabase())
+		}
+
+		if tag.RowsAffected() == 0 {
+			return clientErrors.NewErrNotFound(fmt.Sprintf("group with id: %d", id))
+		}
+
+		if err := tx.Commit(ctx); err != nil {
+			return fmt.Errorf("committing delete group: %w", clientErrors.NewErrDatabase())
+		}
+
+		return nil
+	}
+
+	if albums > 0 {
+		if _, err := tx.Exec(ctx, `DELETE FROM albums WHERE group_id = $1`, id); err != nil {
+			return fmt.Errorf("deleting group albums: %w", clientErrors.NewErrDatabase())
+		}
+	}
+
+	tag, err := tx.Exec(ctx, `DELETE FROM groups WHERE id = $1 AND deleted_at IS NULL`, id)
+	if err != nil {
+		if isPgError(err, pgForeignKeyViolation) {
+			return clientErrors.NewErrConflict(fmt.Sprintf("group with id %d has songs", id))
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"error": err,
+			"id":    id,
+		}).Error("Failed to delete group from database")
+
+		return fmt.Errorf("deleting group: %w", clientErrors.NewErrDatabase())
+	}
+
+	if tag.RowsAffected() == 0 {
+		return clientErrors.NewErrNotFound(fmt.Sprintf("group with id: %d", id))
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("committing delete group: %w", clientErrors.NewErrDatabase())
+	}
+
+	return nil
+}
+
+// MergeGroups moves songs, credits, albums and aliases of the source groups to the target in one transaction,
+// keeps the source names as aliases of the target and removes the source groups.
+func (r *GroupsPoolRepository) MergeGroups(ctx context.Context, targetID int, sourceIDs []int) (int, error) {
+	logrus.WithFields(logrus.Fields{
+		"target":  targetID,
+		"sources": sourceIDs,
+	}).Debug("Executing merge groups query")
+
+	tx, err := begin(ctx, r.Pool)
+	if err != nil {
+		return 0, fmt.Errorf("beginning merge groups: %w", clientErrors.NewErrDatabase())
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	var found int
+
+	err = tx.QueryRow(ctx, `
+    SELECT count(*) FROM (SELECT id FROM groups WHERE id = $1 OR id = ANY($2) FOR UPDATE) AS locked
+  `, targetID, sourceIDs).Scan(&found)
+	if err != nil {
+		return 0, fmt.Errorf("locking merged groups: %w", clientErrors.NewErrDatabase())
+	}
+
+	if found != len(sourceIDs)+1 {
+		return 0, clientErrors.NewErrNotFound(fmt.Sprintf("groups with ids: %d, %v", targetID, sourceIDs))
+	}
+
+	_, err = tx.Exec(ctx, `
+    UPDATE songs SET version = version + 1
+    WHERE group_id <> ALL($1) AND id IN (SELECT song_id FROM song_artists WHERE group_id = ANY($1))
+  `, sourceIDs)
+	if err != nil {
+		return 0, fmt.Errorf("versioning credited songs: %w", clientErrors.NewErrDatabase())
+	}
+
+	tag, err := tx.Exec(ctx, `UPDATE songs SET group_id = $1, version = version + 1 WHERE group_id = ANY($2)`, targetID, sourceIDs)
+	if err != nil {
+		return 0, fmt.Errorf("moving merged songs: %w", clientErrors.NewErrDatabase())
+	}
+
+	_, err = tx.Exec(ctx, `
+    INSERT INTO song_artists (song_id, group_id, role, position)
+    SELECT song_id, $1, role, position FROM song_artists WHERE group_id = ANY($2)
+    ON CONFLICT DO NOTHING
+  `, targetID, sourceIDs)
+	if err != nil {
+		return 0, fmt.Errorf("moving merged credits: %w", clientErrors.NewErrDatabase())
+	}
+
+	_, err = tx.Exec(ctx, `UPDATE albums SET group_id = $1 WHERE group_id = ANY($2)`, targetID, sourceIDs)
+	if err != nil {
+		if isPgError(err, pgUniqueViolation) {
+			return 0, clientErrors.NewErrConflict("merged groups have albums with the same title")
+		}
+
+		return 0, fmt.Errorf("moving merged albums: %w", clientErrors.NewErrDatabase())
+	}
+
+	_, err = tx.Exec(ctx, `UPDATE group_aliases SET group_id = $1 WHERE group_id = ANY($2)`, targetID, sourceIDs)
+	if err != nil {
+		return 0, fmt.Errorf("moving merged aliases: %w", clientErrors.NewErrDatabase())
+	}
+
+	_, err = tx.Exec(ctx, `
+    INSERT INTO group_aliases (alias, group_id)
+    SELECT normalize_group_name(name), $1 FROM groups WHERE id = ANY($2)
+    ON CONFLICT (alias) DO UPDATE SET group_id = EXCLUDED.group_id
+  `, targetID, sourceIDs)
+	if err != nil {
+		return 0, fmt.Errorf("aliasing merged groups: %w", clientErrors.NewErrDatabase())
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM groups WHERE id = ANY($1)`, sourceIDs); err != nil {
+		return 0, fmt.Errorf("deleting merged groups: %w", clientErrors.NewErrDatabase())
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"error":  err,
+			"target": targetID,
+		}).Error("Failed to merge groups in database")
+
+		return 0, fmt.Errorf("committing merge groups: %w", clientErrors.NewErrDatabase())
+	}
+
+	return int(tag.RowsAffected()), nil
+}
+
+func (r *GroupsPoolRepository) GetGroupAliases(ctx context.Context, id int) ([]string, error) {
+	if _, err := r.GetGroupByID(ctx, id); err != nil {
+		return nil, err
+	}
+
+	rows, err := conn(ctx, r.Pool).Query(ctx, `SELECT alias FROM group_aliases WHERE group_id = $1 ORDER BY alias`, id)
+	if err != nil {
+		return nil, fmt.Errorf("querying group aliases: %w", clientErrors.NewErrDatabase())
+	}
+
+	aliases, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, fmt.Errorf("repo scanning group aliases: %w", clientErrors.NewErrDatabase())
+	}
+
+	return aliases, nil
+}
+
+func (r *GroupsPoolRepository) AddGroupAlias(ctx context.Context, id int, alias string) error {
+	if _, err := r.GetGroupByID(ctx, id); err != nil {
+		return err
+	}
+
+	tag, err := conn(ctx, r.Pool).Exec(ctx, `
+    INSERT INTO group_aliases (alias, group_id)
+    VALUES (normalize_group_name($1), $2)
+    ON CONFLICT (alias) DO UPDATE SET group_id = EXCLUDED.group_id
+    WHERE group_aliases.group_id = EXCLUDED.group_id
+  `, alias, id)
+	if err != nil {
+		return fmt.Errorf("adding group alias: %w", clientErrors.NewErrDatabase())
+	}
+
+	if tag.RowsAffected() == 0 {
+		return clientErrors.NewErrConflict(fmt.Sprintf("alias %q belongs to another group", alias))
+	}
+
+	return nil
+}
+
+func (r *GroupsPoolRepository) DeleteGroupAlias(ctx context.Context, id int, alias string) error {
+	tag, err := conn(ctx, r.Pool).Exec(ctx, `
+    DELETE FROM group_aliases
+    WHERE group_id = $1 AND alias = normalize_group_name($2)
+      AND alias <> (SELECT normalize_group_name(name) FROM groups WHERE id = $1)
+  `, id, alias)
+	if err != nil {
+		return fmt.Errorf("deleting group alias: %w", clientErrors.NewErrDatabase())
+	}
+
+	if tag.RowsAffected() == 0 {
+		return clientErrors.NewErrNotFound(fmt.Sprintf("alias %q of group with id: %d", alias, id))
+	}
+
+	return nil
+}
+
+// insertGroupAlias registers the normalized name as an alias of the group unless it is taken.
+func insertGroupAlias(ctx context.Context, q querier, groupID int, name string) error {
+	_, err := q.Exec(ctx, `
+    INSERT INTO group_aliases (alias, group_id)
+    VALUES (normalize_group_name($1), $2)
+    ON CONFLICT (alias) DO NOTHING
+  `, name, groupID)
+
+	return err
+}
+
+func isPgError(err error, code string) bool {
+	var pgErr *pgconn.PgError
+
+	return errors.As(err, &pgErr) && pgErr.Code == code
+}
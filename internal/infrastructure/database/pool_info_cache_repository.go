@@ -0,0 +1,184 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/mashfeii/songs_library/internal/domain"
+	clientErrors "github.com/mashfeii/songs_library/internal/infrastructure/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type InfoCacheRepository interface {
+	GetInfoCacheEntry(ctx context.Context, group, song string) (*domain.InfoCacheEntry, error)
+	SaveInfoCacheEntry(ctx context.Context, entry *domain.InfoCacheEntry) error
+	GetInfoCacheEntries(
+		ctx context.Context,
+		filter domain.InfoCacheFilter,
+		policy domain.InfoCachePolicy,
+		pageReq domain.PageRequest,
+	) (*domain.Page[domain.InfoCacheEntry], error)
+	DeleteInfoCacheEntries(ctx context.Context, filter domain.InfoCacheFilter, policy domain.InfoCachePolicy) (int, error)
+}
+
+const infoCacheColumns = `group_name, song_name, status, payload, fetched_at`
+
+func infoCacheDest(entry *domain.InfoCacheEntry) []any {
+	return []any{&entry.Group, &entry.Song, &entry.Status, &entry.Payload, &entry.FetchedAt}
+}
+
+// infoCacheWhere matches entries by normalized keys, an empty key matching any. Expired entries
+// are those older than the lifetime of their status under the policy.
+const infoCacheWhere = `
+    WHERE ($1 = '' OR group_key = $1) AND ($2 = '' OR song_key = $2)
+      AND (NOT $3 OR fetched_at <= now() - make_interval(secs => CASE status
+        WHEN 200 THEN $4::float8
+        WHEN 404 THEN $5::float8
+        ELSE 0 END))`
+
+func infoCacheArgs(filter domain.InfoCacheFilter, policy domain.InfoCachePolicy) []any {
+	return []any{
+		domain.NormalizeLookupKey(filter.Group),
+		domain.NormalizeLookupKey(filter.Song),
+		filter.Expired,
+		policy.TTL.Seconds(),
+		policy.NegativeTTL.Seconds(),
+	}
+}
+
+type InfoCachePoolRepository struct {
+	Pool *pgxpool.Pool
+}
+
+func NewInfoCachePoolRepository(pool *pgxpool.Pool) *InfoCachePoolRepository {
+	return &InfoCachePoolRepository{Pool: pool}
+}
+
+func (r *InfoCachePoolRepository) GetInfoCacheEntry(ctx context.Context, group, song string) (*domain.InfoCacheEntry, error) {
+	logrus.WithFields(logrus.Fields{
+		"group": group,
+		"song":  song,
+	}).Debug("Executing get info cache entry query")
+
+	var entry domain.InfoCacheEntry
+
+	err := conn(ctx, r.Pool).QueryRow(ctx, `
+    SELECT `+infoCacheColumns+`
+    FROM info_cache
+    WHERE group_key = $1 AND song_key = $2
+    `, domain.NormalizeLookupKey(group), domain.NormalizeLookupKey(song)).Scan(infoCacheDest(&entry)...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, clientErrors.NewErrNotFound(fmt.Sprintf("cached info of %q by %q", song, group))
+		}
+
+		return nil, fmt.Errorf("querying info cache entry: %w", clientErrors.NewErrDatabase())
+	}
+
+	return &entry, nil
+}
+
+// SaveInfoCacheEntry stores the entry under its normalized keys, replacing an older answer.
+func (r *InfoCachePoolRepository) SaveInfoCacheEntry(ctx context.Context, entry *domain.InfoCacheEntry) error {
+	logrus.WithFields(logrus.Fields{
+		"group":  entry.Group,
+		"song":   entry.Song,
+		"status": entry.Status,
+	}).Debug("Executing save info cache entry query")
+
+	_, err := conn(ctx, r.Pool).Exec(ctx, `
+    INSERT INTO info_cache (group_key, song_key, group_name, song_name, status, payload, fetched_at)
+    VALUES ($1, $2, $3, $4, $5, $6, $7)
+    ON CONFLICT (group_key, song_key) DO UPDATE SET
+      group_name = EXCLUDED.group_name,
+      song_name = EXCLUDED.song_name,
+      status = EXCLUDED.status,
+      payload = EXCLUDED.payload,
+      fetched_at = EXCLUDED.fetched_at
+    `, domain.NormalizeLookupKey(entry.Group), domain.NormalizeLookupKey(entry.Song), entry.Group, entry.Song,
+		entry.Status, entry.Payload, entry.FetchedAt)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"error": err,
+			"group": entry.Group,
+			"song":  entry.Song,
+		}).Error("Failed to save info cache entry to database")
+
+		return fmt.Errorf("upserting info cache entry: %w", clientErrors.NewErrDatabase())
+	}
+
+	return nil
+}
+
+// GetInfoCacheEntries lists cache entries from the most recently fetched one.
+func (r *InfoCachePoolRepository) GetInfoCacheEntries(
+	ctx context.Context,
+	filter domain.InfoCacheFilter,
+	policy domain.InfoCachePolicy,
+	pageReq domain.PageRequest,
+) (*domain.Page[domain.InfoCacheEntry], error) {
+	logrus.WithFields(logrus.Fields{
+		"filter": filter,
+		"page":   pageReq.Page,
+		"size":   pageReq.Size,
+	}).Debug("Executing get info cache entries query")
+
+	args := infoCacheArgs(filter, policy)
+	page := &domain.Page[domain.InfoCacheEntry]{Items: []domain.InfoCacheEntry{}, Page: pageReq.Page, Size: pageReq.Size}
+
+	if err := conn(ctx, r.Pool).QueryRow(ctx, `SELECT count(*) FROM info_cache`+infoCacheWhere, args...).Scan(&page.Total); err != nil {
+		return nil, fmt.Errorf("counting info cache entries: %w", clientErrors.NewErrDatabase())
+	}
+
+	rows, err := conn(ctx, r.Pool).Query(ctx, `
+    SELECT `+infoCacheColumns+`
+    FROM info_cache`+infoCacheWhere+`
+    ORDER BY fetched_at DESC, group_key, song_key
+    LIMIT $6 OFFSET $7
+    `, append(args, pageReq.Size, pageReq.Offset())...)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"error":  err,
+			"filter": filter,
+		}).Error("Failed to get info cache entries from database")
+
+		return nil, fmt.Errorf("querying info cache entries: %w", clientErrors.NewErrDatabase())
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var entry domain.InfoCacheEntry
+
+		if err := rows.Scan(infoCacheDest(&entry)...); err != nil {
+			return nil, fmt.Errorf("repo scanning info cache entries: %w", clientErrors.NewErrDatabase())
+		}
+
+		page.Items = append(page.Items, entry)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("repo reading info cache entries: %w", clientErrors.NewErrDatabase())
+	}
+
+	return page, nil
+}
+
+func (r *InfoCachePoolRepository) DeleteInfoCacheEntries(
+	ctx context.Context,
+	filter domain.InfoCacheFilter,
+	policy domain.InfoCachePolicy,
+) (int, error) {
+	logrus.WithFields(logrus.Fields{
+		"filter": filter,
+	}).Debug("Executing delete info cache entries query")
+
+	tag, err := conn(ctx, r.Pool).Exec(ctx, `DELETE FROM info_cache`+infoCacheWhere, infoCacheArgs(filter, policy)...)
+	if err != nil {
+		return 0, fmt.Errorf("deleting info cache entries: %w", clientErrors.NewErrDatabase())
+	}
+
+	return int(tag.RowsAffected()), nil
+}
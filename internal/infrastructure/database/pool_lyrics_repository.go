@@ -0,0 +1,177 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/mashfeii/songs_library/internal/domain"
+	clientErrors "github.com/mashfeii/songs_library/internal/infrastructure/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type LyricsRepository interface {
+	GetSyncedLyrics(ctx context.Context, songID int) (*domain.SyncedLyrics, error)
+	SaveSyncedLyrics(ctx context.Context, lyrics *domain.SyncedLyrics) error
+	DeleteSyncedLyrics(ctx context.Context, songID int) error
+}
+
+type LyricsPoolRepository struct {
+	Pool *pgxpool.Pool
+}
+
+func NewLyricsPoolRepository(pool *pgxpool.Pool) *LyricsPoolRepository {
+	return &LyricsPoolRepository{Pool: pool}
+}
+
+func (r *LyricsPoolRepository) GetSyncedLyrics(ctx context.Context, songID int) (*domain.SyncedLyrics, error) {
+	logrus.WithFields(logrus.Fields{
+		"song_id": songID,
+	}).Debug("Executing get synced lyrics query")
+
+	lyrics := &domain.SyncedLyrics{SongID: songID, Lines: []domain.SyncedLine{}}
+
+	err := conn(ctx, r.Pool).QueryRow(ctx, `
+    SELECT l.tags
+    FROM synced_lyrics AS l
+    JOIN songs AS s ON s.id = l.song_id
+    WHERE l.song_id = $1 AND s.deleted_at IS NULL
+    `, songID).Scan(&lyrics.Tags)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, clientErrors.NewErrNotFound(fmt.Sprintf("synced lyrics of song with id: %d", songID))
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"error":   err,
+			"song_id": songID,
+		}).Error("Failed to get synced lyrics from database")
+
+		return nil, fmt.Errorf("querying synced lyrics: %w", clientErrors.NewErrDatabase())
+	}
+
+	rows, err := conn(ctx, r.Pool).Query(ctx, `
+    SELECT time_ms, text
+    FROM synced_lyrics_lines
+    WHERE song_id = $1
+    ORDER BY time_ms, position
+    `, songID)
+	if err != nil {
+		return nil, fmt.Errorf("querying synced lyrics lines: %w", clientErrors.NewErrDatabase())
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var line domain.SyncedLine
+
+		if err := rows.Scan(&line.TimeMs, &line.Text); err != nil {
+			return nil, fmt.Errorf("repo scanning synced lyrics lines: %w", clientErrors.NewErrDatabase())
+		}
+
+		lyrics.Lines = append(lyrics.Lines, line)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("repo reading synced lyrics lines: %w", clientErrors.NewErrDatabase())
+	}
+
+	return lyrics, nil
+}
+
+// SaveSyncedLyrics replaces the synced lyrics of a song.
+func (r *LyricsPoolRepository) SaveSyncedLyrics(ctx context.Context, lyrics *domain.SyncedLyrics) error {
+	logrus.WithFields(logrus.Fields{
+		"song_id": lyrics.SongID,
+		"lines":   len(lyrics.Lines),
+	}).Debug("Executing save synced lyrics query")
+
+	tx, err := begin(ctx, r.Pool)
+	if err != nil {
+		return fmt.Errorf("beginning save synced lyrics: %w", clientErrors.NewErrDatabase())
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	var locked int
+
+	err = tx.QueryRow(ctx, `SELECT id FROM songs WHERE id = $1 AND deleted_at IS NULL FOR UPDATE`, lyrics.SongID).Scan(&locked)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return clientErrors.NewErrNotFound(fmt.Sprintf("song with id: %d", lyrics.SongID))
+	}
+
+	if err != nil {
+		return fmt.Errorf("locking song: %w", clientErrors.NewErrDatabase())
+	}
+
+	tags := lyrics.Tags
+	if tags == nil {
+		tags = map[string]string{}
+	}
+
+	_, err = tx.Exec(ctx, `
+    INSERT INTO synced_lyrics (song_id, tags)
+    VALUES ($1, $2)
+    ON CONFLICT (song_id) DO UPDATE SET tags = EXCLUDED.tags, updated_at = now()
+    `, lyrics.SongID, tags)
+	if err != nil {
+		if isPgError(err, pgForeignKeyViolation) {
+			return clientErrors.NewErrNotFound(fmt.Sprintf("song with id: %d", lyrics.SongID))
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"error":   err,
+			"song_id": lyrics.SongID,
+		}).Error("Failed to save synced lyrics to database")
+
+		return fmt.Errorf("upserting synced lyrics: %w", clientErrors.NewErrDatabase())
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM synced_lyrics_lines WHERE song_id = $1`, lyrics.SongID); err != nil {
+		return fmt.Errorf("clearing synced lyrics lines: %w", clientErrors.NewErrDatabase())
+	}
+
+	times := make([]int, 0, len(lyrics.Lines))
+	texts := make([]string, 0, len(lyrics.Lines))
+
+	for _, line := range lyrics.Lines {
+		times = append(times, line.TimeMs)
+		texts = append(texts, line.Text)
+	}
+
+	_, err = tx.Exec(ctx, `
+    INSERT INTO synced_lyrics_lines (song_id, position, time_ms, text)
+    SELECT $1, l.position, l.time_ms, l.text
+    FROM unnest($2::int[], $3::text[]) WITH ORDINALITY AS l(time_ms, text, position)
+    `, lyrics.SongID, times, texts)
+	if err != nil {
+		return fmt.Errorf("inserting synced lyrics lines: %w", clientErrors.NewErrDatabase())
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("committing save synced lyrics: %w", clientErrors.NewErrDatabase())
+	}
+
+	return nil
+}
+
+func (r *LyricsPoolRepository) DeleteSyncedLyrics(ctx context.Context, songID int) error {
+	logrus.WithFields(logrus.Fields{
+		"song_id": songID,
+	}).Debug("Executing delete synced lyrics query")
+
+	tag, err := conn(ctx, r.Pool).Exec(ctx, `
+    DELETE FROM synced_lyrics AS l
+    USING songs AS s
+    WHERE s.id = l.song_id AND l.song_id = $1 AND s.deleted_at IS NULL
+    `, songID)
+	if err != nil {
+		return fmt.Errorf("deleting synced lyrics: %w", clientErrors.NewErrDatabase())
+	}
+
+	if tag.RowsAffected() == 0 {
+		return clientErrors.NewErrNotFound(fmt.Sprintf("synced lyrics of song with id: %d", songID))
+	}
+
+	return nil
+}
@@ -0,0 +1,286 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/mashfeii/songs_library/internal/domain"
+	clientErrors "github.com/mashfeii/songs_library/internal/infrastructure/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type MetadataRefreshRepository interface {
+	GetStaleSongs(ctx context.Context, enrichedBefore time.Time, limit int) ([]domain.Song, error)
+	TouchSongMetadata(ctx context.Context, songID int) error
+	StartRefreshRun(ctx context.Context) (*domain.RefreshRun, error)
+	FinishRefreshRun(ctx context.Context, run *domain.RefreshRun) error
+	FailRunningRefreshRuns(ctx context.Context, reason string) (int, error)
+	AddMetadataProposals(ctx context.Context, proposals []domain.MetadataProposal) error
+	GetRefreshRuns(ctx context.Context, pageReq domain.PageRequest) (*domain.Page[domain.RefreshRun], error)
+	GetRefreshRun(ctx context.Context, id int) (*domain.RefreshRun, error)
+}
+
+const refreshRunColumns = `id, status, checked, changed, proposed, unchanged, failed, COALESCE(error, ''),
+    started_at, finished_at`
+
+func refreshRunDest(run *domain.RefreshRun) []any {
+	return []any{
+		&run.ID, &run.Status, &run.Checked, &run.Changed, &run.Proposed, &run.Unchanged, &run.Failed,
+		&run.Error, &run.StartedAt, &run.FinishedAt,
+	}
+}
+
+type MetadataRefreshPoolRepository struct {
+	Pool *pgxpool.Pool
+}
+
+func NewMetadataRefreshPoolRepository(pool *pgxpool.Pool) *MetadataRefreshPoolRepository {
+	return &MetadataRefreshPoolRepository{Pool: pool}
+}
+
+// GetStaleSongs lists enriched songs last refreshed before the given time, never refreshed
+// songs first.
+func (r *MetadataRefreshPoolRepository) GetStaleSongs(
+	ctx context.Context,
+	enrichedBefore time.Time,
+	limit int,
+) ([]domain.Song, error) {
+	logrus.WithFields(logrus.Fields{
+		"enriched_before": enrichedBefore,
+		"limit":           limit,
+	}).Debug("Executing get stale songs query")
+
+	rows, err := conn(ctx, r.Pool).Query(ctx, `
+    SELECT `+songColumns+`
+    FROM `+songTables+`
+    WHERE s.status = 'enriched' AND s.deleted_at IS NULL AND (s.enriched_at IS NULL OR s.enriched_at < $1)
+    ORDER BY s.enriched_at NULLS FIRST, s.id
+    LIMIT $2
+    `, enrichedBefore, limit)
+	if err != nil {
+		logrus.WithField("error", err).Error("Failed to get stale songs from database")
+
+		return nil, fmt.Errorf("querying stale songs: %w", clientErrors.NewErrDatabase())
+	}
+	defer rows.Close()
+
+	songs := []domain.Song{}
+
+	for rows.Next() {
+		var song domain.Song
+
+		if err := rows.Scan(songDest(&song)...); err != nil {
+			return nil, fmt.Errorf("repo scanning stale songs: %w", clientErrors.NewErrDatabase())
+		}
+
+		songs = append(songs, song)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("repo reading stale songs: %w", clientErrors.NewErrDatabase())
+	}
+
+	return songs, nil
+}
+
+// TouchSongMetadata marks the song metadata as refreshed now without changing it. The song
+// keeps its version, so clients holding its ETag are not turned away by a run that changed nothing.
+func (r *MetadataRefreshPoolRepository) TouchSongMetadata(ctx context.Context, songID int) error {
+	_, err := conn(ctx, r.Pool).Exec(ctx, `UPDATE songs SET enriched_at = now() WHERE id = $1`, songID)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"error":   err,
+			"song_id": songID,
+		}).Error("Failed to touch song metadata")
+
+		return fmt.Errorf("touching song metadata: %w", clientErrors.NewErrDatabase())
+	}
+
+	return nil
+}
+
+func (r *MetadataRefreshPoolRepository) StartRefreshRun(ctx context.Context) (*domain.RefreshRun, error) {
+	var run domain.RefreshRun
+
+	err := conn(ctx, r.Pool).QueryRow(ctx, `
+    INSERT INTO metadata_refresh_runs DEFAULT VALUES
+    RETURNING `+refreshRunColumns).Scan(refreshRunDest(&run)...)
+	if err != nil {
+		logrus.WithField("error", err).Error("Failed to start refresh run")
+
+		return nil, fmt.Errorf("starting refresh run: %w", clientErrors.NewErrDatabase())
+	}
+
+	return &run, nil
+}
+
+// FinishRefreshRun stores the counts, status and error of the run and sets its finish time.
+func (r *MetadataRefreshPoolRepository) FinishRefreshRun(ctx context.Context, run *domain.RefreshRun) error {
+	err := conn(ctx, r.Pool).QueryRow(ctx, `
+    UPDATE metadata_refresh_runs
+    SET status = $2, checked = $3, changed = $4, proposed = $5, unchanged = $6, failed = $7,
+      error = NULLIF($8, ''), finished_at = now()
+    WHERE id = $1
+    RETURNING finished_at
+    `, run.ID, run.Status, run.Checked, run.Changed, run.Proposed, run.Unchanged, run.Failed, run.Error).
+		Scan(&run.FinishedAt)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"error": err,
+			"run":   run,
+		}).Error("Failed to finish refresh run")
+
+		return fmt.Errorf("finishing refresh run: %w", clientErrors.NewErrDatabase())
+	}
+
+	return nil
+}
+
+// FailRunningRefreshRuns marks every run still running as failed with the given reason,
+// returning how many were marked.
+func (r *MetadataRefreshPoolRepository) FailRunningRefreshRuns(ctx context.Context, reason string) (int, error) {
+	tag, err := conn(ctx, r.Pool).Exec(ctx, `
+    UPDATE metadata_refresh_runs
+    SET status = $1, error = $2, finished_at = now()
+    WHERE status = $3
+    `, domain.RefreshRunFailed, reason, domain.RefreshRunRunning)
+	if err != nil {
+		logrus.WithField("error", err).Error("Failed to fail running refresh runs")
+
+		return 0, fmt.Errorf("failing running refresh runs: %w", clientErrors.NewErrDatabase())
+	}
+
+	return int(tag.RowsAffected()), nil
+}
+
+func (r *MetadataRefreshPoolRepository) AddMetadataProposals(
+	ctx context.Context,
+	proposals []domain.MetadataProposal,
+) error {
+	runIDs := make([]int, 0, len(proposals))
+	songIDs := make([]int, 0, len(proposals))
+	fields := make([]string, 0, len(proposals))
+	current := make([]string, 0, len(proposals))
+	proposed := make([]string, 0, len(proposals))
+	providers := make([]string, 0, len(proposals))
+
+	for _, proposal := range proposals {
+		runIDs = append(runIDs, proposal.RunID)
+		songIDs = append(songIDs, proposal.SongID)
+		fields = append(fields, proposal.Field)
+		current = append(current, proposal.Current)
+		proposed = append(proposed, proposal.Proposed)
+		providers = append(providers, proposal.Provider)
+	}
+
+	_, err := conn(ctx, r.Pool).Exec(ctx, `
+    INSERT INTO metadata_proposals (run_id, song_id, field, current_value, proposed_value, provider)
+    SELECT * FROM unnest($1::int[], $2::int[], $3::text[], $4::text[], $5::text[], $6::text[])
+    `, runIDs, songIDs, fields, current, proposed, providers)
+	if err != nil {
+		logrus.WithField("error", err).Error("Failed to add metadata proposals")
+
+		return fmt.Errorf("adding metadata proposals: %w", clientErrors.NewErrDatabase())
+	}
+
+	return nil
+}
+
+// GetRefreshRuns lists refresh runs from the newest one, without their proposals.
+func (r *MetadataRefreshPoolRepository) GetRefreshRuns(
+	ctx context.Context,
+	pageReq domain.PageRequest,
+) (*domain.Page[domain.RefreshRun], error) {
+	page := &domain.Page[domain.RefreshRun]{Items: []domain.RefreshRun{}, Page: pageReq.Page, Size: pageReq.Size}
+
+	if err := conn(ctx, r.Pool).QueryRow(ctx, `SELECT count(*) FROM metadata_refresh_runs`).Scan(&page.Total); err != nil {
+		logrus.WithField("error", err).Error("Failed to count refresh runs in database")
+
+		return nil, fmt.Errorf("counting refresh runs: %w", clientErrors.NewErrDatabase())
+	}
+
+	rows, err := conn(ctx, r.Pool).Query(ctx, `
+    SELECT `+refreshRunColumns+`
+    FROM metadata_refresh_runs
+    ORDER BY id DESC
+    LIMIT $1 OFFSET $2
+    `, pageReq.Size, (pageReq.Page-1)*pageReq.Size)
+	if err != nil {
+		logrus.WithField("error", err).Error("Failed to get refresh runs from database")
+
+		return nil, fmt.Errorf("querying refresh runs: %w", clientErrors.NewErrDatabase())
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var run domain.RefreshRun
+
+		if err := rows.Scan(refreshRunDest(&run)...); err != nil {
+			return nil, fmt.Errorf("repo scanning refresh runs: %w", clientErrors.NewErrDatabase())
+		}
+
+		page.Items = append(page.Items, run)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("repo reading refresh runs: %w", clientErrors.NewErrDatabase())
+	}
+
+	return page, nil
+}
+
+// GetRefreshRun returns the run together with the proposals it made.
+func (r *MetadataRefreshPoolRepository) GetRefreshRun(ctx context.Context, id int) (*domain.RefreshRun, error) {
+	var run domain.RefreshRun
+
+	err := conn(ctx, r.Pool).QueryRow(ctx, `
+    SELECT `+refreshRunColumns+`
+    FROM metadata_refresh_runs
+    WHERE id = $1
+    `, id).Scan(refreshRunDest(&run)...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, clientErrors.NewErrNotFound(fmt.Sprintf("refresh run with id: %d", id))
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"error": err,
+			"id":    id,
+		}).Error("Failed to get refresh run from database")
+
+		return nil, fmt.Errorf("querying refresh run: %w", clientErrors.NewErrDatabase())
+	}
+
+	rows, err := conn(ctx, r.Pool).Query(ctx, `
+    SELECT id, run_id, song_id, field, current_value, proposed_value, provider, created_at
+    FROM metadata_proposals
+    WHERE run_id = $1
+    ORDER BY song_id, field
+    `, id)
+	if err != nil {
+		return nil, fmt.Errorf("querying metadata proposals: %w", clientErrors.NewErrDatabase())
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var proposal domain.MetadataProposal
+
+		err := rows.Scan(&proposal.ID, &proposal.RunID, &proposal.SongID, &proposal.Field, &proposal.Current,
+			&proposal.Proposed, &proposal.Provider, &proposal.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("repo scanning metadata proposals: %w", clientErrors.NewErrDatabase())
+		}
+
+		run.Proposals = append(run.Proposals, proposal)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("repo reading metadata proposals: %w", clientErrors.NewErrDatabase())
+	}
+
+	return &run, nil
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strconv"
+	"slices"
+	"time"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/mashfeii/songs_library/internal/domain"
 	clientErrors "github.com/mashfeii/songs_library/internal/infrastructure/errors"
@@ -14,11 +16,63 @@ import (
 )
 
 type SongsRepository interface {
-	GetSongs(ctx context.Context, filters map[string]string, page, size int) ([]domain.Song, error)
+	GetSongs(ctx context.Context, filter *domain.SongFilter, pageReq domain.PageRequest) (*domain.Page[domain.Song], error)
 	GetSongByID(ctx context.Context, id int) (*domain.Song, error)
+	SearchSongs(ctx context.Context, query string, page, size int) ([]domain.SongSearchResult, error)
 	AddSong(ctx context.Context, song *domain.Song) (int, error)
-	UpdateSong(ctx context.Context, song *domain.Song) error
-	DeleteSong(ctx context.Context, id int) error
+	UpdateSong(ctx context.Context, song *domain.Song, info domain.RevisionInfo) error
+	GetSongRevisions(ctx context.Context, songID int, pageReq domain.PageRequest) (*domain.Page[domain.Revision], error)
+	GetSongRevision(ctx context.Context, songID, revision int) (*domain.Revision, error)
+	SetSongArtists(ctx context.Context, songID int, artists []domain.SongArtist) error
+	DeleteSong(ctx context.Context, id, version int) error
+	RestoreSong(ctx context.Context, id int) error
+	GetTrashedSongs(ctx context.Context, pageReq domain.PageRequest) (*domain.Page[domain.Song], error)
+	PurgeDeletedSongs(ctx context.Context, deletedBefore time.Time) ([]domain.Song, error)
+	ClaimPendingSong(ctx context.Context, lease time.Duration) (*domain.Song, error)
+	CompleteEnrichment(ctx context.Context, song *domain.Song) error
+	FailEnrichment(ctx context.Context, song *domain.Song, reason string) error
+}
+
+// querier is the subset of query methods shared by the pool and transactions.
+type querier interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// songColumns and songTables are shared by every query returning domain.Song,
+// the selected columns are scanned with songDest. Queries leave out trashed songs, whose
+// deleted_at is set, unless they are asked for. Tags and credited artists are aggregated
+// into JSON arrays.
+const (
+	songColumns = `s.id, s.group_id, g.name AS group_name, s.song_name, s.release_date, s.text, s.link,
+      COALESCE(s.language, '') AS language, s.album_id, COALESCE(a.title, '') AS album_title, s.track_number,
+      s.metadata_sources, s.status, COALESCE(s.status_error, '') AS status_error, s.enriched_at, s.edited_fields,
+      s.version, s.deleted_at,
+      COALESCE((
+        SELECT json_agg(json_build_object('id', t.id, 'name', t.name, 'kind', t.kind) ORDER BY t.kind, t.name)
+        FROM song_tags AS st
+        JOIN tags AS t ON st.tag_id = t.id
+        WHERE st.song_id = s.id
+      ), '[]') AS tags,
+      COALESCE((
+        SELECT json_agg(json_build_object('group_id', cg.id, 'name', cg.name, 'role', sa.role)
+          ORDER BY array_position(ARRAY['primary', 'featured', 'composer', 'producer'], sa.role), sa.position, cg.name)
+        FROM song_artists AS sa
+        JOIN groups AS cg ON sa.group_id = cg.id
+        WHERE sa.song_id = s.id
+      ), '[]') AS artists`
+	songTables = `songs AS s
+    JOIN groups AS g ON s.group_id = g.id
+    LEFT JOIN albums AS a ON s.album_id = a.id`
+)
+
+func songDest(song *domain.Song) []any {
+	return []any{
+		&song.ID, &song.GroupID, &song.Group, &song.Song, &song.ReleaseDate, &song.Text, &song.Link,
+		&song.Language, &song.AlbumID, &song.Album, &song.TrackNumber, &song.Sources, &song.Status,
+		&song.StatusError, &song.EnrichedAt, &song.EditedFields, &song.Version, &song.DeletedAt, &song.Tags, &song.Artists,
+	}
 }
 
 type SongsPoolRepository struct {
@@ -29,56 +83,171 @@ func NewSongsPoolRepository(pool *pgxpool.Pool) *SongsPoolRepository {
 	return &SongsPoolRepository{Pool: pool}
 }
 
-func (r *SongsPoolRepository) GetSongs(ctx context.Context, filters map[string]string, page, size int) ([]domain.Song, error) {
-	query := `
-    SELECT s.id, group_id, g.name as group_name, song_name, release_date, text, link
-    FROM songs AS s
-    JOIN groups AS g ON s.group_id = g.id
-    WHERE 1 = 1`
-	args := []any{}
+// fuzzyMatchThreshold is the minimal pg_trgm word similarity for a fuzzy filter to match.
+const fuzzyMatchThreshold = "0.3"
+
+func (r *SongsPoolRepository) GetSongs(
+	ctx context.Context,
+	filter *domain.SongFilter,
+	pageReq domain.PageRequest,
+) (*domain.Page[domain.Song], error) {
+	b := newSongsQueryBuilder(filter)
+	terms := b.orderTerms(filter.Sort)
+
+	countQuery := `
+    SELECT count(*)
+    FROM ` + songTables + b.whereClause()
+	countArgs := slices.Clone(b.args)
+
+	cursor := pageReq.Cursor
+	if cursor != nil {
+		if !validCursor(terms, cursor) {
+			return nil, clientErrors.NewErrInvalidInput("cursor")
+		}
 
-	for key, value := range filters {
-		query += " AND " + key + " = $" + strconv.Itoa(len(args)+1)
-		args = append(args, value)
+		b.keysetAfter(terms, cursor)
 	}
 
-	query += " LIMIT $" + strconv.Itoa(len(args)+1) + " OFFSET $" + strconv.Itoa(len(args)+2)
-	args = append(args, size, (page-1)*size)
+	backward := cursor != nil && cursor.Backward
+
+	query := `
+    SELECT ` + songColumns + `, ` + keysColumns(terms) + `
+    FROM ` + songTables +
+		b.whereClause() +
+		orderClause(terms, backward) +
+		" LIMIT " + b.arg(pageReq.Size+1)
+
+	if cursor == nil {
+		query += " OFFSET " + b.arg(pageReq.Offset())
+	}
 
 	logrus.WithFields(logrus.Fields{
 		"query": query,
-		"args":  args,
+		"args":  b.args,
 	}).Debug("Executing get songs query")
 
-	rows, err := r.Pool.Query(ctx, query, args...)
+	page := &domain.Page[domain.Song]{Items: []domain.Song{}, Size: pageReq.Size}
+	if cursor == nil {
+		page.Page = pageReq.Page
+	}
+
+	var keys [][]string
+
+	err := r.withSimilarity(ctx, filter.Match == domain.MatchFuzzy, func(q querier) error {
+		if err := q.QueryRow(ctx, countQuery, countArgs...).Scan(&page.Total); err != nil {
+			return fmt.Errorf("counting songs: %w", clientErrors.NewErrDatabase())
+		}
+
+		rows, err := q.Query(ctx, query, b.args...)
+		if err != nil {
+			return fmt.Errorf("querying songs: %w", clientErrors.NewErrDatabase())
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var song domain.Song
+
+			key := make([]string, len(terms))
+			dest := songDest(&song)
+
+			for i := range key {
+				dest = append(dest, &key[i])
+			}
+
+			if err := rows.Scan(dest...); err != nil {
+				return fmt.Errorf("repo scanning songs: %w", clientErrors.NewErrDatabase())
+			}
+
+			page.Items = append(page.Items, song)
+			keys = append(keys, key)
+		}
+
+		if rows.Err() != nil {
+			return fmt.Errorf("repo reading songs: %w", clientErrors.NewErrDatabase())
+		}
+
+		return nil
+	})
 	if err != nil {
 		logrus.WithFields(logrus.Fields{
-			"error":   err,
-			"filters": filters,
+			"error":  err,
+			"filter": filter,
 		}).Error("Failed to get songs from database")
 
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, clientErrors.NewErrNotFound(fmt.Sprintf("songs with args: %v", args))
-		}
+		return nil, err
+	}
 
-		return nil, fmt.Errorf("querying songs: %w", clientErrors.NewErrDatabase())
+	hasMore := len(page.Items) > pageReq.Size
+	if hasMore {
+		page.Items = page.Items[:pageReq.Size]
+		keys = keys[:pageReq.Size]
 	}
-	defer rows.Close()
 
-	var songs []domain.Song
+	if backward {
+		slices.Reverse(page.Items)
+		slices.Reverse(keys)
+	}
 
-	for rows.Next() {
-		var song domain.Song
+	setPageCursors(page, keys, pageReq, hasMore)
 
-		err := rows.Scan(&song.ID, &song.GroupID, &song.Group, &song.Song, &song.ReleaseDate, &song.Text, &song.Link)
-		if err != nil {
-			return nil, fmt.Errorf("repo scanning songs: %w", clientErrors.NewErrDatabase())
+	return page, nil
+}
+
+// setPageCursors fills Next and Prev cursors from the boundary rows of the page. The extra
+// row fetched beyond the page size tells whether anything lies further in the walk direction.
+func setPageCursors[T any](page *domain.Page[T], keys [][]string, pageReq domain.PageRequest, hasMore bool) {
+	if len(keys) == 0 {
+		return
+	}
+
+	hasNext, hasPrev := hasMore, pageReq.Page > 1
+
+	if pageReq.Cursor != nil {
+		hasNext, hasPrev = true, true
+
+		if pageReq.Cursor.Backward {
+			hasPrev = hasMore
+		} else {
+			hasNext = hasMore
 		}
+	}
+
+	if hasNext {
+		page.Next = domain.EncodeCursor(&domain.Cursor{Values: keys[len(keys)-1]})
+	}
+
+	if hasPrev {
+		page.Prev = domain.EncodeCursor(&domain.Cursor{Values: keys[0], Backward: true})
+	}
+}
+
+// withSimilarity runs fn against the pool, fuzzy queries are executed inside a transaction
+// so the trigram similarity threshold can be lowered locally without leaking to the pool.
+func (r *SongsPoolRepository) withSimilarity(ctx context.Context, fuzzy bool, fn func(q querier) error) error {
+	if !fuzzy {
+		return fn(conn(ctx, r.Pool))
+	}
+
+	tx, err := begin(ctx, r.Pool)
+	if err != nil {
+		return fmt.Errorf("beginning fuzzy songs query: %w", clientErrors.NewErrDatabase())
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	_, err = tx.Exec(ctx, `SELECT set_config('pg_trgm.word_similarity_threshold', $1, true)`, fuzzyMatchThreshold)
+	if err != nil {
+		return fmt.Errorf("setting similarity threshold: %w", clientErrors.NewErrDatabase())
+	}
 
-		songs = append(songs, song)
+	if err := fn(tx); err != nil {
+		return err
 	}
 
-	return songs, nil
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("committing fuzzy songs query: %w", clientErrors.NewErrDatabase())
+	}
+
+	return nil
 }
 
 func (r *SongsPoolRepository) GetSongByID(ctx context.Context, id int) (*domain.Song, error) {
@@ -88,14 +257,13 @@ func (r *SongsPoolRepository) GetSongByID(ctx context.Context, id int) (*domain.
 
 	var song domain.Song
 
-	err := r.Pool.
+	err := conn(ctx, r.Pool).
 		QueryRow(ctx, `
-    SELECT s.id, group_id, g.name AS group_name, song_name, release_date, text, link
-    FROM songs AS s 
-    JOIN groups AS g ON s.group_id = g.id
-    WHERE s.id = $1
+    SELECT `+songColumns+`
+    FROM `+songTables+`
+    WHERE s.id = $1 AND s.deleted_at IS NULL
     `, id).
-		Scan(&song.ID, &song.GroupID, &song.Group, &song.Song, &song.ReleaseDate, &song.Text, &song.Link)
+		Scan(songDest(&song)...)
 	if err != nil {
 		logrus.WithFields(logrus.Fields{
 			"error": err,
@@ -112,6 +280,59 @@ func (r *SongsPoolRepository) GetSongByID(ctx context.Context, id int) (*domain.
 	return &song, nil
 }
 
+func (r *SongsPoolRepository) SearchSongs(ctx context.Context, query string, page, size int) ([]domain.SongSearchResult, error) {
+	logrus.WithFields(logrus.Fields{
+		"query": query,
+		"page":  page,
+		"size":  size,
+	}).Debug("Executing search songs query")
+
+	rows, err := conn(ctx, r.Pool).Query(ctx, `
+    SELECT `+songColumns+`,
+      ts_rank(s.search_vector, q) AS rank,
+      ts_headline('simple', coalesce(s.text, ''), q,
+        'StartSel=<b>, StopSel=</b>, MaxFragments=2, MaxWords=20, MinWords=5, FragmentDelimiter=" ... "') AS headline
+    FROM `+songTables+`
+    CROSS JOIN websearch_to_tsquery('simple', $1) AS q
+    WHERE s.search_vector @@ q AND s.deleted_at IS NULL
+    ORDER BY rank DESC, s.id
+    LIMIT $2 OFFSET $3
+    `, query, size, (page-1)*size)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"error": err,
+			"query": query,
+		}).Error("Failed to search songs in database")
+
+		return nil, fmt.Errorf("searching songs: %w", clientErrors.NewErrDatabase())
+	}
+	defer rows.Close()
+
+	results := []domain.SongSearchResult{}
+
+	for rows.Next() {
+		var result domain.SongSearchResult
+
+		err := rows.Scan(append(songDest(&result.Song), &result.Rank, &result.Headline)...)
+		if err != nil {
+			return nil, fmt.Errorf("repo scanning search results: %w", clientErrors.NewErrDatabase())
+		}
+
+		results = append(results, result)
+	}
+
+	if rows.Err() != nil {
+		logrus.WithFields(logrus.Fields{
+			"error": rows.Err(),
+			"query": query,
+		}).Error("Failed to read search results from database")
+
+		return nil, fmt.Errorf("repo reading search results: %w", clientErrors.NewErrDatabase())
+	}
+
+	return results, nil
+}
+
 func (r *SongsPoolRepository) AddSong(ctx context.Context, song *domain.Song) (int, error) {
 	logrus.WithFields(logrus.Fields{
 		"song": song,
@@ -119,10 +340,13 @@ func (r *SongsPoolRepository) AddSong(ctx context.Context, song *domain.Song) (i
 
 	var id int
 
-	err := r.Pool.
-		QueryRow(ctx, `INSERT INTO songs(group_id, song_name, release_date, text, link)
-    VALUES($1, $2, $3, $4, $5)
-    RETURNING id`, song.GroupID, song.Song, song.ReleaseDate, song.Text, song.Link).
+	err := conn(ctx, r.Pool).
+		QueryRow(ctx, `INSERT INTO songs(group_id, song_name, release_date, text, link, language, album_id, track_number,
+      metadata_sources, status, enriched_at)
+    VALUES($1, $2, $3, $4, $5, NULLIF($6, ''), $7, $8, $9, COALESCE(NULLIF($10, ''), 'enriched'),
+      CASE WHEN COALESCE(NULLIF($10, ''), 'enriched') = 'enriched' THEN now() END)
+    RETURNING id`, song.GroupID, song.Song, song.ReleaseDate, song.Text, song.Link, song.Language,
+			song.AlbumID, song.TrackNumber, song.Sources, song.Status).
 		Scan(&id)
 	if err != nil {
 		logrus.WithFields(logrus.Fields{
@@ -136,37 +360,353 @@ func (r *SongsPoolRepository) AddSong(ctx context.Context, song *domain.Song) (i
 	return id, nil
 }
 
-func (r *SongsPoolRepository) UpdateSong(ctx context.Context, song *domain.Song) error {
+// UpdateSong overwrites the song fields and stores the new state as a revision in the same
+// transaction. Songs edited for the first time get their previous state recorded beforehand.
+// Annotations are flagged stale when their anchored lines no longer match the text.
+// Changed metadata fields are remembered as edited, unless the metadata refresh made the
+// change, which stores the sources and the refresh time instead. A non-zero song version has
+// to match the stored one, the new version is stored back into the song.
+func (r *SongsPoolRepository) UpdateSong(ctx context.Context, song *domain.Song, info domain.RevisionInfo) error {
 	logrus.WithFields(logrus.Fields{
 		"song": song,
+		"info": info,
 	}).Debug("Executing update song query")
 
-	_, err := r.Pool.Exec(ctx, `UPDATE songs
-    SET group_id = $1, song_name = $2, release_date = $3, text = $4, link = $5
-    WHERE id = $6`, song.GroupID, song.Song, song.ReleaseDate, song.Text, song.Link, song.ID)
+	tx, err := begin(ctx, r.Pool)
+	if err != nil {
+		return fmt.Errorf("beginning update song: %w", clientErrors.NewErrDatabase())
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	var locked int
+
+	err = tx.QueryRow(ctx, `SELECT id FROM songs WHERE id = $1 AND deleted_at IS NULL FOR UPDATE`, song.ID).Scan(&locked)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return clientErrors.NewErrNotFound(fmt.Sprintf("song with id: %d", song.ID))
+	}
+
+	if err != nil {
+		return fmt.Errorf("locking song: %w", clientErrors.NewErrDatabase())
+	}
+
+	_, err = tx.Exec(ctx, `
+    INSERT INTO song_revisions (song_id, revision, group_name, song_name, release_date, text, link, language, reason)
+    SELECT s.id, 1, g.name, s.song_name, s.release_date, s.text, s.link, s.language, 'initial version'
+    FROM songs AS s
+    JOIN groups AS g ON s.group_id = g.id
+    WHERE s.id = $1 AND NOT EXISTS (SELECT 1 FROM song_revisions WHERE song_id = $1)
+    `, song.ID)
+	if err != nil {
+		return fmt.Errorf("recording initial revision: %w", clientErrors.NewErrDatabase())
+	}
 
+	err = tx.QueryRow(ctx, `UPDATE songs
+    SET version = version + 1, group_id = $1, song_name = $2, release_date = $3, text = $4, link = $5, language = NULLIF($6, ''),
+      edited_fields = CASE WHEN $8 THEN edited_fields ELSE ARRAY(
+        SELECT DISTINCT field FROM unnest(edited_fields || array_remove(ARRAY[
+          CASE WHEN release_date IS DISTINCT FROM $3 THEN 'release_date' END,
+          CASE WHEN text IS DISTINCT FROM $4 THEN 'text' END,
+          CASE WHEN link IS DISTINCT FROM $5 THEN 'link' END
+        ], NULL)) AS field ORDER BY field
+      ) END,
+      metadata_sources = CASE WHEN $8 THEN $9 ELSE metadata_sources END,
+      enriched_at = CASE WHEN $8 THEN now() ELSE enriched_at END
+    WHERE id = $7 AND ($10 = 0 OR version = $10)
+    RETURNING version`, song.GroupID, song.Song, song.ReleaseDate, song.Text, song.Link, song.Language, song.ID,
+		info.Refresh, song.Sources, song.Version).Scan(&song.Version)
 	if errors.Is(err, pgx.ErrNoRows) {
+		return clientErrors.NewErrPreconditionFailed(fmt.Sprintf("song with id %d changed since version %d", song.ID, song.Version))
+	}
+
+	if err != nil {
 		logrus.WithFields(logrus.Fields{
 			"error": err,
 			"song":  song,
 		}).Error("Failed to update song in database")
 
-		return clientErrors.NewErrNotFound(fmt.Sprintf("song with id: %d", song.ID))
+		return fmt.Errorf("updating song: %w", clientErrors.NewErrDatabase())
+	}
+
+	_, err = tx.Exec(ctx, `
+    INSERT INTO song_revisions (song_id, revision, group_name, song_name, release_date, text, link, language, author, reason)
+    SELECT s.id, (SELECT max(revision) + 1 FROM song_revisions WHERE song_id = s.id),
+      g.name, s.song_name, s.release_date, s.text, s.link, s.language, $2, $3
+    FROM songs AS s
+    JOIN groups AS g ON s.group_id = g.id
+    WHERE s.id = $1
+    `, song.ID, info.Author, info.Reason)
+	if err != nil {
+		return fmt.Errorf("recording song revision: %w", clientErrors.NewErrDatabase())
+	}
+
+	if _, err := tx.Exec(ctx, markStaleAnnotationsQuery, song.ID); err != nil {
+		return fmt.Errorf("marking stale annotations: %w", clientErrors.NewErrDatabase())
 	}
 
-	return fmt.Errorf("updating song: %w", clientErrors.NewErrDatabase())
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("committing update song: %w", clientErrors.NewErrDatabase())
+	}
+
+	return nil
 }
 
-func (r *SongsPoolRepository) DeleteSong(ctx context.Context, id int) error {
-	logrus.WithFields(logrus.Fields{
-		"id": id,
-	}).Debug("Executing delete song query")
+// ClaimPendingSong marks the oldest pending song as processing for a worker, skipping songs
+// claimed by others, and returns it, or nil when no song is pending. The claim commits right
+// away, so no transaction stays open while the song is looked up. Songs whose claim is older
+// than the lease, left behind by a worker that stopped midway, are claimed again.
+func (r *SongsPoolRepository) ClaimPendingSong(ctx context.Context, lease time.Duration) (*domain.Song, error) {
+	logrus.WithField("lease", lease).Debug("Executing claim pending song query")
+
+	var song domain.Song
 
-	_, err := r.Pool.Exec(ctx, `DELETE FROM songs WHERE id = $1`, id)
+	err := conn(ctx, r.Pool).QueryRow(ctx, `
+    WITH claimed AS (
+      SELECT id FROM songs
+      WHERE deleted_at IS NULL
+        AND (status = 'pending' OR (status = 'processing' AND claimed_at < now() - make_interval(secs => $1)))
+      ORDER BY id
+      LIMIT 1
+      FOR UPDATE SKIP LOCKED
+    )
+    UPDATE songs AS s
+    SET status = 'processing', claimed_at = now(), version = s.version + 1
+    FROM claimed, groups AS g
+    WHERE s.id = claimed.id AND g.id = s.group_id
+    RETURNING s.id, s.group_id, g.name, s.song_name, COALESCE(s.language, ''), s.status, s.version, s.claimed_at
+    `, lease.Seconds()).Scan(&song.ID, &song.GroupID, &song.Group, &song.Song, &song.Language, &song.Status,
+		&song.Version, &song.ClaimedAt)
 	if errors.Is(err, pgx.ErrNoRows) {
-		return clientErrors.NewErrNotFound(fmt.Sprintf("song with id: %d", id))
+		return nil, nil
+	}
+
+	if err != nil {
+		logrus.WithField("error", err).Error("Failed to claim pending song")
+
+		return nil, fmt.Errorf("claiming pending song: %w", clientErrors.NewErrDatabase())
+	}
+
+	return &song, nil
+}
+
+// CompleteEnrichment stores the details of a claimed song and marks it enriched. The claim
+// has to be the current one, a song claimed again after its lease expired or moved to the
+// trash meanwhile is answered with a conflict.
+func (r *SongsPoolRepository) CompleteEnrichment(ctx context.Context, song *domain.Song) error {
+	logrus.WithField("id", song.ID).Debug("Executing complete enrichment query")
+
+	err := conn(ctx, r.Pool).QueryRow(ctx, `
+    UPDATE songs
+    SET release_date = $2, text = $3, link = $4, album_id = $5, track_number = $6, metadata_sources = $7,
+      status = 'enriched', status_error = NULL, claimed_at = NULL, enriched_at = now(), version = version + 1
+    WHERE id = $1 AND status = 'processing' AND claimed_at = $8 AND deleted_at IS NULL
+    RETURNING status, enriched_at, version
+    `, song.ID, song.ReleaseDate, song.Text, song.Link, song.AlbumID, song.TrackNumber, song.Sources,
+		song.ClaimedAt).Scan(&song.Status, &song.EnrichedAt, &song.Version)
+
+	return r.enrichmentResult(song, "complete", err)
+}
+
+// FailEnrichment marks a claimed song failed with the reason recorded, the claim has to be
+// the current one as for CompleteEnrichment.
+func (r *SongsPoolRepository) FailEnrichment(ctx context.Context, song *domain.Song, reason string) error {
+	logrus.WithField("id", song.ID).Debug("Executing fail enrichment query")
+
+	err := conn(ctx, r.Pool).QueryRow(ctx, `
+    UPDATE songs
+    SET status = 'failed', status_error = $2, claimed_at = NULL, version = version + 1
+    WHERE id = $1 AND status = 'processing' AND claimed_at = $3 AND deleted_at IS NULL
+    RETURNING status, status_error, version
+    `, song.ID, reason, song.ClaimedAt).Scan(&song.Status, &song.StatusError, &song.Version)
+
+	return r.enrichmentResult(song, "fail", err)
+}
+
+func (r *SongsPoolRepository) enrichmentResult(song *domain.Song, operation string, err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return clientErrors.NewErrConflict(fmt.Sprintf("claim of song with id %d expired", song.ID))
+	}
+
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"error": err,
+			"id":    song.ID,
+		}).Error("Failed to store song enrichment")
+
+		return fmt.Errorf("%s enrichment: %w", operation, clientErrors.NewErrDatabase())
+	}
+
+	song.ClaimedAt = nil
+
+	return nil
+}
+
+const revisionColumns = `revision, song_id, group_name, song_name, release_date, COALESCE(link, ''),
+    COALESCE(language, ''), author, reason, created_at`
+
+func revisionDest(revision *domain.Revision) []any {
+	return []any{
+		&revision.Number, &revision.SongID, &revision.Group, &revision.Song, &revision.ReleaseDate,
+		&revision.Link, &revision.Language, &revision.Author, &revision.Reason, &revision.CreatedAt,
 	}
+}
+
+// GetSongRevisions lists revisions of a song from the newest one, without their text.
+func (r *SongsPoolRepository) GetSongRevisions(
+	ctx context.Context,
+	songID int,
+	pageReq domain.PageRequest,
+) (*domain.Page[domain.Revision], error) {
+	logrus.WithFields(logrus.Fields{
+		"song_id": songID,
+		"page":    pageReq.Page,
+		"size":    pageReq.Size,
+	}).Debug("Executing get song revisions query")
+
+	page := &domain.Page[domain.Revision]{Items: []domain.Revision{}, Page: pageReq.Page, Size: pageReq.Size}
+
+	var exists bool
+
+	err := conn(ctx, r.Pool).QueryRow(ctx, `
+    SELECT EXISTS (SELECT 1 FROM songs WHERE id = $1), (SELECT count(*) FROM song_revisions WHERE song_id = $1)
+    `, songID).Scan(&exists, &page.Total)
+	if err != nil {
+		logrus.WithField("error", err).Error("Failed to count song revisions in database")
+
+		return nil, fmt.Errorf("counting song revisions: %w", clientErrors.NewErrDatabase())
+	}
+
+	if !exists {
+		return nil, clientErrors.NewErrNotFound(fmt.Sprintf("song with id: %d", songID))
+	}
+
+	rows, err := conn(ctx, r.Pool).Query(ctx, `
+    SELECT `+revisionColumns+`
+    FROM song_revisions
+    WHERE song_id = $1
+    ORDER BY revision DESC
+    LIMIT $2 OFFSET $3
+    `, songID, pageReq.Size, pageReq.Offset())
+	if err != nil {
+		return nil, fmt.Errorf("querying song revisions: %w", clientErrors.NewErrDatabase())
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var revision domain.Revision
+
+		if err := rows.Scan(revisionDest(&revision)...); err != nil {
+			return nil, fmt.Errorf("repo scanning song revisions: %w", clientErrors.NewErrDatabase())
+		}
+
+		page.Items = append(page.Items, revision)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("repo reading song revisions: %w", clientErrors.NewErrDatabase())
+	}
+
+	return page, nil
+}
+
+func (r *SongsPoolRepository) GetSongRevision(ctx context.Context, songID, revision int) (*domain.Revision, error) {
+	logrus.WithFields(logrus.Fields{
+		"song_id":  songID,
+		"revision": revision,
+	}).Debug("Executing get song revision query")
+
+	var result domain.Revision
+
+	err := conn(ctx, r.Pool).QueryRow(ctx, `
+    SELECT `+revisionColumns+`, COALESCE(text, '')
+    FROM song_revisions
+    WHERE song_id = $1 AND revision = $2
+    `, songID, revision).Scan(append(revisionDest(&result), &result.Text)...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, clientErrors.NewErrNotFound(fmt.Sprintf("revision %d of song with id: %d", revision, songID))
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"error":    err,
+			"song_id":  songID,
+			"revision": revision,
+		}).Error("Failed to get song revision from database")
+
+		return nil, fmt.Errorf("querying song revision: %w", clientErrors.NewErrDatabase())
+	}
+
+	return &result, nil
+}
 
+// SetSongArtists replaces the credits of a song, the first primary artist becomes the group
+// of the song. Callers must pass at least one primary artist.
+func (r *SongsPoolRepository) SetSongArtists(ctx context.Context, songID int, artists []domain.SongArtist) error {
+	logrus.WithFields(logrus.Fields{
+		"song_id": songID,
+		"artists": artists,
+	}).Debug("Executing set song artists query")
+
+	primary := slices.IndexFunc(artists, func(artist domain.SongArtist) bool {
+		return artist.Role == domain.RolePrimary
+	})
+	if primary < 0 {
+		return clientErrors.NewErrInvalidInput("artists")
+	}
+
+	tx, err := begin(ctx, r.Pool)
+	if err != nil {
+		return fmt.Errorf("beginning set song artists: %w", clientErrors.NewErrDatabase())
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	tag, err := tx.Exec(ctx, `UPDATE songs SET group_id = $1, version = version + 1 WHERE id = $2 AND deleted_at IS NULL`, artists[primary].GroupID, songID)
+	if err != nil {
+		return fmt.Errorf("updating song primary artist: %w", clientErrors.NewErrDatabase())
+	}
+
+	if tag.RowsAffected() == 0 {
+		return clientErrors.NewErrNotFound(fmt.Sprintf("song with id: %d", songID))
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM song_artists WHERE song_id = $1`, songID); err != nil {
+		return fmt.Errorf("clearing song artists: %w", clientErrors.NewErrDatabase())
+	}
+
+	for position, artist := range artists {
+		_, err := tx.Exec(ctx, `
+    INSERT INTO song_artists (song_id, group_id, role, position)
+    VALUES ($1, $2, $3, $4)
+    ON CONFLICT DO NOTHING
+    `, songID, artist.GroupID, string(artist.Role), position)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"error":  err,
+				"artist": artist,
+			}).Error("Failed to credit song artist")
+
+			return fmt.Errorf("inserting song artist: %w", clientErrors.NewErrDatabase())
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("committing set song artists: %w", clientErrors.NewErrDatabase())
+	}
+
+	return nil
+}
+
+// DeleteSong moves the song to the trash, a non-zero version has to match the stored one.
+// Trashed songs are kept until RestoreSong brings them back or PurgeDeletedSongs removes them.
+func (r *SongsPoolRepository) DeleteSong(ctx context.Context, id, version int) error {
+	logrus.WithFields(logrus.Fields{
+		"id":      id,
+		"version": version,
+	}).Debug("Executing delete song query")
+
+	tag, err := conn(ctx, r.Pool).Exec(ctx, `
+    UPDATE songs SET deleted_at = now(), version = version + 1
+    WHERE id = $1 AND deleted_at IS NULL AND ($2 = 0 OR version = $2)
+    `, id, version)
 	if err != nil {
 		logrus.WithFields(logrus.Fields{
 			"error": err,
@@ -176,5 +716,192 @@ func (r *SongsPoolRepository) DeleteSong(ctx context.Context, id int) error {
 		return fmt.Errorf("deleting song: %w", clientErrors.NewErrDatabase())
 	}
 
+	if tag.RowsAffected() > 0 {
+		return nil
+	}
+
+	var exists bool
+
+	err = conn(ctx, r.Pool).
+		QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM songs WHERE id = $1 AND deleted_at IS NULL)`, id).
+		Scan(&exists)
+	if err != nil {
+		return fmt.Errorf("checking deleted song: %w", clientErrors.NewErrDatabase())
+	}
+
+	if exists {
+		return clientErrors.NewErrPreconditionFailed(fmt.Sprintf("song with id %d changed since version %d", id, version))
+	}
+
+	return clientErrors.NewErrNotFound(fmt.Sprintf("song with id: %d", id))
+}
+
+// RestoreSong takes the song out of the trash, along with its group when the group was
+// deleted and waits for its trashed songs to be purged.
+func (r *SongsPoolRepository) RestoreSong(ctx context.Context, id int) error {
+	logrus.WithFields(logrus.Fields{
+		"id": id,
+	}).Debug("Executing restore song query")
+
+	tx, err := begin(ctx, r.Pool)
+	if err != nil {
+		return fmt.Errorf("beginning restore song: %w", clientErrors.NewErrDatabase())
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	var groupID int
+
+	err = tx.QueryRow(ctx, `
+    UPDATE songs SET deleted_at = NULL, version = version + 1
+    WHERE id = $1 AND deleted_at IS NOT NULL
+    RETURNING group_id
+    `, id).Scan(&groupID)
+	if err == nil {
+		_, err = tx.Exec(ctx, `UPDATE groups SET deleted_at = NULL WHERE id = $1 AND deleted_at IS NOT NULL`, groupID)
+	}
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return clientErrors.NewErrNotFound(fmt.Sprintf("trashed song with id: %d", id))
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"error": err,
+			"id":    id,
+		}).Error("Failed to restore song in database")
+
+		return fmt.Errorf("restoring song: %w", clientErrors.NewErrDatabase())
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("committing restore song: %w", clientErrors.NewErrDatabase())
+	}
+
 	return nil
 }
+
+// GetTrashedSongs lists songs in the trash, most recently deleted first.
+func (r *SongsPoolRepository) GetTrashedSongs(
+	ctx context.Context,
+	pageReq domain.PageRequest,
+) (*domain.Page[domain.Song], error) {
+	logrus.WithFields(logrus.Fields{
+		"page": pageReq.Page,
+		"size": pageReq.Size,
+	}).Debug("Executing get trashed songs query")
+
+	page := &domain.Page[domain.Song]{Items: []domain.Song{}, Page: pageReq.Page, Size: pageReq.Size}
+
+	err := conn(ctx, r.Pool).
+		QueryRow(ctx, `SELECT count(*) FROM songs WHERE deleted_at IS NOT NULL`).
+		Scan(&page.Total)
+	if err != nil {
+		logrus.WithField("error", err).Error("Failed to count trashed songs in database")
+
+		return nil, fmt.Errorf("counting trashed songs: %w", clientErrors.NewErrDatabase())
+	}
+
+	rows, err := conn(ctx, r.Pool).Query(ctx, `
+    SELECT `+songColumns+`
+    FROM `+songTables+`
+    WHERE s.deleted_at IS NOT NULL
+    ORDER BY s.deleted_at DESC, s.id
+    LIMIT $1 OFFSET $2
+    `, pageReq.Size, pageReq.Offset())
+	if err != nil {
+		logrus.WithField("error", err).Error("Failed to get trashed songs from database")
+
+		return nil, fmt.Errorf("querying trashed songs: %w", clientErrors.NewErrDatabase())
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var song domain.Song
+
+		if err := rows.Scan(songDest(&song)...); err != nil {
+			return nil, fmt.Errorf("repo scanning trashed songs: %w", clientErrors.NewErrDatabase())
+		}
+
+		page.Items = append(page.Items, song)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("repo reading trashed songs: %w", clientErrors.NewErrDatabase())
+	}
+
+	return page, nil
+}
+
+// PurgeDeletedSongs removes songs trashed before the given time for good, returning the ID,
+// group, name and deletion time of each removed song. Deleted groups left without songs are
+// removed together with their albums.
+func (r *SongsPoolRepository) PurgeDeletedSongs(ctx context.Context, deletedBefore time.Time) ([]domain.Song, error) {
+	logrus.WithFields(logrus.Fields{
+		"deleted_before": deletedBefore,
+	}).Debug("Executing purge deleted songs query")
+
+	tx, err := begin(ctx, r.Pool)
+	if err != nil {
+		return nil, fmt.Errorf("beginning purge deleted songs: %w", clientErrors.NewErrDatabase())
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	purged, err := purgeSongs(ctx, tx, deletedBefore)
+	if err != nil {
+		logrus.WithField("error", err).Error("Failed to purge deleted songs from database")
+
+		return nil, fmt.Errorf("purging deleted songs: %w", clientErrors.NewErrDatabase())
+	}
+
+	_, err = tx.Exec(ctx, `
+    WITH emptied AS (
+      SELECT g.id FROM groups AS g
+      WHERE g.deleted_at IS NOT NULL AND NOT EXISTS (SELECT 1 FROM songs AS s WHERE s.group_id = g.id)
+    ), albums_removed AS (
+      DELETE FROM albums WHERE group_id IN (SELECT id FROM emptied)
+    )
+    DELETE FROM groups WHERE id IN (SELECT id FROM emptied)
+    `)
+	if err != nil {
+		logrus.WithField("error", err).Error("Failed to purge deleted groups from database")
+
+		return nil, fmt.Errorf("purging deleted groups: %w", clientErrors.NewErrDatabase())
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("committing purge deleted songs: %w", clientErrors.NewErrDatabase())
+	}
+
+	return purged, nil
+}
+
+func purgeSongs(ctx context.Context, tx pgx.Tx, deletedBefore time.Time) ([]domain.Song, error) {
+	rows, err := tx.Query(ctx, `
+    DELETE FROM songs AS s
+    USING groups AS g
+    WHERE g.id = s.group_id AND s.deleted_at < $1
+    RETURNING s.id, g.name, s.song_name, s.deleted_at
+    `, deletedBefore)
+	if err != nil {
+		return nil, fmt.Errorf("deleting trashed songs: %w", err)
+	}
+	defer rows.Close()
+
+	purged := []domain.Song{}
+
+	for rows.Next() {
+		var song domain.Song
+
+		if err := rows.Scan(&song.ID, &song.Group, &song.Song, &song.DeletedAt); err != nil {
+			return nil, fmt.Errorf("scanning purged songs: %w", err)
+		}
+
+		purged = append(purged, song)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("reading purged songs: %w", err)
+	}
+
+	return purged, nil
+}
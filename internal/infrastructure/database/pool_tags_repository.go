@@ -0,0 +1,138 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/mashfeii/songs_library/internal/domain"
+	clientErrors "github.com/mashfeii/songs_library/internal/infrastructure/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type TagsRepository interface {
+	GetTags(ctx context.Context, kind domain.TagKind) ([]domain.TagCount, error)
+	SetSongTags(ctx context.Context, songID int, tags []domain.Tag) ([]domain.Tag, error)
+}
+
+type TagsPoolRepository struct {
+	Pool *pgxpool.Pool
+}
+
+func NewTagsPoolRepository(pool *pgxpool.Pool) *TagsPoolRepository {
+	return &TagsPoolRepository{Pool: pool}
+}
+
+// GetTags lists tags with the number of songs outside the trash carrying them, kind narrows the listing when set.
+func (r *TagsPoolRepository) GetTags(ctx context.Context, kind domain.TagKind) ([]domain.TagCount, error) {
+	logrus.WithFields(logrus.Fields{
+		"kind": kind,
+	}).Debug("Executing get tags query")
+
+	rows, err := conn(ctx, r.Pool).Query(ctx, `
+    SELECT t.id, t.name, t.kind, count(s.id) AS songs
+    FROM tags AS t
+    LEFT JOIN song_tags AS st ON st.tag_id = t.id
+    LEFT JOIN songs AS s ON s.id = st.song_id AND s.deleted_at IS NULL
+    WHERE $1 = '' OR t.kind = $1
+    GROUP BY t.id
+    ORDER BY songs DESC, t.name, t.kind
+    `, string(kind))
+	if err != nil {
+		logrus.WithField("error", err).Error("Failed to get tags from database")
+
+		return nil, fmt.Errorf("querying tags: %w", clientErrors.NewErrDatabase())
+	}
+	defer rows.Close()
+
+	tags := []domain.TagCount{}
+
+	for rows.Next() {
+		var tag domain.TagCount
+
+		if err := rows.Scan(&tag.ID, &tag.Name, &tag.Kind, &tag.Songs); err != nil {
+			return nil, fmt.Errorf("repo scanning tags: %w", clientErrors.NewErrDatabase())
+		}
+
+		tags = append(tags, tag)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("repo reading tags: %w", clientErrors.NewErrDatabase())
+	}
+
+	return tags, nil
+}
+
+// SetSongTags replaces the tags of a song, unknown tags are created on the fly.
+func (r *TagsPoolRepository) SetSongTags(ctx context.Context, songID int, tags []domain.Tag) ([]domain.Tag, error) {
+	logrus.WithFields(logrus.Fields{
+		"song_id": songID,
+		"tags":    tags,
+	}).Debug("Executing set song tags query")
+
+	tx, err := begin(ctx, r.Pool)
+	if err != nil {
+		return nil, fmt.Errorf("beginning set song tags: %w", clientErrors.NewErrDatabase())
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	var locked int
+
+	err = tx.QueryRow(ctx, `SELECT id FROM songs WHERE id = $1 AND deleted_at IS NULL FOR UPDATE`, songID).Scan(&locked)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, clientErrors.NewErrNotFound(fmt.Sprintf("song with id: %d", songID))
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("locking song: %w", clientErrors.NewErrDatabase())
+	}
+
+	ids := make([]int, 0, len(tags))
+	stored := make([]domain.Tag, 0, len(tags))
+
+	for _, tag := range tags {
+		err := tx.QueryRow(ctx, `
+    INSERT INTO tags (name, kind)
+    VALUES ($1, $2)
+    ON CONFLICT (kind, name) DO UPDATE SET name = EXCLUDED.name
+    RETURNING id
+    `, tag.Name, string(tag.Kind)).Scan(&tag.ID)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"error": err,
+				"tag":   tag,
+			}).Error("Failed to upsert tag")
+
+			return nil, fmt.Errorf("upserting tag: %w", clientErrors.NewErrDatabase())
+		}
+
+		ids = append(ids, tag.ID)
+		stored = append(stored, tag)
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM song_tags WHERE song_id = $1`, songID); err != nil {
+		return nil, fmt.Errorf("clearing song tags: %w", clientErrors.NewErrDatabase())
+	}
+
+	_, err = tx.Exec(ctx, `
+    INSERT INTO song_tags (song_id, tag_id)
+    SELECT $1, unnest($2::int[])
+    ON CONFLICT DO NOTHING
+    `, songID, ids)
+	if err != nil {
+		return nil, fmt.Errorf("linking song tags: %w", clientErrors.NewErrDatabase())
+	}
+
+	if _, err := tx.Exec(ctx, `UPDATE songs SET version = version + 1 WHERE id = $1`, songID); err != nil {
+		return nil, fmt.Errorf("bumping song version: %w", clientErrors.NewErrDatabase())
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("committing set song tags: %w", clientErrors.NewErrDatabase())
+	}
+
+	return stored, nil
+}
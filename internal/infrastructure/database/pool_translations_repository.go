@@ -0,0 +1,168 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/mashfeii/songs_library/internal/domain"
+	clientErrors "github.com/mashfeii/songs_library/internal/infrastructure/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type TranslationsRepository interface {
+	GetTranslations(ctx context.Context, songID int) ([]domain.Translation, error)
+	GetTranslation(ctx context.Context, songID int, language string) (*domain.Translation, error)
+	AddTranslation(ctx context.Context, translation *domain.Translation) error
+	UpdateTranslation(ctx context.Context, translation *domain.Translation) error
+}
+
+const translationColumns = `song_id, language, text, translator, created_at, updated_at`
+
+func translationDest(translation *domain.Translation) []any {
+	return []any{
+		&translation.SongID, &translation.Language, &translation.Text, &translation.Translator,
+		&translation.CreatedAt, &translation.UpdatedAt,
+	}
+}
+
+type TranslationsPoolRepository struct {
+	Pool *pgxpool.Pool
+}
+
+func NewTranslationsPoolRepository(pool *pgxpool.Pool) *TranslationsPoolRepository {
+	return &TranslationsPoolRepository{Pool: pool}
+}
+
+func (r *TranslationsPoolRepository) GetTranslations(ctx context.Context, songID int) ([]domain.Translation, error) {
+	logrus.WithFields(logrus.Fields{
+		"song_id": songID,
+	}).Debug("Executing get translations query")
+
+	rows, err := conn(ctx, r.Pool).Query(ctx, `
+    SELECT `+translationColumns+`
+    FROM song_translations
+    WHERE song_id = $1
+    ORDER BY language
+    `, songID)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"error":   err,
+			"song_id": songID,
+		}).Error("Failed to get translations from database")
+
+		return nil, fmt.Errorf("querying translations: %w", clientErrors.NewErrDatabase())
+	}
+	defer rows.Close()
+
+	translations := []domain.Translation{}
+
+	for rows.Next() {
+		var translation domain.Translation
+
+		if err := rows.Scan(translationDest(&translation)...); err != nil {
+			return nil, fmt.Errorf("repo scanning translations: %w", clientErrors.NewErrDatabase())
+		}
+
+		translations = append(translations, translation)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("repo reading translations: %w", clientErrors.NewErrDatabase())
+	}
+
+	return translations, nil
+}
+
+func (r *TranslationsPoolRepository) GetTranslation(
+	ctx context.Context,
+	songID int,
+	language string,
+) (*domain.Translation, error) {
+	logrus.WithFields(logrus.Fields{
+		"song_id":  songID,
+		"language": language,
+	}).Debug("Executing get translation query")
+
+	var translation domain.Translation
+
+	err := conn(ctx, r.Pool).QueryRow(ctx, `
+    SELECT `+translationColumns+`
+    FROM song_translations
+    WHERE song_id = $1 AND language = $2
+    `, songID, language).Scan(translationDest(&translation)...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, clientErrors.NewErrNotFound(fmt.Sprintf("%s translation of song with id: %d", language, songID))
+		}
+
+		return nil, fmt.Errorf("querying translation: %w", clientErrors.NewErrDatabase())
+	}
+
+	return &translation, nil
+}
+
+func (r *TranslationsPoolRepository) AddTranslation(ctx context.Context, translation *domain.Translation) error {
+	logrus.WithFields(logrus.Fields{
+		"song_id":  translation.SongID,
+		"language": translation.Language,
+	}).Debug("Executing add translation query")
+
+	err := conn(ctx, r.Pool).QueryRow(ctx, `
+    INSERT INTO song_translations (song_id, language, text, translator)
+    VALUES ($1, $2, $3, $4)
+    RETURNING created_at, updated_at
+    `, translation.SongID, translation.Language, translation.Text, translation.Translator).
+		Scan(&translation.CreatedAt, &translation.UpdatedAt)
+	if err != nil {
+		switch {
+		case isPgError(err, pgForeignKeyViolation):
+			return clientErrors.NewErrNotFound(fmt.Sprintf("song with id: %d", translation.SongID))
+		case isPgError(err, pgUniqueViolation):
+			return clientErrors.NewErrConflict(fmt.Sprintf("%s translation already exists", translation.Language))
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"error":    err,
+			"song_id":  translation.SongID,
+			"language": translation.Language,
+		}).Error("Failed to add translation to database")
+
+		return fmt.Errorf("inserting translation: %w", clientErrors.NewErrDatabase())
+	}
+
+	return nil
+}
+
+func (r *TranslationsPoolRepository) UpdateTranslation(ctx context.Context, translation *domain.Translation) error {
+	logrus.WithFields(logrus.Fields{
+		"song_id":  translation.SongID,
+		"language": translation.Language,
+	}).Debug("Executing update translation query")
+
+	err := conn(ctx, r.Pool).QueryRow(ctx, `
+    UPDATE song_translations
+    SET text = $3, translator = $4, updated_at = now()
+    WHERE song_id = $1 AND language = $2
+    RETURNING created_at, updated_at
+    `, translation.SongID, translation.Language, translation.Text, translation.Translator).
+		Scan(&translation.CreatedAt, &translation.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return clientErrors.NewErrNotFound(
+				fmt.Sprintf("%s translation of song with id: %d", translation.Language, translation.SongID))
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"error":    err,
+			"song_id":  translation.SongID,
+			"language": translation.Language,
+		}).Error("Failed to update translation in database")
+
+		return fmt.Errorf("updating translation: %w", clientErrors.NewErrDatabase())
+	}
+
+	return nil
+}
@@ -0,0 +1,320 @@
+package database
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mashfeii/songs_library/internal/domain"
+)
+
+// songSortColumns whitelists sort keys accepted by GetSongs and maps them to expressions.
+// Keyset pagination compares rows by these expressions, so they must never be NULL.
+var songSortColumns = map[string]orderTerm{
+	"id":           {expr: "s.id", sqlType: "int"},
+	"group_name":   {expr: "g.name", sqlType: "text"},
+	"song_name":    {expr: "s.song_name", sqlType: "text"},
+	"release_date": {expr: "COALESCE(s.release_date, '-infinity'::date)", sqlType: "date"},
+}
+
+type orderTerm struct {
+	expr    string
+	sqlType string
+	desc    bool
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// songsQueryBuilder collects WHERE conditions and ORDER BY terms for the songs listing
+// while keeping every user supplied value in a positional argument.
+type songsQueryBuilder struct {
+	args       []any
+	conditions []string
+	similarity []string
+}
+
+func (b *songsQueryBuilder) arg(value any) string {
+	b.args = append(b.args, value)
+
+	return "$" + strconv.Itoa(len(b.args))
+}
+
+func (b *songsQueryBuilder) where(condition string) {
+	b.conditions = append(b.conditions, condition)
+}
+
+// match adds a condition comparing column with any of the values according to the match mode.
+func (b *songsQueryBuilder) match(column string, mode domain.MatchMode, values ...string) {
+	if len(values) == 0 {
+		return
+	}
+
+	condition, score := b.matchCondition(column, mode, values...)
+
+	b.where(condition)
+
+	if score != "" {
+		b.similarity = append(b.similarity, score)
+	}
+}
+
+// matchCondition builds the condition of match, fuzzy matching also returns the similarity
+// expression used to rank rows.
+func (b *songsQueryBuilder) matchCondition(column string, mode domain.MatchMode, values ...string) (string, string) {
+	switch mode {
+	case domain.MatchPrefix:
+		patterns := make([]string, 0, len(values))
+		for _, value := range values {
+			patterns = append(patterns, likeEscaper.Replace(value)+"%")
+		}
+
+		return column + " ILIKE ANY(" + b.arg(patterns) + ")", ""
+	case domain.MatchFuzzy:
+		alternatives := make([]string, 0, len(values))
+		scores := make([]string, 0, len(values))
+
+		for _, value := range values {
+			placeholder := b.arg(value)
+			alternatives = append(alternatives, placeholder+" <% "+column)
+			scores = append(scores, "word_similarity("+placeholder+", "+column+")")
+		}
+
+		return "(" + strings.Join(alternatives, " OR ") + ")", "GREATEST(" + strings.Join(scores, ", ") + ")"
+	case domain.MatchExact:
+		fallthrough
+	default:
+		return column + " = ANY(" + b.arg(values) + ")", ""
+	}
+}
+
+// matchArtists keeps songs crediting the group or any of the named groups, with the given
+// role when set. Exact names are resolved through group aliases, so any spelling that
+// normalizes to a known alias finds the canonical group.
+func (b *songsQueryBuilder) matchArtists(groupID int, groups []string, role domain.ArtistRole, mode domain.MatchMode) {
+	if groupID == 0 && len(groups) == 0 && role == "" {
+		return
+	}
+
+	credits := "FROM song_artists AS sa JOIN groups AS cg ON sa.group_id = cg.id WHERE sa.song_id = s.id"
+
+	if groupID != 0 {
+		credits += " AND sa.group_id = " + b.arg(groupID)
+	}
+
+	if role != "" {
+		credits += " AND sa.role = " + b.arg(string(role))
+	}
+
+	if len(groups) == 0 {
+		b.where("EXISTS (SELECT 1 " + credits + ")")
+
+		return
+	}
+
+	var condition, score string
+
+	if mode == domain.MatchExact || mode == "" {
+		names := b.arg(groups)
+		condition = "(cg.name = ANY(" + names + ") OR sa.group_id IN (" +
+			"SELECT ga.group_id FROM group_aliases AS ga " +
+			"WHERE ga.alias IN (SELECT normalize_group_name(v) FROM unnest(" + names + "::text[]) AS v)))"
+	} else {
+		condition, score = b.matchCondition("cg.name", mode, groups...)
+	}
+
+	b.where("EXISTS (SELECT 1 " + credits + " AND " + condition + ")")
+
+	if score != "" {
+		b.similarity = append(b.similarity, "(SELECT max("+score+") "+credits+")")
+	}
+}
+
+// matchTags keeps songs carrying any of the tags, or every one of them in TagMatchAll mode.
+func (b *songsQueryBuilder) matchTags(mode domain.TagMatch, tags []string) {
+	if len(tags) == 0 {
+		return
+	}
+
+	subquery := "SELECT st.song_id FROM song_tags AS st JOIN tags AS t ON st.tag_id = t.id " +
+		"WHERE t.name = ANY(" + b.arg(tags) + ")"
+
+	if mode == domain.TagMatchAll {
+		subquery += " GROUP BY st.song_id HAVING count(DISTINCT t.name) = " + b.arg(len(tags))
+	}
+
+	b.where("s.id IN (" + subquery + ")")
+}
+
+func (b *songsQueryBuilder) whereClause() string {
+	if len(b.conditions) == 0 {
+		return ""
+	}
+
+	return " WHERE " + strings.Join(b.conditions, " AND ")
+}
+
+// orderTerms resolves the requested sort keys, falling back to similarity for fuzzy
+// matches, and always ends with the primary key so every row has a unique position.
+func (b *songsQueryBuilder) orderTerms(sort []domain.SortField) []orderTerm {
+	terms := make([]orderTerm, 0, len(sort)+2)
+
+	if len(sort) == 0 && len(b.similarity) > 0 {
+		terms = append(terms, orderTerm{
+			expr:    "(" + strings.Join(b.similarity, " + ") + ")::float8",
+			sqlType: "float8",
+			desc:    true,
+		})
+	}
+
+	hasID := false
+
+	for _, field := range sort {
+		term, ok := songSortColumns[field.Field]
+		if !ok {
+			continue
+		}
+
+		hasID = hasID || field.Field == "id"
+		term.desc = field.Desc
+
+		terms = append(terms, term)
+	}
+
+	if !hasID {
+		terms = append(terms, songSortColumns["id"])
+	}
+
+	return terms
+}
+
+// validCursor reports whether the cursor values parse as the types of the order terms,
+// so a tampered cursor or one from another sort is rejected before reaching the query.
+func validCursor(terms []orderTerm, cursor *domain.Cursor) bool {
+	if len(cursor.Values) != len(terms) {
+		return false
+	}
+
+	for i, term := range terms {
+		value := cursor.Values[i]
+
+		var err error
+
+		switch term.sqlType {
+		case "int":
+			_, err = strconv.ParseInt(value, 10, 32)
+		case "float8":
+			_, err = strconv.ParseFloat(value, 64)
+		case "date":
+			if value != "-infinity" && value != "infinity" {
+				_, err = time.Parse(time.DateOnly, value)
+			}
+		}
+
+		if err != nil || strings.ContainsRune(value, 0) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// keysetAfter restricts rows to those positioned after the cursor in the given order,
+// or before it when the cursor walks backward.
+func (b *songsQueryBuilder) keysetAfter(terms []orderTerm, cursor *domain.Cursor) {
+	values := make([]string, 0, len(terms))
+	for i, term := range terms {
+		values = append(values, b.arg(cursor.Values[i])+"::"+term.sqlType)
+	}
+
+	alternatives := make([]string, 0, len(terms))
+
+	for i, term := range terms {
+		parts := make([]string, 0, i+1)
+
+		for j, prev := range terms[:i] {
+			parts = append(parts, prev.expr+" = "+values[j])
+		}
+
+		op := ">"
+		if term.desc != cursor.Backward {
+			op = "<"
+		}
+
+		parts = append(parts, term.expr+" "+op+" "+values[i])
+		alternatives = append(alternatives, "("+strings.Join(parts, " AND ")+")")
+	}
+
+	b.where("(" + strings.Join(alternatives, " OR ") + ")")
+}
+
+func orderClause(terms []orderTerm, reverse bool) string {
+	parts := make([]string, 0, len(terms))
+
+	for _, term := range terms {
+		if term.desc != reverse {
+			parts = append(parts, term.expr+" DESC")
+		} else {
+			parts = append(parts, term.expr)
+		}
+	}
+
+	return " ORDER BY " + strings.Join(parts, ", ")
+}
+
+// keysColumns selects the order expressions as text so a cursor can be built from any row.
+func keysColumns(terms []orderTerm) string {
+	columns := make([]string, 0, len(terms))
+
+	for _, term := range terms {
+		columns = append(columns, term.expr+"::text")
+	}
+
+	return strings.Join(columns, ", ")
+}
+
+func newSongsQueryBuilder(filter *domain.SongFilter) *songsQueryBuilder {
+	b := &songsQueryBuilder{}
+
+	if !filter.IncludeDeleted {
+		b.where("s.deleted_at IS NULL")
+	}
+
+	b.matchArtists(filter.GroupID, filter.Groups, filter.Role, filter.Match)
+
+	if filter.Song != "" {
+		b.match("s.song_name", filter.Match, filter.Song)
+	}
+
+	if filter.Album != "" {
+		b.match("a.title", filter.Match, filter.Album)
+	}
+
+	if filter.Text != "" {
+		b.match("s.text", filter.Match, filter.Text)
+	}
+
+	if filter.Link != "" {
+		b.where("s.link = " + b.arg(filter.Link))
+	}
+
+	if filter.Language != "" {
+		language := b.arg(filter.Language)
+		b.where("(s.language = " + language + " OR s.language LIKE " + language + " || '-%')")
+	}
+
+	if filter.ReleaseDate != nil {
+		b.where("s.release_date = " + b.arg(*filter.ReleaseDate))
+	}
+
+	if filter.ReleasedFrom != nil {
+		b.where("s.release_date >= " + b.arg(*filter.ReleasedFrom))
+	}
+
+	if filter.ReleasedTo != nil {
+		b.where("s.release_date <= " + b.arg(*filter.ReleasedTo))
+	}
+
+	b.matchTags(filter.TagMatch, filter.Tags)
+
+	return b
+}
@@ -0,0 +1,69 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	clientErrors "github.com/mashfeii/songs_library/internal/infrastructure/errors"
+)
+
+// Transactor runs operations spanning several repositories atomically. Repositories called
+// with the context passed to fn work on its transaction, and a nested WithinTransaction or
+// a repository starting its own transaction runs in a savepoint of it.
+type Transactor interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type txKey struct{}
+
+type PoolTransactor struct {
+	Pool *pgxpool.Pool
+}
+
+func NewPoolTransactor(pool *pgxpool.Pool) *PoolTransactor {
+	return &PoolTransactor{Pool: pool}
+}
+
+// WithinTransaction commits when fn succeeds and rolls back when it returns an error, which
+// is returned as is.
+func (t *PoolTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx, err := begin(ctx, t.Pool)
+	if err != nil {
+		return fmt.Errorf("beginning transaction: %w", clientErrors.NewErrDatabase())
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if err := fn(withTx(ctx, tx)); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("committing transaction: %w", clientErrors.NewErrDatabase())
+	}
+
+	return nil
+}
+
+func withTx(ctx context.Context, tx pgx.Tx) context.Context {
+	return context.WithValue(ctx, txKey{}, tx)
+}
+
+// conn returns the transaction carried by ctx, or the pool outside of a transaction.
+func conn(ctx context.Context, pool *pgxpool.Pool) querier {
+	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return tx
+	}
+
+	return pool
+}
+
+// begin starts a transaction on the pool, or a savepoint when ctx carries a transaction.
+func begin(ctx context.Context, pool *pgxpool.Pool) (pgx.Tx, error) {
+	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return tx.Begin(ctx)
+	}
+
+	return pool.Begin(ctx)
+}
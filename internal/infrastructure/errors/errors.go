@@ -47,3 +47,27 @@ func NewErrExternal(err error) error {
 func (e ErrExternal) Error() string {
 	return fmt.Sprintf("external error: %s", e.Err)
 }
+
+type ErrConflict struct {
+	Reason string
+}
+
+func NewErrConflict(reason string) error {
+	return ErrConflict{Reason: reason}
+}
+
+func (e ErrConflict) Error() string {
+	return fmt.Sprintf("conflict: %s", e.Reason)
+}
+
+type ErrPreconditionFailed struct {
+	Reason string
+}
+
+func NewErrPreconditionFailed(reason string) error {
+	return ErrPreconditionFailed{Reason: reason}
+}
+
+func (e ErrPreconditionFailed) Error() string {
+	return fmt.Sprintf("precondition failed: %s", e.Reason)
+}
@@ -0,0 +1,243 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mashfeii/songs_library/internal/application"
+	"github.com/mashfeii/songs_library/internal/domain"
+	"github.com/sirupsen/logrus"
+
+	clientErrors "github.com/mashfeii/songs_library/internal/infrastructure/errors"
+)
+
+// @Summary Get list of albums
+// @Description Retrieve albums ordered by release date with pagination
+// @Tags albums
+// @Accept json
+// @Produce json
+// @Param groupId query int false "Only albums of the group"
+// @Param page query int false "Page number" default(1)
+// @Param size query int false "Page size" default(10)
+// @Success 200 {object} domain.Page[domain.Album] "Albums successfully retrieved"
+// @Failure 500 {object} domain.ErrorResponse "Internal server error"
+// @Router /albums [get]
+func GetAlbums(service application.AlbumsServiceInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		groupID, _ := strconv.Atoi(c.Query("groupId"))
+
+		albums, err := service.GetAlbums(c, groupID, parsePageRequest(c))
+		if err != nil {
+			respondAlbumError(c, err)
+
+			return
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"amount": len(albums.Items),
+			"total":  albums.Total,
+		}).Info("Successfully retrieved albums")
+		c.JSON(http.StatusOK, albums)
+	}
+}
+
+// @Summary Get an album
+// @Description Retrieve an album by ID
+// @Tags albums
+// @Accept json
+// @Produce json
+// @Param id path int true "Album ID"
+// @Success 200 {object} domain.Album "Album successfully retrieved"
+// @Failure 400 {object} domain.ErrorResponse "Invalid request"
+// @Failure 404 {object} domain.ErrorResponse "Album not found"
+// @Failure 500 {object} domain.ErrorResponse "Internal server error"
+// @Router /albums/{id} [get]
+func GetAlbum(service application.AlbumsServiceInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := parseIDParam(c, "Album")
+		if !ok {
+			return
+		}
+
+		album, err := service.GetAlbum(c, id)
+		if err != nil {
+			respondAlbumError(c, err)
+
+			return
+		}
+
+		c.JSON(http.StatusOK, album)
+	}
+}
+
+// @Summary Get album tracks
+// @Description Retrieve songs of an album in track order
+// @Tags albums
+// @Accept json
+// @Produce json
+// @Param id path int true "Album ID"
+// @Success 200 {array} domain.Song "Album tracks"
+// @Failure 400 {object} domain.ErrorResponse "Invalid request"
+// @Failure 404 {object} domain.ErrorResponse "Album not found"
+// @Failure 500 {object} domain.ErrorResponse "Internal server error"
+// @Router /albums/{id}/tracks [get]
+func GetAlbumTracks(service application.AlbumsServiceInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := parseIDParam(c, "Album")
+		if !ok {
+			return
+		}
+
+		tracks, err := service.GetAlbumTracks(c, id)
+		if err != nil {
+			respondAlbumError(c, err)
+
+			return
+		}
+
+		c.JSON(http.StatusOK, tracks)
+	}
+}
+
+// @Summary Add an album
+// @Description Create an album of a group, the group is created when missing
+// @Tags albums
+// @Accept json
+// @Produce json
+// @Param album body domain.AlbumRequest true "Album data"
+// @Success 201 {object} domain.Album "Created album"
+// @Failure 400 {object} domain.ErrorResponse "Invalid request"
+// @Failure 409 {object} domain.ErrorResponse "Album already exists"
+// @Failure 500 {object} domain.ErrorResponse "Internal server error"
+// @Router /albums [post]
+func AddAlbum(service application.AlbumsServiceInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req domain.AlbumRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{
+				Code:    http.StatusBadRequest,
+				Message: "Invalid request",
+				Details: "Album group and title are required",
+			})
+
+			return
+		}
+
+		album, err := service.AddAlbum(c, &req)
+		if err != nil {
+			respondAlbumError(c, err)
+
+			return
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"id": album.ID,
+		}).Info("Successfully added album")
+		c.JSON(http.StatusCreated, album)
+	}
+}
+
+// @Summary Update an album
+// @Description Update an album by ID
+// @Tags albums
+// @Accept json
+// @Produce json
+// @Param id path int true "Album ID"
+// @Param album body domain.AlbumRequest true "Album data"
+// @Success 200 {object} domain.Album "Updated album"
+// @Failure 400 {object} domain.ErrorResponse "Invalid request"
+// @Failure 404 {object} domain.ErrorResponse "Album not found"
+// @Failure 409 {object} domain.ErrorResponse "Album already exists"
+// @Failure 500 {object} domain.ErrorResponse "Internal server error"
+// @Router /albums/{id} [put]
+func UpdateAlbum(service application.AlbumsServiceInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := parseIDParam(c, "Album")
+		if !ok {
+			return
+		}
+
+		var req domain.AlbumRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{
+				Code:    http.StatusBadRequest,
+				Message: "Invalid request",
+				Details: "Album group and title are required",
+			})
+
+			return
+		}
+
+		album, err := service.UpdateAlbum(c, id, &req)
+		if err != nil {
+			respondAlbumError(c, err)
+
+			return
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"album": album,
+		}).Info("Successfully updated album")
+		c.JSON(http.StatusOK, album)
+	}
+}
+
+// @Summary Delete an album
+// @Description Delete an album by ID, its songs are kept and detached from the album
+// @Tags albums
+// @Accept json
+// @Produce json
+// @Param id path int true "Album ID"
+// @Success 204 "Album successfully removed"
+// @Failure 400 {object} domain.ErrorResponse "Invalid request"
+// @Failure 404 {object} domain.ErrorResponse "Album not found"
+// @Failure 500 {object} domain.ErrorResponse "Internal server error"
+// @Router /albums/{id} [delete]
+func DeleteAlbum(service application.AlbumsServiceInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := parseIDParam(c, "Album")
+		if !ok {
+			return
+		}
+
+		if err := service.DeleteAlbum(c, id); err != nil {
+			respondAlbumError(c, err)
+
+			return
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"id": id,
+		}).Info("Successfully removed album")
+		c.Status(http.StatusNoContent)
+	}
+}
+
+func respondAlbumError(c *gin.Context, err error) {
+	switch err := err.(type) {
+	case clientErrors.ErrNotFound:
+		c.JSON(http.StatusNotFound, domain.ErrorResponse{
+			Code:    http.StatusNotFound,
+			Message: "Album not found",
+		})
+	case clientErrors.ErrInvalidInput:
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request",
+			Details: err.Error(),
+		})
+	case clientErrors.ErrConflict:
+		c.JSON(http.StatusConflict, domain.ErrorResponse{
+			Code:    http.StatusConflict,
+			Message: "Conflict",
+			Details: err.Reason,
+		})
+	default:
+		logrus.WithField("error", err).Error("Album request failed")
+		c.JSON(http.StatusInternalServerError, domain.ErrorResponse{
+			Code:    http.StatusInternalServerError,
+			Message: "Internal server error",
+		})
+	}
+}
@@ -0,0 +1,229 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mashfeii/songs_library/internal/application"
+	"github.com/mashfeii/songs_library/internal/domain"
+	"github.com/sirupsen/logrus"
+
+	clientErrors "github.com/mashfeii/songs_library/internal/infrastructure/errors"
+)
+
+// @Summary Get song annotations
+// @Description Retrieve annotations of a song ordered by their first line, stale ones point at lines changed since
+// @Tags annotations
+// @Produce json
+// @Param id path int true "Song ID"
+// @Success 200 {array} domain.Annotation "Annotations successfully retrieved"
+// @Failure 400 {object} domain.ErrorResponse "Invalid request"
+// @Failure 404 {object} domain.ErrorResponse "Song not found"
+// @Failure 500 {object} domain.ErrorResponse "Internal server error"
+// @Router /songs/{id}/annotations [get]
+func GetAnnotations(service application.AnnotationsServiceInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := parseIDParam(c, "Song")
+		if !ok {
+			return
+		}
+
+		annotations, err := service.GetAnnotations(c, id)
+		if err != nil {
+			respondAnnotationError(c, err)
+
+			return
+		}
+
+		c.JSON(http.StatusOK, annotations)
+	}
+}
+
+// @Summary Get a song annotation
+// @Description Retrieve an annotation of a song
+// @Tags annotations
+// @Produce json
+// @Param id path int true "Song ID"
+// @Param annotationId path int true "Annotation ID"
+// @Success 200 {object} domain.Annotation "Annotation successfully retrieved"
+// @Failure 400 {object} domain.ErrorResponse "Invalid request"
+// @Failure 404 {object} domain.ErrorResponse "Annotation not found"
+// @Failure 500 {object} domain.ErrorResponse "Internal server error"
+// @Router /songs/{id}/annotations/{annotationId} [get]
+func GetAnnotation(service application.AnnotationsServiceInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, annotationID, ok := parseAnnotationParams(c)
+		if !ok {
+			return
+		}
+
+		annotation, err := service.GetAnnotation(c, id, annotationID)
+		if err != nil {
+			respondAnnotationError(c, err)
+
+			return
+		}
+
+		c.JSON(http.StatusOK, annotation)
+	}
+}
+
+// @Summary Add a song annotation
+// @Description Annotate lines startLine to endLine of a song, numbered from 1 as in verses, endLine defaults to startLine
+// @Tags annotations
+// @Accept json
+// @Produce json
+// @Param id path int true "Song ID"
+// @Param X-Author header string false "Author of the annotation"
+// @Param request body domain.AnnotationRequest true "Annotated lines and text"
+// @Success 201 {object} domain.Annotation "Annotation successfully added"
+// @Failure 400 {object} domain.ErrorResponse "Invalid request"
+// @Failure 404 {object} domain.ErrorResponse "Song not found"
+// @Failure 500 {object} domain.ErrorResponse "Internal server error"
+// @Router /songs/{id}/annotations [post]
+func AddAnnotation(service application.AnnotationsServiceInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := parseIDParam(c, "Song")
+		if !ok {
+			return
+		}
+
+		var req domain.AnnotationRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{
+				Code:    http.StatusBadRequest,
+				Message: "Invalid request",
+				Details: "Invalid annotation data",
+			})
+
+			return
+		}
+
+		annotation, err := service.AddAnnotation(c, id, &req)
+		if err != nil {
+			respondAnnotationError(c, err)
+
+			return
+		}
+
+		c.JSON(http.StatusCreated, annotation)
+	}
+}
+
+// @Summary Update a song annotation
+// @Description Replace an annotation and anchor it to the current lines of the song, clearing its stale flag
+// @Tags annotations
+// @Accept json
+// @Produce json
+// @Param id path int true "Song ID"
+// @Param annotationId path int true "Annotation ID"
+// @Param request body domain.AnnotationRequest true "Annotated lines and text"
+// @Success 200 {object} domain.Annotation "Annotation successfully updated"
+// @Failure 400 {object} domain.ErrorResponse "Invalid request"
+// @Failure 404 {object} domain.ErrorResponse "Annotation not found"
+// @Failure 500 {object} domain.ErrorResponse "Internal server error"
+// @Router /songs/{id}/annotations/{annotationId} [put]
+func UpdateAnnotation(service application.AnnotationsServiceInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, annotationID, ok := parseAnnotationParams(c)
+		if !ok {
+			return
+		}
+
+		var req domain.AnnotationRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{
+				Code:    http.StatusBadRequest,
+				Message: "Invalid request",
+				Details: "Invalid annotation data",
+			})
+
+			return
+		}
+
+		annotation, err := service.UpdateAnnotation(c, id, annotationID, &req)
+		if err != nil {
+			respondAnnotationError(c, err)
+
+			return
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"song_id": id,
+			"id":      annotationID,
+		}).Info("Successfully updated annotation")
+		c.JSON(http.StatusOK, annotation)
+	}
+}
+
+// @Summary Delete a song annotation
+// @Description Delete an annotation of a song
+// @Tags annotations
+// @Produce json
+// @Param id path int true "Song ID"
+// @Param annotationId path int true "Annotation ID"
+// @Success 204 "Annotation successfully removed"
+// @Failure 400 {object} domain.ErrorResponse "Invalid request"
+// @Failure 404 {object} domain.ErrorResponse "Annotation not found"
+// @Failure 500 {object} domain.ErrorResponse "Internal server error"
+// @Router /songs/{id}/annotations/{annotationId} [delete]
+func DeleteAnnotation(service application.AnnotationsServiceInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, annotationID, ok := parseAnnotationParams(c)
+		if !ok {
+			return
+		}
+
+		if err := service.DeleteAnnotation(c, id, annotationID); err != nil {
+			respondAnnotationError(c, err)
+
+			return
+		}
+
+		c.Status(http.StatusNoContent)
+	}
+}
+
+func parseAnnotationParams(c *gin.Context) (int, int, bool) {
+	id, ok := parseIDParam(c, "Song")
+	if !ok {
+		return 0, 0, false
+	}
+
+	annotationID, err := strconv.Atoi(c.Param("annotationId"))
+	if err != nil || annotationID < 1 {
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request",
+			Details: "Annotation ID must be a positive integer",
+		})
+
+		return 0, 0, false
+	}
+
+	return id, annotationID, true
+}
+
+func respondAnnotationError(c *gin.Context, err error) {
+	switch err := err.(type) {
+	case clientErrors.ErrNotFound:
+		c.JSON(http.StatusNotFound, domain.ErrorResponse{
+			Code:    http.StatusNotFound,
+			Message: "Not found",
+			Details: err.Error(),
+		})
+	case clientErrors.ErrInvalidInput:
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request",
+			Details: err.Error(),
+		})
+	default:
+		logrus.WithField("error", err).Error("Annotation request failed")
+		c.JSON(http.StatusInternalServerError, domain.ErrorResponse{
+			Code:    http.StatusInternalServerError,
+			Message: "Internal server error",
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package handlers_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mashfeii/songs_library/internal/domain"
+	"github.com/mashfeii/songs_library/internal/infrastructure/handlers"
+	"github.com/mashfeii/songs_library/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	clientErrors "github.com/mashfeii/songs_library/internal/infrastructure/errors"
+)
+
+func TestAddAnnotation(t *testing.T) {
+	mockService := mocks.NewAnnotationsServiceInterfaceMock(t)
+
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Success", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: "1"}}
+		c.Request, _ = http.NewRequest("POST", "/songs/1/annotations",
+			bytes.NewBufferString(`{"startLine":2,"endLine":3,"body":"About the first verse"}`))
+		c.Request.Header.Set("Content-Type", "application/json")
+
+		mockService.On("AddAnnotation", mock.Anything, 1, &domain.AnnotationRequest{
+			StartLine: 2,
+			EndLine:   3,
+			Body:      "About the first verse",
+		}).Return(&domain.Annotation{ID: 7, SongID: 1, StartLine: 2, EndLine: 3}, nil).Once()
+
+		handlers.AddAnnotation(mockService)(c)
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Contains(t, w.Body.String(), `"id":7`)
+	})
+
+	t.Run("MissingStartLine", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: "1"}}
+		c.Request, _ = http.NewRequest("POST", "/songs/1/annotations", bytes.NewBufferString(`{"body":"No lines"}`))
+		c.Request.Header.Set("Content-Type", "application/json")
+
+		handlers.AddAnnotation(mockService)(c)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("OutOfRange", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: "1"}}
+		c.Request, _ = http.NewRequest("POST", "/songs/1/annotations",
+			bytes.NewBufferString(`{"startLine":40,"body":"Too far"}`))
+		c.Request.Header.Set("Content-Type", "application/json")
+
+		mockService.On("AddAnnotation", mock.Anything, 1, mock.Anything).
+			Return(nil, clientErrors.NewErrInvalidInput("endLine")).Once()
+
+		handlers.AddAnnotation(mockService)(c)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestDeleteAnnotation(t *testing.T) {
+	mockService := mocks.NewAnnotationsServiceInterfaceMock(t)
+
+	gin.SetMode(gin.TestMode)
+
+	t.Run("NotFound", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: "1"}, {Key: "annotationId", Value: "9"}}
+		c.Request, _ = http.NewRequest("DELETE", "/songs/1/annotations/9", http.NoBody)
+
+		mockService.On("DeleteAnnotation", mock.Anything, 1, 9).
+			Return(clientErrors.NewErrNotFound("annotation with id: 9")).Once()
+
+		handlers.DeleteAnnotation(mockService)(c)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("InvalidAnnotationID", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: "1"}, {Key: "annotationId", Value: "abc"}}
+		c.Request, _ = http.NewRequest("DELETE", "/songs/1/annotations/abc", http.NoBody)
+
+		handlers.DeleteAnnotation(mockService)(c)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
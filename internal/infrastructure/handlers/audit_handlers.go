@@ -0,0 +1,128 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mashfeii/songs_library/internal/application"
+	"github.com/mashfeii/songs_library/internal/domain"
+	"github.com/sirupsen/logrus"
+
+	clientErrors "github.com/mashfeii/songs_library/internal/infrastructure/errors"
+)
+
+// @Summary Get audit events
+// @Description Retrieve recorded changes of songs, groups and albums, most recent first
+// @Tags audit
+// @Accept json
+// @Produce json
+// @Param entity query string false "Changed entity" Enums(song, group, album)
+// @Param entityId query int false "ID of the changed entity"
+// @Param actor query string false "Actor who made the change"
+// @Param from query string false "Earliest change time (RFC 3339)"
+// @Param to query string false "Change time the events precede (RFC 3339)"
+// @Param page query int false "Page number" default(1)
+// @Param size query int false "Page size" default(10)
+// @Success 200 {object} domain.Page[domain.AuditEvent] "Audit events successfully retrieved"
+// @Failure 400 {object} domain.ErrorResponse "Invalid request"
+// @Failure 500 {object} domain.ErrorResponse "Internal server error"
+// @Router /audit [get]
+func GetAuditEvents(service application.AuditServiceInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		filter, err := parseAuditFilter(c)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"error":         err,
+				"request_query": c.Request.URL.Query(),
+			}).Error("Failed to parse audit filter")
+
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{
+				Code:    http.StatusBadRequest,
+				Message: "Invalid request",
+				Details: err.Error(),
+			})
+
+			return
+		}
+
+		events, err := service.GetAuditEvents(c, filter, parsePageRequest(c))
+		if err != nil {
+			respondAuditError(c, err)
+
+			return
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"amount": len(events.Items),
+			"total":  events.Total,
+		}).Info("Successfully retrieved audit events")
+		c.JSON(http.StatusOK, events)
+	}
+}
+
+func parseAuditFilter(c *gin.Context) (domain.AuditFilter, error) {
+	filter := domain.AuditFilter{
+		Entity: c.Query("entity"),
+		Actor:  c.Query("actor"),
+	}
+
+	switch filter.Entity {
+	case "", domain.AuditEntitySong, domain.AuditEntityGroup, domain.AuditEntityAlbum:
+	default:
+		return filter, errors.New("entity must be song, group or album")
+	}
+
+	if value := c.Query("entityId"); value != "" {
+		id, err := strconv.Atoi(value)
+		if err != nil || id < 1 {
+			return filter, errors.New("entityId must be a positive integer")
+		}
+
+		filter.EntityID = id
+	}
+
+	times := []struct {
+		param string
+		dest  **time.Time
+	}{
+		{"from", &filter.From},
+		{"to", &filter.To},
+	}
+
+	for _, bound := range times {
+		value := c.Query(bound.param)
+		if value == "" {
+			continue
+		}
+
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return filter, fmt.Errorf("%s must be an RFC 3339 time such as 2024-01-02T15:04:05Z", bound.param)
+		}
+
+		*bound.dest = &parsed
+	}
+
+	return filter, nil
+}
+
+func respondAuditError(c *gin.Context, err error) {
+	switch err := err.(type) {
+	case clientErrors.ErrInvalidInput:
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request",
+			Details: err.Error(),
+		})
+	default:
+		logrus.WithField("error", err).Error("Audit request failed")
+		c.JSON(http.StatusInternalServerError, domain.ErrorResponse{
+			Code:    http.StatusInternalServerError,
+			Message: "Internal server error",
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mashfeii/songs_library/internal/domain"
+	"github.com/mashfeii/songs_library/internal/infrastructure/handlers"
+	"github.com/mashfeii/songs_library/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetAuditEvents(t *testing.T) {
+	mockService := mocks.NewAuditServiceInterfaceMock(t)
+
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Filters", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest("GET",
+			"/audit?entity=song&entityId=4&actor=alice&from=2024-01-01T00:00:00Z&to=2024-02-01T00:00:00Z&size=5",
+			http.NoBody)
+
+		from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		to := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+		entityID := 4
+
+		mockService.On("GetAuditEvents", mock.Anything, domain.AuditFilter{
+			Entity:   domain.AuditEntitySong,
+			EntityID: 4,
+			Actor:    "alice",
+			From:     &from,
+			To:       &to,
+		}, domain.PageRequest{Page: 1, Size: 5}).Return(&domain.Page[domain.AuditEvent]{
+			Items: []domain.AuditEvent{{
+				ID:       1,
+				Actor:    "alice",
+				Action:   domain.AuditActionUpdate,
+				Entity:   domain.AuditEntitySong,
+				EntityID: &entityID,
+				Before:   []byte(`{"song":"Uprsing"}`),
+				After:    []byte(`{"song":"Uprising"}`),
+			}},
+			Total: 1,
+		}, nil).Once()
+
+		handlers.GetAuditEvents(mockService)(c)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"before":{"song":"Uprsing"}`)
+	})
+
+	for _, query := range []string{"entity=lyrics", "entityId=x", "from=yesterday", "to=2024-01-01"} {
+		t.Run("Invalid "+query, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request, _ = http.NewRequest("GET", "/audit?"+query, http.NoBody)
+
+			handlers.GetAuditEvents(mockService)(c)
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+		})
+	}
+}
+
+func TestRequestContext(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	r := gin.New()
+	r.Use(handlers.RequestContext())
+	r.GET("/", func(c *gin.Context) {
+		c.String(http.StatusOK, domain.RequestIDFrom(c.Request.Context()))
+	})
+
+	t.Run("KeepsClientID", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/", http.NoBody)
+		req.Header.Set(handlers.RequestIDHeader, "abc-123")
+
+		r.ServeHTTP(w, req)
+		assert.Equal(t, "abc-123", w.Body.String())
+		assert.Equal(t, "abc-123", w.Header().Get(handlers.RequestIDHeader))
+	})
+
+	t.Run("GeneratesID", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/", http.NoBody)
+
+		r.ServeHTTP(w, req)
+		assert.Len(t, w.Body.String(), 32)
+		assert.Equal(t, w.Body.String(), w.Header().Get(handlers.RequestIDHeader))
+	})
+}
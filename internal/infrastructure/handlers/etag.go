@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mashfeii/songs_library/internal/domain"
+	"github.com/sirupsen/logrus"
+)
+
+// songETag is the entity tag of a song, the version changes with every stored change of it.
+func songETag(version int) string {
+	return `"` + strconv.Itoa(version) + `"`
+}
+
+func setSongETag(c *gin.Context, song *domain.Song) {
+	if song != nil && song.Version > 0 {
+		c.Header("ETag", songETag(song.Version))
+	}
+}
+
+// ifMatchVersion returns the song version required by the If-Match header, zero when the
+// header is missing or "*". Weak or foreign tags never match a song, the request is answered
+// with 412 Precondition Failed and false is returned.
+func ifMatchVersion(c *gin.Context) (int, bool) {
+	header := strings.TrimSpace(c.GetHeader("If-Match"))
+	if header == "" || header == "*" {
+		return 0, true
+	}
+
+	version, err := strconv.Atoi(strings.Trim(header, `"`))
+	if err != nil || version < 1 || header != songETag(version) {
+		logrus.WithFields(logrus.Fields{
+			"if_match": header,
+		}).Error("Failed to parse If-Match header")
+		respondPreconditionFailed(c, "If-Match must hold the ETag of the song")
+
+		return 0, false
+	}
+
+	return version, true
+}
+
+// notModified reports whether the If-None-Match header lists the current tag of the song,
+// tags are compared weakly as for GET requests.
+func notModified(c *gin.Context, song *domain.Song) bool {
+	header := strings.TrimSpace(c.GetHeader("If-None-Match"))
+	if header == "" {
+		return false
+	}
+
+	if header == "*" {
+		return true
+	}
+
+	current := songETag(song.Version)
+
+	for _, tag := range strings.Split(header, ",") {
+		if strings.TrimPrefix(strings.TrimSpace(tag), "W/") == current {
+			return true
+		}
+	}
+
+	return false
+}
+
+func respondPreconditionFailed(c *gin.Context, details string) {
+	c.JSON(http.StatusPreconditionFailed, domain.ErrorResponse{
+		Code:    http.StatusPreconditionFailed,
+		Message: "Precondition failed",
+		Details: details,
+	})
+}
@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mashfeii/songs_library/internal/application"
+	"github.com/mashfeii/songs_library/internal/domain"
+	"github.com/mashfeii/songs_library/internal/infrastructure/musicinfo"
+	"github.com/sirupsen/logrus"
+)
+
+// @Summary Get music info circuit breaker
+// @Description Retrieve the state of the circuit breaker guarding the external music info API
+// @Tags admin
+// @Produce json
+// @Success 200 {object} musicinfo.BreakerSnapshot "Breaker state"
+// @Router /admin/music-info/breaker [get]
+func GetMusicInfoBreaker(reporter musicinfo.BreakerReporter) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.JSON(http.StatusOK, reporter.Breaker())
+	}
+}
+
+// @Summary Get cached music info lookups
+// @Description Retrieve cached answers of the music info API from the most recently fetched one, missing songs are cached with status 404
+// @Tags admin
+// @Produce json
+// @Param group query string false "Only entries of the group, matched case-insensitively"
+// @Param song query string false "Only entries of the song, matched case-insensitively"
+// @Param expired query bool false "Only expired entries" default(false)
+// @Param page query int false "Page number" default(1)
+// @Param size query int false "Page size" default(10)
+// @Success 200 {object} domain.Page[domain.InfoCacheEntry] "Cache entries"
+// @Failure 400 {object} domain.ErrorResponse "Invalid request"
+// @Failure 500 {object} domain.ErrorResponse "Internal server error"
+// @Router /admin/music-info/cache [get]
+func GetInfoCache(service application.InfoCacheServiceInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		filter, ok := parseInfoCacheFilter(c)
+		if !ok {
+			return
+		}
+
+		entries, err := service.GetEntries(c, filter, parsePageRequest(c))
+		if err != nil {
+			respondInfoCacheError(c, err)
+
+			return
+		}
+
+		c.JSON(http.StatusOK, entries)
+	}
+}
+
+// @Summary Purge cached music info lookups
+// @Description Delete cached answers of the music info API matching the filters, every entry when none is given
+// @Tags admin
+// @Produce json
+// @Param group query string false "Only entries of the group, matched case-insensitively"
+// @Param song query string false "Only entries of the song, matched case-insensitively"
+// @Param expired query bool false "Only expired entries" default(false)
+// @Success 200 {object} domain.PurgeInfoCacheResponse "Number of purged entries"
+// @Failure 400 {object} domain.ErrorResponse "Invalid request"
+// @Failure 500 {object} domain.ErrorResponse "Internal server error"
+// @Router /admin/music-info/cache [delete]
+func PurgeInfoCache(service application.InfoCacheServiceInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		filter, ok := parseInfoCacheFilter(c)
+		if !ok {
+			return
+		}
+
+		purged, err := service.Purge(c, filter)
+		if err != nil {
+			respondInfoCacheError(c, err)
+
+			return
+		}
+
+		c.JSON(http.StatusOK, domain.PurgeInfoCacheResponse{Purged: purged})
+	}
+}
+
+func parseInfoCacheFilter(c *gin.Context) (domain.InfoCacheFilter, bool) {
+	expired, err := strconv.ParseBool(c.DefaultQuery("expired", "false"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request",
+			Details: "expired must be a boolean",
+		})
+
+		return domain.InfoCacheFilter{}, false
+	}
+
+	return domain.InfoCacheFilter{
+		Group:   c.Query("group"),
+		Song:    c.Query("song"),
+		Expired: expired,
+	}, true
+}
+
+func respondInfoCacheError(c *gin.Context, err error) {
+	logrus.WithField("error", err).Error("Info cache request failed")
+	c.JSON(http.StatusInternalServerError, domain.ErrorResponse{
+		Code:    http.StatusInternalServerError,
+		Message: "Internal server error",
+	})
+}
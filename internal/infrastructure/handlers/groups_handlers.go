@@ -0,0 +1,429 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mashfeii/songs_library/internal/application"
+	"github.com/mashfeii/songs_library/internal/domain"
+	"github.com/sirupsen/logrus"
+
+	clientErrors "github.com/mashfeii/songs_library/internal/infrastructure/errors"
+)
+
+// @Summary Get list of groups
+// @Description Retrieve groups ordered by name with pagination
+// @Tags groups
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param size query int false "Page size" default(10)
+// @Success 200 {object} domain.Page[domain.Group] "Groups successfully retrieved"
+// @Failure 500 {object} domain.ErrorResponse "Internal server error"
+// @Router /groups [get]
+func GetGroups(service application.GroupsServiceInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		groups, err := service.GetGroups(c, parsePageRequest(c))
+		if err != nil {
+			respondGroupError(c, err)
+
+			return
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"amount": len(groups.Items),
+			"total":  groups.Total,
+		}).Info("Successfully retrieved groups")
+		c.JSON(http.StatusOK, groups)
+	}
+}
+
+// @Summary Get a group
+// @Description Retrieve a group by ID
+// @Tags groups
+// @Accept json
+// @Produce json
+// @Param id path int true "Group ID"
+// @Success 200 {object} domain.Group "Group successfully retrieved"
+// @Failure 400 {object} domain.ErrorResponse "Invalid request"
+// @Failure 404 {object} domain.ErrorResponse "Group not found"
+// @Failure 500 {object} domain.ErrorResponse "Internal server error"
+// @Router /groups/{id} [get]
+func GetGroup(service application.GroupsServiceInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := parseIDParam(c, "Group")
+		if !ok {
+			return
+		}
+
+		group, err := service.GetGroup(c, id)
+		if err != nil {
+			respondGroupError(c, err)
+
+			return
+		}
+
+		c.JSON(http.StatusOK, group)
+	}
+}
+
+// @Summary Get songs of a group
+// @Description Retrieve paginated songs that belong to a group
+// @Tags groups
+// @Accept json
+// @Produce json
+// @Param id path int true "Group ID"
+// @Param page query int false "Page number" default(1)
+// @Param size query int false "Page size" default(10)
+// @Success 200 {object} domain.Page[domain.Song] "Songs successfully retrieved"
+// @Failure 400 {object} domain.ErrorResponse "Invalid request"
+// @Failure 404 {object} domain.ErrorResponse "Group not found"
+// @Failure 500 {object} domain.ErrorResponse "Internal server error"
+// @Router /groups/{id}/songs [get]
+func GetGroupSongs(service application.GroupsServiceInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := parseIDParam(c, "Group")
+		if !ok {
+			return
+		}
+
+		songs, err := service.GetGroupSongs(c, id, parsePageRequest(c))
+		if err != nil {
+			respondGroupError(c, err)
+
+			return
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"id":     id,
+			"amount": len(songs.Items),
+		}).Info("Successfully retrieved group songs")
+		c.JSON(http.StatusOK, songs)
+	}
+}
+
+// @Summary Add a group
+// @Description Create a new group
+// @Tags groups
+// @Accept json
+// @Produce json
+// @Param group body domain.GroupRequest true "Group name"
+// @Success 201 {object} domain.Group "Created group"
+// @Failure 400 {object} domain.ErrorResponse "Invalid request"
+// @Failure 409 {object} domain.ErrorResponse "Group already exists"
+// @Failure 500 {object} domain.ErrorResponse "Internal server error"
+// @Router /groups [post]
+func AddGroup(service application.GroupsServiceInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req domain.GroupRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{
+				Code:    http.StatusBadRequest,
+				Message: "Invalid request",
+				Details: "Group name is required",
+			})
+
+			return
+		}
+
+		group, err := service.AddGroup(c, req.Name)
+		if err != nil {
+			respondGroupError(c, err)
+
+			return
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"id": group.ID,
+		}).Info("Successfully added group")
+		c.JSON(http.StatusCreated, group)
+	}
+}
+
+// @Summary Rename a group
+// @Description Rename a group by ID
+// @Tags groups
+// @Accept json
+// @Produce json
+// @Param id path int true "Group ID"
+// @Param group body domain.GroupRequest true "New group name"
+// @Success 200 {object} domain.Group "Renamed group"
+// @Failure 400 {object} domain.ErrorResponse "Invalid request"
+// @Failure 404 {object} domain.ErrorResponse "Group not found"
+// @Failure 409 {object} domain.ErrorResponse "Group name already taken"
+// @Failure 500 {object} domain.ErrorResponse "Internal server error"
+// @Router /groups/{id} [put]
+func RenameGroup(service application.GroupsServiceInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := parseIDParam(c, "Group")
+		if !ok {
+			return
+		}
+
+		var req domain.GroupRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{
+				Code:    http.StatusBadRequest,
+				Message: "Invalid request",
+				Details: "Group name is required",
+			})
+
+			return
+		}
+
+		group, err := service.RenameGroup(c, id, req.Name)
+		if err != nil {
+			respondGroupError(c, err)
+
+			return
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"group": group,
+		}).Info("Successfully renamed group")
+		c.JSON(http.StatusOK, group)
+	}
+}
+
+// @Summary Delete a group
+// @Description Delete a group by ID, groups with songs require cascade=true which trashes them and removes the group once they are purged
+// @Tags groups
+// @Accept json
+// @Produce json
+// @Param id path int true "Group ID"
+// @Param cascade query bool false "Move the songs of the group to the trash and delete its albums along with it" default(false)
+// @Success 204 "Group successfully removed"
+// @Failure 400 {object} domain.ErrorResponse "Invalid request"
+// @Failure 404 {object} domain.ErrorResponse "Group not found"
+// @Failure 409 {object} domain.ErrorResponse "Group still has songs, trashed songs or albums"
+// @Failure 500 {object} domain.ErrorResponse "Internal server error"
+// @Router /groups/{id} [delete]
+func DeleteGroup(service application.GroupsServiceInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := parseIDParam(c, "Group")
+		if !ok {
+			return
+		}
+
+		cascade, _ := strconv.ParseBool(c.DefaultQuery("cascade", "false"))
+
+		if err := service.DeleteGroup(c, id, cascade); err != nil {
+			respondGroupError(c, err)
+
+			return
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"id":      id,
+			"cascade": cascade,
+		}).Info("Successfully removed group")
+		c.Status(http.StatusNoContent)
+	}
+}
+
+// @Summary Merge groups
+// @Description Move all songs and aliases of the source groups to the target group in one transaction and remove the sources
+// @Tags groups
+// @Accept json
+// @Produce json
+// @Param id path int true "Target group ID"
+// @Param merge body domain.MergeGroupsRequest true "Source group IDs"
+// @Success 200 {object} domain.MergeGroupsResponse "Groups merged"
+// @Failure 400 {object} domain.ErrorResponse "Invalid request"
+// @Failure 404 {object} domain.ErrorResponse "Group not found"
+// @Failure 500 {object} domain.ErrorResponse "Internal server error"
+// @Router /groups/{id}/merge [post]
+func MergeGroups(service application.GroupsServiceInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := parseIDParam(c, "Group")
+		if !ok {
+			return
+		}
+
+		var req domain.MergeGroupsRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{
+				Code:    http.StatusBadRequest,
+				Message: "Invalid request",
+				Details: "At least one source group ID is required",
+			})
+
+			return
+		}
+
+		result, err := service.MergeGroups(c, id, req.Sources)
+		if err != nil {
+			respondGroupError(c, err)
+
+			return
+		}
+
+		c.JSON(http.StatusOK, result)
+	}
+}
+
+// @Summary Get group aliases
+// @Description Retrieve the normalized names that resolve to the group
+// @Tags groups
+// @Accept json
+// @Produce json
+// @Param id path int true "Group ID"
+// @Success 200 {object} domain.GroupAliasesResponse "Group aliases"
+// @Failure 400 {object} domain.ErrorResponse "Invalid request"
+// @Failure 404 {object} domain.ErrorResponse "Group not found"
+// @Failure 500 {object} domain.ErrorResponse "Internal server error"
+// @Router /groups/{id}/aliases [get]
+func GetGroupAliases(service application.GroupsServiceInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := parseIDParam(c, "Group")
+		if !ok {
+			return
+		}
+
+		aliases, err := service.GetGroupAliases(c, id)
+		if err != nil {
+			respondGroupError(c, err)
+
+			return
+		}
+
+		c.JSON(http.StatusOK, domain.GroupAliasesResponse{Aliases: aliases})
+	}
+}
+
+// @Summary Add a group alias
+// @Description Register an alternative name that resolves to the group when adding or filtering songs
+// @Tags groups
+// @Accept json
+// @Produce json
+// @Param id path int true "Group ID"
+// @Param alias body domain.GroupAliasRequest true "Alias"
+// @Success 204 "Alias added"
+// @Failure 400 {object} domain.ErrorResponse "Invalid request"
+// @Failure 404 {object} domain.ErrorResponse "Group not found"
+// @Failure 409 {object} domain.ErrorResponse "Alias belongs to another group"
+// @Failure 500 {object} domain.ErrorResponse "Internal server error"
+// @Router /groups/{id}/aliases [post]
+func AddGroupAlias(service application.GroupsServiceInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := parseIDParam(c, "Group")
+		if !ok {
+			return
+		}
+
+		var req domain.GroupAliasRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{
+				Code:    http.StatusBadRequest,
+				Message: "Invalid request",
+				Details: "Alias is required",
+			})
+
+			return
+		}
+
+		if err := service.AddGroupAlias(c, id, req.Alias); err != nil {
+			respondGroupError(c, err)
+
+			return
+		}
+
+		c.Status(http.StatusNoContent)
+	}
+}
+
+// @Summary Delete a group alias
+// @Description Remove an alias of the group, the alias of the current group name cannot be removed
+// @Tags groups
+// @Accept json
+// @Produce json
+// @Param id path int true "Group ID"
+// @Param alias path string true "Alias"
+// @Success 204 "Alias removed"
+// @Failure 400 {object} domain.ErrorResponse "Invalid request"
+// @Failure 404 {object} domain.ErrorResponse "Alias not found"
+// @Failure 500 {object} domain.ErrorResponse "Internal server error"
+// @Router /groups/{id}/aliases/{alias} [delete]
+func DeleteGroupAlias(service application.GroupsServiceInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := parseIDParam(c, "Group")
+		if !ok {
+			return
+		}
+
+		if err := service.DeleteGroupAlias(c, id, c.Param("alias")); err != nil {
+			respondGroupError(c, err)
+
+			return
+		}
+
+		c.Status(http.StatusNoContent)
+	}
+}
+
+func respondGroupError(c *gin.Context, err error) {
+	switch err := err.(type) {
+	case clientErrors.ErrNotFound:
+		c.JSON(http.StatusNotFound, domain.ErrorResponse{
+			Code:    http.StatusNotFound,
+			Message: "Group not found",
+		})
+	case clientErrors.ErrInvalidInput:
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request",
+			Details: err.Error(),
+		})
+	case clientErrors.ErrConflict:
+		c.JSON(http.StatusConflict, domain.ErrorResponse{
+			Code:    http.StatusConflict,
+			Message: "Conflict",
+			Details: err.Reason,
+		})
+	default:
+		logrus.WithField("error", err).Error("Group request failed")
+		c.JSON(http.StatusInternalServerError, domain.ErrorResponse{
+			Code:    http.StatusInternalServerError,
+			Message: "Internal server error",
+		})
+	}
+}
+
+func parseIDParam(c *gin.Context, entity string) (int, bool) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"error": err,
+			"id":    c.Param("id"),
+		}).Errorf("Failed to parse %s ID", entity)
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request",
+			Details: entity + " ID must be an integer",
+		})
+
+		return 0, false
+	}
+
+	return id, true
+}
+
+func parsePageRequest(c *gin.Context) domain.PageRequest {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	size, _ := strconv.Atoi(c.DefaultQuery("size", "10"))
+
+	if page < 1 {
+		logrus.Warn("page is less than 1, setting to 1")
+
+		page = 1
+	}
+
+	if size < 1 {
+		logrus.Warn("size is less than 1, setting to 10")
+
+		size = 10
+	}
+
+	return domain.PageRequest{Page: page, Size: size}
+}
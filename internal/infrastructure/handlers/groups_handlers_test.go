@@ -0,0 +1,126 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mashfeii/songs_library/internal/domain"
+	"github.com/mashfeii/songs_library/internal/infrastructure/handlers"
+	"github.com/mashfeii/songs_library/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	clientErrors "github.com/mashfeii/songs_library/internal/infrastructure/errors"
+)
+
+func TestAddGroup(t *testing.T) {
+	mockService := mocks.NewGroupsServiceInterfaceMock(t)
+
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Success", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest("POST", "/groups", strings.NewReader(`{"name":"Muse"}`))
+
+		mockService.On("AddGroup", mock.Anything, "Muse").Return(&domain.Group{ID: 1, Name: "Muse"}, nil).Once()
+
+		handlers.AddGroup(mockService)(c)
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.JSONEq(t, `{"id":1,"name":"Muse"}`, w.Body.String())
+	})
+
+	t.Run("Conflict", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest("POST", "/groups", strings.NewReader(`{"name":"Muse"}`))
+
+		mockService.On("AddGroup", mock.Anything, "Muse").Return(nil, clientErrors.NewErrConflict(`group "Muse" already exists`)).Once()
+
+		handlers.AddGroup(mockService)(c)
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("MissingName", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest("POST", "/groups", strings.NewReader(`{}`))
+
+		handlers.AddGroup(mockService)(c)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestDeleteGroup(t *testing.T) {
+	mockService := mocks.NewGroupsServiceInterfaceMock(t)
+
+	gin.SetMode(gin.TestMode)
+
+	t.Run("RefusedWithSongs", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: "1"}}
+		c.Request, _ = http.NewRequest("DELETE", "/groups/1", http.NoBody)
+
+		mockService.On("DeleteGroup", mock.Anything, 1, false).Return(clientErrors.NewErrConflict("group with id 1 has 2 songs")).Once()
+
+		handlers.DeleteGroup(mockService)(c)
+		assert.Equal(t, http.StatusConflict, w.Code)
+		assert.JSONEq(t, `{"code":409,"message":"Conflict","details":"group with id 1 has 2 songs"}`, w.Body.String())
+	})
+
+	t.Run("Cascade", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: "1"}}
+		c.Request, _ = http.NewRequest("DELETE", "/groups/1?cascade=true", http.NoBody)
+
+		mockService.On("DeleteGroup", mock.Anything, 1, true).Return(nil).Once()
+
+		handlers.DeleteGroup(mockService)(c)
+		assert.Equal(t, http.StatusNoContent, c.Writer.Status())
+	})
+
+	t.Run("InvalidID", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: "abc"}}
+		c.Request, _ = http.NewRequest("DELETE", "/groups/abc", http.NoBody)
+
+		handlers.DeleteGroup(mockService)(c)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestMergeGroups(t *testing.T) {
+	mockService := mocks.NewGroupsServiceInterfaceMock(t)
+
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Success", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: "1"}}
+		c.Request, _ = http.NewRequest("POST", "/groups/1/merge", strings.NewReader(`{"sources":[2,3]}`))
+
+		mockService.On("MergeGroups", mock.Anything, 1, []int{2, 3}).
+			Return(&domain.MergeGroupsResponse{Group: domain.Group{ID: 1, Name: "The Beatles"}, MovedSongs: 4}, nil).Once()
+
+		handlers.MergeGroups(mockService)(c)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"group":{"id":1,"name":"The Beatles"},"movedSongs":4}`, w.Body.String())
+	})
+
+	t.Run("NoSources", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: "1"}}
+		c.Request, _ = http.NewRequest("POST", "/groups/1/merge", strings.NewReader(`{"sources":[]}`))
+
+		handlers.MergeGroups(mockService)(c)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
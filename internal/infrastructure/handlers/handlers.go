@@ -1,6 +1,8 @@
 package handlers
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
 	"time"
@@ -18,14 +20,26 @@ import (
 // @Tags songs
 // @Accept json
 // @Produce json
-// @Param group query string false "Filter by group"
+// @Param group query []string false "Filter by group, repeat to match any of several groups" collectionFormat(multi)
+// @Param role query string false "Only match groups credited with the role, any role by default" Enums(primary, featured, composer, producer)
 // @Param song query string false "Filter by song"
-// @Param song query string false "Filter by text"
-// @Param song query string false "Filter by link"
-// @Param page query int false "Page number" default(1)
+// @Param album query string false "Filter by album title"
+// @Param text query string false "Filter by text"
+// @Param link query string false "Filter by link"
+// @Param language query string false "Filter by original language, a base code such as pt also matches pt-br"
+// @Param releaseDate query string false "Filter by exact release date (YYYY-MM-DD)"
+// @Param releasedFrom query string false "Released on or after the date (YYYY-MM-DD)"
+// @Param releasedTo query string false "Released on or before the date (YYYY-MM-DD)"
+// @Param tag query []string false "Filter by genre or tag, repeat to match several tags" collectionFormat(multi)
+// @Param tagMatch query string false "Whether songs must carry any or all of the tags" Enums(any, all) default(any)
+// @Param sort query string false "Comma separated sort keys (id, group_name, song_name, release_date), prefix with - for descending"
+// @Param match query string false "Matching mode for group, song, album and text filters" Enums(exact, prefix, fuzzy) default(exact)
+// @Param page query int false "Page number, ignored when a cursor is given" default(1)
 // @Param size query int false "Page size" default(10)
-// @Success 200 {array} domain.Song "Songs successfully retrieved"
-// @Failure 404 {object} domain.ErrorResponse "No songs found"
+// @Param cursor query string false "Opaque cursor taken from the next or prev field of a previous page"
+// @Param includeDeleted query bool false "Also list songs in the trash" default(false)
+// @Success 200 {object} domain.Page[domain.Song] "Songs successfully retrieved"
+// @Failure 400 {object} domain.ErrorResponse "Invalid request"
 // @Failure 500 {object} domain.ErrorResponse "Internal server error"
 // @Router /songs [get]
 func GetSongs(service application.SongsServiceInterface) gin.HandlerFunc {
@@ -51,36 +65,47 @@ func GetSongs(service application.SongsServiceInterface) gin.HandlerFunc {
 			size = 10
 		}
 
-		if _, err := time.Parse("2006-01-02", c.Query("releaseDate")); err != nil && c.Query("releaseDate") != "" {
+		filter, err := parseSongFilter(c)
+		if err != nil {
 			logrus.WithFields(logrus.Fields{
-				"error":       err,
-				"releaseDate": c.Query("releaseDate"),
-			}).Error("Failed to parse release date")
+				"error":         err,
+				"request_query": c.Request.URL.Query(),
+			}).Error("Failed to parse songs filter")
 
 			c.JSON(http.StatusBadRequest, domain.ErrorResponse{
 				Code:    http.StatusBadRequest,
 				Message: "Invalid request",
-				Details: "Release date must be in format YYYY-MM-DD",
+				Details: err.Error(),
 			})
 
 			return
 		}
 
-		filters := map[string]string{
-			"group_name":   c.Query("group"),
-			"song_name":    c.Query("song"),
-			"release_date": c.Query("releaseDate"),
-			"text":         c.Query("text"),
-			"link":         c.Query("link"),
+		pageReq := domain.PageRequest{Page: page, Size: size}
+
+		if value := c.Query("cursor"); value != "" {
+			cursor, err := domain.DecodeCursor(value)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, domain.ErrorResponse{
+					Code:    http.StatusBadRequest,
+					Message: "Invalid request",
+					Details: err.Error(),
+				})
+
+				return
+			}
+
+			pageReq.Cursor = cursor
 		}
 
-		songs, err := service.GetSongs(c, filters, page, size)
+		songs, err := service.GetSongs(c, filter, pageReq)
 		if err != nil {
 			switch err.(type) {
-			case clientErrors.ErrNotFound:
-				c.JSON(http.StatusNotFound, domain.ErrorResponse{
-					Code:    http.StatusNotFound,
-					Message: "Songs not found",
+			case clientErrors.ErrInvalidInput:
+				c.JSON(http.StatusBadRequest, domain.ErrorResponse{
+					Code:    http.StatusBadRequest,
+					Message: "Invalid request",
+					Details: err.Error(),
 				})
 			default:
 				c.JSON(http.StatusInternalServerError, domain.ErrorResponse{
@@ -93,23 +118,163 @@ func GetSongs(service application.SongsServiceInterface) gin.HandlerFunc {
 		}
 
 		logrus.WithFields(logrus.Fields{
-			"amount": len(songs),
+			"amount": len(songs.Items),
+			"total":  songs.Total,
 		}).Info("Successfully retrieved songs")
 		c.JSON(http.StatusOK, songs)
 	}
 }
 
+func parseSongFilter(c *gin.Context) (*domain.SongFilter, error) {
+	match, err := domain.ParseMatchMode(c.Query("match"))
+	if err != nil {
+		return nil, errors.New("match mode must be one of exact, prefix or fuzzy")
+	}
+
+	sort, err := domain.ParseSort(c.Query("sort"), domain.SongSortFields)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sort: %w", err)
+	}
+
+	tagMatch, err := domain.ParseTagMatch(c.Query("tagMatch"))
+	if err != nil {
+		return nil, errors.New("tag match must be one of any or all")
+	}
+
+	role, err := domain.ParseArtistRole(c.Query("role"))
+	if err != nil {
+		return nil, errors.New("role must be one of primary, featured, composer or producer")
+	}
+
+	language, err := domain.ParseLanguage(c.Query("language"))
+	if err != nil {
+		return nil, errors.New("language must be a language code such as en or pt-br")
+	}
+
+	includeDeleted, err := strconv.ParseBool(c.DefaultQuery("includeDeleted", "false"))
+	if err != nil {
+		return nil, errors.New("includeDeleted must be a boolean")
+	}
+
+	filter := &domain.SongFilter{
+		Groups:         c.QueryArray("group"),
+		Role:           role,
+		Song:           c.Query("song"),
+		Album:          c.Query("album"),
+		Text:           c.Query("text"),
+		Link:           c.Query("link"),
+		Language:       language,
+		Tags:           c.QueryArray("tag"),
+		TagMatch:       tagMatch,
+		Match:          match,
+		Sort:           sort,
+		IncludeDeleted: includeDeleted,
+	}
+
+	dates := []struct {
+		param string
+		dest  **time.Time
+	}{
+		{"releaseDate", &filter.ReleaseDate},
+		{"releasedFrom", &filter.ReleasedFrom},
+		{"releasedTo", &filter.ReleasedTo},
+	}
+
+	for _, date := range dates {
+		value := c.Query(date.param)
+		if value == "" {
+			continue
+		}
+
+		parsed, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			return nil, fmt.Errorf("%s must be in format YYYY-MM-DD", date.param)
+		}
+
+		*date.dest = &parsed
+	}
+
+	return filter, nil
+}
+
+// @Summary Search songs by lyrics
+// @Description Full-text search over song names and lyrics, ranked by relevance with highlighted snippets
+// @Tags songs
+// @Accept json
+// @Produce json
+// @Param q query string true "Search query (supports quoted phrases, OR and -exclusions)"
+// @Param page query int false "Page number" default(1)
+// @Param size query int false "Page size" default(10)
+// @Success 200 {array} domain.SongSearchResult "Songs matching the query, empty when none match"
+// @Failure 400 {object} domain.ErrorResponse "Invalid request"
+// @Failure 500 {object} domain.ErrorResponse "Internal server error"
+// @Router /songs/search [get]
+func SearchSongs(service application.SongsServiceInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logrus.WithFields(logrus.Fields{
+			"request_method": c.Request.Method,
+			"request_path":   c.Request.URL.Path,
+			"request_query":  c.Request.URL.Query(),
+		}).Debug("Request received")
+
+		page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+		size, _ := strconv.Atoi(c.DefaultQuery("size", "10"))
+
+		if page < 1 {
+			logrus.Warn("page is less than 1, setting to 1")
+
+			page = 1
+		}
+
+		if size < 1 {
+			logrus.Warn("size is less than 1, setting to 10")
+
+			size = 10
+		}
+
+		results, err := service.SearchSongs(c, c.Query("q"), page, size)
+		if err != nil {
+			switch err.(type) {
+			case clientErrors.ErrInvalidInput:
+				c.JSON(http.StatusBadRequest, domain.ErrorResponse{
+					Code:    http.StatusBadRequest,
+					Message: "Invalid request",
+					Details: "Search query must not be empty",
+				})
+			default:
+				c.JSON(http.StatusInternalServerError, domain.ErrorResponse{
+					Code:    http.StatusInternalServerError,
+					Message: "Internal server error",
+				})
+			}
+
+			return
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"amount": len(results),
+		}).Info("Successfully searched songs")
+		c.JSON(http.StatusOK, results)
+	}
+}
+
 // @Summary Get song verses with pagination
-// @Description Retrieve paginated verses of a song by ID
+// @Description Retrieve paginated lines or blank line separated stanzas of a song by ID. Stanzas opened by a
+// @Description "[Chorus]"-style line carry it as label, stanzas repeating an earlier one refer to it by repeatOf.
 // @Tags songs
 // @Accept json
 // @Produce json
 // @Param id path int true "Song ID"
+// @Param unit query string false "Paginate by lines or stanzas" Enums(line, stanza) default(line)
+// @Param collapse query bool false "Omit lines of repeated stanzas such as choruses" default(false)
+// @Param annotations query bool false "Include annotations overlapping each verse" default(false)
+// @Param lang query string false "Return the verses of the translation into the language"
+// @Param sideBySide query bool false "Pair original lines with the translation, requires lang" default(false)
 // @Param page query int false "Page number" default(1)
 // @Param size query int false "Page size" default(10)
 // @Success 200 {object} domain.GetSongVersesResponse "Verses successfully retrieved"
 // @Failure 400 {object} domain.ErrorResponse "Invalid request"
-// @Failure 404 {object} domain.ErrorResponse "No verses found"
+// @Failure 404 {object} domain.ErrorResponse "Song or translation not found"
 // @Failure 500 {object} domain.ErrorResponse "Internal server error"
 // @Router /songs/{id}/verses [get]
 func GetSongVerses(service application.SongsServiceInterface) gin.HandlerFunc {
@@ -130,13 +295,77 @@ func GetSongVerses(service application.SongsServiceInterface) gin.HandlerFunc {
 			size = 10
 		}
 
-		result, err := service.GetSongVerses(c, id, page, size)
+		unit, err := domain.ParseVerseUnit(c.Query("unit"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{
+				Code:    http.StatusBadRequest,
+				Message: "Invalid request",
+				Details: "unit must be one of line or stanza",
+			})
+
+			return
+		}
+
+		collapse, err := strconv.ParseBool(c.DefaultQuery("collapse", "false"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{
+				Code:    http.StatusBadRequest,
+				Message: "Invalid request",
+				Details: "collapse must be a boolean",
+			})
+
+			return
+		}
+
+		annotations, err := strconv.ParseBool(c.DefaultQuery("annotations", "false"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{
+				Code:    http.StatusBadRequest,
+				Message: "Invalid request",
+				Details: "annotations must be a boolean",
+			})
+
+			return
+		}
+
+		language, err := domain.ParseLanguage(c.Query("lang"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{
+				Code:    http.StatusBadRequest,
+				Message: "Invalid request",
+				Details: "lang must be a language code such as en or pt-br",
+			})
+
+			return
+		}
+
+		sideBySide, err := strconv.ParseBool(c.DefaultQuery("sideBySide", "false"))
+		if err != nil || (sideBySide && language == "") {
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{
+				Code:    http.StatusBadRequest,
+				Message: "Invalid request",
+				Details: "sideBySide must be a boolean and requires lang",
+			})
+
+			return
+		}
+
+		result, total, err := service.GetSongVerses(c, id, domain.VersesOptions{
+			Unit:        unit,
+			Collapse:    collapse,
+			Annotations: annotations,
+			Language:    language,
+			SideBySide:  sideBySide,
+			Page:        page,
+			Size:        size,
+		})
 		if err != nil {
 			switch err.(type) {
 			case clientErrors.ErrNotFound:
 				c.JSON(http.StatusNotFound, domain.ErrorResponse{
 					Code:    http.StatusNotFound,
-					Message: "Song not found",
+					Message: "Not found",
+					Details: err.Error(),
 				})
 			case clientErrors.ErrInvalidInput:
 				c.JSON(http.StatusBadRequest, domain.ErrorResponse{
@@ -159,40 +388,93 @@ func GetSongVerses(service application.SongsServiceInterface) gin.HandlerFunc {
 			"request_query": c.Request.URL.Query(),
 		}).Info("Retrieved verses")
 		c.JSON(http.StatusOK, domain.GetSongVersesResponse{
-			Verses: result,
-			Page:   page,
-			Size:   size,
+			Unit:       unit,
+			Language:   language,
+			SideBySide: sideBySide,
+			Verses:     result,
+			Total:      total,
+			Page:       page,
+			Size:       size,
 		})
 	}
 }
 
+// @Summary Get a song
+// @Description Get a song by ID, answers 304 when If-None-Match holds its current ETag
+// @Tags songs
+// @Produce json
+// @Param id path int true "Song ID"
+// @Param If-None-Match header string false "ETag of a cached song"
+// @Success 200 {object} domain.Song "Song"
+// @Header 200 {string} ETag "Song version"
+// @Success 304 "Song not modified"
+// @Failure 400 {object} domain.ErrorResponse "Invalid request"
+// @Failure 404 {object} domain.ErrorResponse "Song not found"
+// @Failure 500 {object} domain.ErrorResponse "Internal server error"
+// @Router /songs/{id} [get]
+func GetSong(service application.SongsServiceInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := parseIDParam(c, "Song")
+		if !ok {
+			return
+		}
+
+		song, err := service.GetSong(c, id)
+		if err != nil {
+			switch err.(type) {
+			case clientErrors.ErrNotFound:
+				c.JSON(http.StatusNotFound, domain.ErrorResponse{
+					Code:    http.StatusNotFound,
+					Message: "Song not found",
+				})
+			default:
+				c.JSON(http.StatusInternalServerError, domain.ErrorResponse{
+					Code:    http.StatusInternalServerError,
+					Message: "Internal server error",
+				})
+			}
+
+			return
+		}
+
+		setSongETag(c, song)
+
+		if notModified(c, song) {
+			c.Status(http.StatusNotModified)
+
+			return
+		}
+
+		c.JSON(http.StatusOK, song)
+	}
+}
+
 // @Summary Delete a song
-// @Description Delete a song by ID
+// @Description Move a song to the trash, with If-Match only while it still has the given ETag
 // @Tags songs
 // @Accept json
 // @Produce json
 // @Param id path int true "Song ID"
+// @Param If-Match header string false "ETag the song must still have"
 // @Success 204 "Song successfully removed"
 // @Failure 400 {object} domain.ErrorResponse "Invalid request"
 // @Failure 404 {object} domain.ErrorResponse "Song not found"
+// @Failure 412 {object} domain.ErrorResponse "Song changed since the given ETag"
 // @Failure 500 {object} domain.ErrorResponse "Internal server error"
 // @Router /songs/{id} [delete]
 func DeleteSong(service application.SongsServiceInterface) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		id, err := strconv.Atoi(c.Param("id"))
-		if err != nil {
-			logrus.WithFields(logrus.Fields{
-				"error": err,
-				"id":    c.Param("id"),
-			}).Error("Failed to parse song ID")
-			c.JSON(http.StatusBadRequest, domain.ErrorResponse{
-				Code:    http.StatusBadRequest,
-				Message: "Invalid request",
-				Details: "Song ID must be an integer",
-			})
+		id, ok := parseIDParam(c, "Song")
+		if !ok {
+			return
+		}
+
+		version, ok := ifMatchVersion(c)
+		if !ok {
+			return
 		}
 
-		err = service.DeleteSong(c, id)
+		err := service.DeleteSong(c, id, version)
 		if err != nil {
 			switch err.(type) {
 			case clientErrors.ErrNotFound:
@@ -200,6 +482,8 @@ func DeleteSong(service application.SongsServiceInterface) gin.HandlerFunc {
 					Code:    http.StatusNotFound,
 					Message: "Songs not found",
 				})
+			case clientErrors.ErrPreconditionFailed:
+				respondPreconditionFailed(c, err.Error())
 			default:
 				c.JSON(http.StatusInternalServerError, domain.ErrorResponse{
 					Code:    http.StatusInternalServerError,
@@ -218,15 +502,19 @@ func DeleteSong(service application.SongsServiceInterface) gin.HandlerFunc {
 }
 
 // @Summary Update a song
-// @Description Update a song by ID
+// @Description Update a song by ID, the previous state stays available as a revision
 // @Tags songs
 // @Accept json
 // @Produce json
 // @Param id path int true "Song ID"
+// @Param X-Author header string false "Author recorded in the revision"
+// @Param If-Match header string false "ETag the song must still have"
 // @Param song body domain.UpdateSongRequest true "Song data"
 // @Success 200 {object} domain.Song "Updated song"
+// @Header 200 {string} ETag "New song version"
 // @Failure 400 {object} domain.ErrorResponse "Invalid request"
 // @Failure 404 {object} domain.ErrorResponse "Song not found"
+// @Failure 412 {object} domain.ErrorResponse "Song changed since the given ETag"
 // @Failure 500 {object} domain.ErrorResponse "Internal server error"
 // @Router /songs/{id} [put]
 func UpdateSong(service application.SongsServiceInterface) gin.HandlerFunc {
@@ -246,6 +534,11 @@ func UpdateSong(service application.SongsServiceInterface) gin.HandlerFunc {
 			return
 		}
 
+		version, ok := ifMatchVersion(c)
+		if !ok {
+			return
+		}
+
 		var song domain.UpdateSongRequest
 		if err := c.BindJSON(&song); err != nil {
 			logrus.WithFields(logrus.Fields{
@@ -267,9 +560,11 @@ func UpdateSong(service application.SongsServiceInterface) gin.HandlerFunc {
 			ReleaseDate: song.ReleaseDate,
 			Text:        song.Text,
 			Link:        song.Link,
+			Language:    song.Language,
+			Version:     version,
 		}
 
-		err = service.UpdateSong(c, &songUpdate)
+		err = service.UpdateSong(c, &songUpdate, song.Reason)
 		if err != nil {
 			switch err.(type) {
 			case clientErrors.ErrNotFound:
@@ -277,6 +572,14 @@ func UpdateSong(service application.SongsServiceInterface) gin.HandlerFunc {
 					Code:    http.StatusNotFound,
 					Message: "Song not found",
 				})
+			case clientErrors.ErrPreconditionFailed:
+				respondPreconditionFailed(c, err.Error())
+			case clientErrors.ErrInvalidInput:
+				c.JSON(http.StatusBadRequest, domain.ErrorResponse{
+					Code:    http.StatusBadRequest,
+					Message: "Invalid request",
+					Details: err.Error(),
+				})
 			default:
 				c.JSON(http.StatusInternalServerError, domain.ErrorResponse{
 					Code:    http.StatusInternalServerError,
@@ -290,18 +593,177 @@ func UpdateSong(service application.SongsServiceInterface) gin.HandlerFunc {
 		logrus.WithFields(logrus.Fields{
 			"song": song,
 		}).Info("Successfully updated song")
+		setSongETag(c, &songUpdate)
+		c.JSON(http.StatusOK, songUpdate)
+	}
+}
+
+// mimeMergePatch is the media type of JSON merge patch documents (RFC 7396).
+const mimeMergePatch = "application/merge-patch+json"
+
+// @Summary Patch a song
+// @Description Update provided song fields with a JSON merge patch (RFC 7396), null clears text, link and language
+// @Tags songs
+// @Accept application/merge-patch+json
+// @Produce json
+// @Param id path int true "Song ID"
+// @Param reason query string false "Reason recorded in the revision"
+// @Param X-Author header string false "Author recorded in the revision"
+// @Param If-Match header string false "ETag the song must still have"
+// @Param patch body domain.SongDocument true "Song fields to change"
+// @Success 200 {object} domain.Song "Updated song"
+// @Header 200 {string} ETag "New song version"
+// @Failure 400 {object} domain.ErrorResponse "Invalid request"
+// @Failure 404 {object} domain.ErrorResponse "Song not found"
+// @Failure 412 {object} domain.ErrorResponse "Song changed since the given ETag"
+// @Failure 415 {object} domain.ErrorResponse "Unsupported media type"
+// @Failure 500 {object} domain.ErrorResponse "Internal server error"
+// @Router /songs/{id} [patch]
+func PatchSong(service application.SongsServiceInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := parseIDParam(c, "Song")
+		if !ok {
+			return
+		}
+
+		if contentType := c.ContentType(); contentType != mimeMergePatch && contentType != gin.MIMEJSON {
+			c.JSON(http.StatusUnsupportedMediaType, domain.ErrorResponse{
+				Code:    http.StatusUnsupportedMediaType,
+				Message: "Unsupported media type",
+				Details: "Patch must be sent as " + mimeMergePatch,
+			})
+
+			return
+		}
+
+		version, ok := ifMatchVersion(c)
+		if !ok {
+			return
+		}
+
+		patch, err := c.GetRawData()
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"error": err,
+			}).Error("Failed to read song patch")
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{
+				Code:    http.StatusBadRequest,
+				Message: "Invalid request",
+				Details: "Invalid song patch",
+			})
+
+			return
+		}
+
+		song, err := service.PatchSong(c, id, version, patch, c.Query("reason"))
+		if err != nil {
+			switch err.(type) {
+			case clientErrors.ErrNotFound:
+				c.JSON(http.StatusNotFound, domain.ErrorResponse{
+					Code:    http.StatusNotFound,
+					Message: "Song not found",
+				})
+			case clientErrors.ErrPreconditionFailed:
+				respondPreconditionFailed(c, err.Error())
+			case clientErrors.ErrInvalidInput:
+				c.JSON(http.StatusBadRequest, domain.ErrorResponse{
+					Code:    http.StatusBadRequest,
+					Message: "Invalid request",
+					Details: err.Error(),
+				})
+			default:
+				c.JSON(http.StatusInternalServerError, domain.ErrorResponse{
+					Code:    http.StatusInternalServerError,
+					Message: "Internal server error",
+				})
+			}
+
+			return
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"id": id,
+		}).Info("Successfully patched song")
+		setSongETag(c, song)
+		c.JSON(http.StatusOK, song)
+	}
+}
+
+// @Summary Replace song artists
+// @Description Replace groups credited on a song, the first primary artist becomes the group of the song
+// @Tags songs
+// @Accept json
+// @Produce json
+// @Param id path int true "Song ID"
+// @Param artists body domain.SongArtistsRequest true "Credited groups with their roles"
+// @Success 200 {object} domain.Song "Updated song"
+// @Failure 400 {object} domain.ErrorResponse "Invalid request"
+// @Failure 404 {object} domain.ErrorResponse "Song not found"
+// @Failure 500 {object} domain.ErrorResponse "Internal server error"
+// @Router /songs/{id}/artists [put]
+func SetSongArtists(service application.SongsServiceInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := parseIDParam(c, "Song")
+		if !ok {
+			return
+		}
+
+		var req domain.SongArtistsRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{
+				Code:    http.StatusBadRequest,
+				Message: "Invalid request",
+				Details: "Artists must list at least one group with its role",
+			})
+
+			return
+		}
+
+		song, err := service.SetSongArtists(c, id, &req)
+		if err != nil {
+			switch err.(type) {
+			case clientErrors.ErrNotFound:
+				c.JSON(http.StatusNotFound, domain.ErrorResponse{
+					Code:    http.StatusNotFound,
+					Message: "Song not found",
+				})
+			case clientErrors.ErrInvalidInput:
+				c.JSON(http.StatusBadRequest, domain.ErrorResponse{
+					Code:    http.StatusBadRequest,
+					Message: "Invalid request",
+					Details: "Artists need a primary artist and roles among primary, featured, composer or producer",
+				})
+			default:
+				c.JSON(http.StatusInternalServerError, domain.ErrorResponse{
+					Code:    http.StatusInternalServerError,
+					Message: "Internal server error",
+				})
+			}
+
+			return
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"id":      id,
+			"artists": song.Artists,
+		}).Info("Successfully replaced song artists")
+		setSongETag(c, song)
 		c.JSON(http.StatusOK, song)
 	}
 }
 
 // @Summary Add a song
-// @Description Add a song
+// @Description Add a song, its details are merged from the configured metadata providers.
+// @Description With async=true the song is stored as pending and filled in by a background worker.
 // @Tags songs
 // @Accept json
 // @Produce json
 // @Param song body domain.AddSongRequest true "Song name and group"
+// @Param async query bool false "Enrich the song in the background"
 // @Success 201 {object} domain.AddSongResponse "Song ID"
+// @Success 202 {object} domain.SongStatusResponse "Song queued for enrichment"
 // @Failure 400 {object} domain.ErrorResponse "Invalid request"
+// @Failure 404 {object} domain.ErrorResponse "Song not found by any metadata provider"
 // @Failure 500 {object} domain.ErrorResponse "Internal server error"
 // @Router /songs [post]
 func AddSong(service application.SongsServiceInterface) gin.HandlerFunc {
@@ -312,6 +774,23 @@ func AddSong(service application.SongsServiceInterface) gin.HandlerFunc {
 			return
 		}
 
+		async, err := strconv.ParseBool(c.DefaultQuery("async", "false"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{
+				Code:    http.StatusBadRequest,
+				Message: "Invalid request",
+				Details: "async must be a boolean",
+			})
+
+			return
+		}
+
+		if async {
+			queueSong(c, service, &req)
+
+			return
+		}
+
 		id, err := service.AddSong(c, &req)
 		if err != nil {
 			switch err.(type) {
@@ -320,6 +799,17 @@ func AddSong(service application.SongsServiceInterface) gin.HandlerFunc {
 					Code:    http.StatusInternalServerError,
 					Message: "External API error",
 				})
+			case clientErrors.ErrNotFound:
+				c.JSON(http.StatusNotFound, domain.ErrorResponse{
+					Code:    http.StatusNotFound,
+					Message: "Song not found by any metadata provider",
+				})
+			case clientErrors.ErrInvalidInput:
+				c.JSON(http.StatusBadRequest, domain.ErrorResponse{
+					Code:    http.StatusBadRequest,
+					Message: "Invalid request",
+					Details: err.Error(),
+				})
 			default:
 				c.JSON(http.StatusInternalServerError, domain.ErrorResponse{
 					Code:    http.StatusInternalServerError,
@@ -336,3 +826,80 @@ func AddSong(service application.SongsServiceInterface) gin.HandlerFunc {
 		c.JSON(http.StatusCreated, id)
 	}
 }
+
+func queueSong(c *gin.Context, service application.SongsServiceInterface, req *domain.AddSongRequest) {
+	id, err := service.QueueSong(c, req)
+	if err != nil {
+		switch err.(type) {
+		case clientErrors.ErrInvalidInput:
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{
+				Code:    http.StatusBadRequest,
+				Message: "Invalid request",
+				Details: err.Error(),
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{
+				Code:    http.StatusInternalServerError,
+				Message: "Internal server error",
+			})
+		}
+
+		return
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"id": id,
+	}).Info("Successfully queued song")
+
+	response := songStatusResponse(&domain.Song{ID: id, Status: domain.SongStatusPending})
+	c.Header("Location", response.StatusURL)
+	c.JSON(http.StatusAccepted, response)
+}
+
+// @Summary Get song enrichment status
+// @Description Get whether a song added asynchronously is still pending, enriched or failed
+// @Tags songs
+// @Produce json
+// @Param id path int true "Song ID"
+// @Success 200 {object} domain.SongStatusResponse "Song status"
+// @Failure 400 {object} domain.ErrorResponse "Invalid request"
+// @Failure 404 {object} domain.ErrorResponse "Song not found"
+// @Failure 500 {object} domain.ErrorResponse "Internal server error"
+// @Router /songs/{id}/status [get]
+func GetSongStatus(service application.SongsServiceInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := parseIDParam(c, "Song")
+		if !ok {
+			return
+		}
+
+		song, err := service.GetSongStatus(c, id)
+		if err != nil {
+			switch err.(type) {
+			case clientErrors.ErrNotFound:
+				c.JSON(http.StatusNotFound, domain.ErrorResponse{
+					Code:    http.StatusNotFound,
+					Message: "Song not found",
+				})
+			default:
+				c.JSON(http.StatusInternalServerError, domain.ErrorResponse{
+					Code:    http.StatusInternalServerError,
+					Message: "Internal server error",
+				})
+			}
+
+			return
+		}
+
+		c.JSON(http.StatusOK, songStatusResponse(song))
+	}
+}
+
+func songStatusResponse(song *domain.Song) domain.SongStatusResponse {
+	return domain.SongStatusResponse{
+		ID:        song.ID,
+		Status:    song.Status,
+		Error:     song.StatusError,
+		StatusURL: fmt.Sprintf("/songs/%d/status", song.ID),
+	}
+}
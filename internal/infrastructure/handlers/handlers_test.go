@@ -3,6 +3,7 @@ package handlers_test
 import (
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/gin-gonic/gin"
@@ -17,6 +18,7 @@ import (
 
 func TestGetSongs(t *testing.T) {
 	mockService := mocks.NewSongsServiceInterfaceMock(t)
+	pageReq := domain.PageRequest{Page: 1, Size: 10}
 
 	gin.SetMode(gin.TestMode)
 
@@ -25,35 +27,64 @@ func TestGetSongs(t *testing.T) {
 	c.Request, _ = http.NewRequest("GET", "/songs?page=1&size=10", http.NoBody)
 
 	t.Run("Success", func(t *testing.T) {
-		mockService.On("GetSongs", mock.Anything, mock.Anything, 1, 10).Return([]domain.Song{
-			{ID: 1, Group: "Muse", Song: "Supermassive Black Hole"},
+		mockService.On("GetSongs", mock.Anything, mock.Anything, pageReq).Return(&domain.Page[domain.Song]{
+			Items: []domain.Song{{ID: 1, Group: "Muse", Song: "Supermassive Black Hole"}},
+			Total: 1,
+			Page:  1,
+			Size:  10,
 		}, nil).Once()
 
 		handlers.GetSongs(mockService)(c)
 		assert.Equal(t, http.StatusOK, w.Code)
-		assert.JSONEq(t, `[{"id":1,"group":"Muse","song":"Supermassive Black Hole","release_date":"0001-01-01T00:00:00Z","text":"","link":""}]`, w.Body.String())
+		assert.JSONEq(t, `{"items":[{"id":1,"group":"Muse","song":"Supermassive Black Hole","release_date":"0001-01-01T00:00:00Z",`+
+			`"text":"","link":""}],"total":1,"page":1,"size":10}`, w.Body.String())
 		mockService.AssertExpectations(t)
 	})
 
-	t.Run("NotFound", func(t *testing.T) {
+	t.Run("Empty", func(t *testing.T) {
 		w := httptest.NewRecorder()
 		c, _ := gin.CreateTestContext(w)
 		c.Request, _ = http.NewRequest("GET", "/songs?page=1&size=10", http.NoBody)
 
-		mockService.On("GetSongs", mock.Anything, mock.Anything, 1, 10).Return(nil, clientErrors.NewErrNotFound("songs")).Once()
+		mockService.On("GetSongs", mock.Anything, mock.Anything, pageReq).
+			Return(&domain.Page[domain.Song]{Items: []domain.Song{}, Page: 1, Size: 10}, nil).Once()
 
 		handlers.GetSongs(mockService)(c)
-		assert.Equal(t, http.StatusNotFound, w.Code)
-		assert.JSONEq(t, `{"code":404,"message":"Songs not found"}`, w.Body.String())
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"items":[],"total":0,"page":1,"size":10}`, w.Body.String())
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("Cursor", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		cursor := domain.EncodeCursor(&domain.Cursor{Values: []string{"2006-06-19", "1"}})
+		c.Request, _ = http.NewRequest("GET", "/songs?sort=release_date&cursor="+cursor, http.NoBody)
+
+		mockService.On("GetSongs", mock.Anything, mock.Anything, mock.MatchedBy(func(req domain.PageRequest) bool {
+			return req.Cursor != nil && !req.Cursor.Backward && assert.ObjectsAreEqual([]string{"2006-06-19", "1"}, req.Cursor.Values)
+		})).Return(&domain.Page[domain.Song]{Items: []domain.Song{}, Size: 10}, nil).Once()
+
+		handlers.GetSongs(mockService)(c)
+		assert.Equal(t, http.StatusOK, w.Code)
 		mockService.AssertExpectations(t)
 	})
 
+	t.Run("MalformedCursor", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest("GET", "/songs?cursor=not-a-cursor!", http.NoBody)
+
+		handlers.GetSongs(mockService)(c)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
 	t.Run("InternalServerError", func(t *testing.T) {
 		w := httptest.NewRecorder()
 		c, _ := gin.CreateTestContext(w)
 		c.Request, _ = http.NewRequest("GET", "/songs?page=1&size=10", http.NoBody)
 
-		mockService.On("GetSongs", mock.Anything, mock.Anything, 1, 10).Return(nil, clientErrors.NewErrDatabase()).Once()
+		mockService.On("GetSongs", mock.Anything, mock.Anything, pageReq).Return(nil, clientErrors.NewErrDatabase()).Once()
 
 		handlers.GetSongs(mockService)(c)
 		assert.Equal(t, http.StatusInternalServerError, w.Code)
@@ -61,3 +92,629 @@ func TestGetSongs(t *testing.T) {
 		mockService.AssertExpectations(t)
 	})
 }
+
+func TestSearchSongs(t *testing.T) {
+	mockService := mocks.NewSongsServiceInterfaceMock(t)
+
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Success", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest("GET", "/songs/search?q=suffer", http.NoBody)
+
+		mockService.On("SearchSongs", mock.Anything, "suffer", 1, 10).Return([]domain.SongSearchResult{
+			{Song: domain.Song{ID: 1, Group: "Muse", Song: "Supermassive Black Hole"}, Rank: 0.5, Headline: "I <b>suffer</b>"},
+		}, nil).Once()
+
+		handlers.SearchSongs(mockService)(c)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `[{"id":1,"group":"Muse","song":"Supermassive Black Hole","release_date":"0001-01-01T00:00:00Z",`+
+			`"text":"","link":"","rank":0.5,"headline":"I <b>suffer</b>"}]`, w.Body.String())
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("EmptyQuery", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest("GET", "/songs/search", http.NoBody)
+
+		mockService.On("SearchSongs", mock.Anything, "", 1, 10).Return(nil, clientErrors.NewErrInvalidInput("q")).Once()
+
+		handlers.SearchSongs(mockService)(c)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertExpectations(t)
+	})
+}
+
+func TestGetSongVerses(t *testing.T) {
+	mockService := mocks.NewSongsServiceInterfaceMock(t)
+
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Stanzas", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: "1"}}
+		c.Request, _ = http.NewRequest("GET", "/songs/1/verses?unit=stanza&collapse=true&size=2", http.NoBody)
+
+		mockService.On("GetSongVerses", mock.Anything, 1, domain.VersesOptions{
+			Unit:     domain.VerseUnitStanza,
+			Collapse: true,
+			Page:     1,
+			Size:     2,
+		}).Return([]domain.Verse{
+			{Label: "Chorus", Line: 2, Lines: []string{"Sing along"}},
+			{Label: "Chorus", Line: 5, RepeatOf: 1},
+		}, 3, nil).Once()
+
+		handlers.GetSongVerses(mockService)(c)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"unit":"stanza","verses":[{"label":"Chorus","line":2,"lines":["Sing along"]},`+
+			`{"label":"Chorus","line":5,"repeatOf":1}],"total":3,"page":1,"size":2}`, w.Body.String())
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("UnknownUnit", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: "1"}}
+		c.Request, _ = http.NewRequest("GET", "/songs/1/verses?unit=word", http.NoBody)
+
+		handlers.GetSongVerses(mockService)(c)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("SideBySide", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: "1"}}
+		c.Request, _ = http.NewRequest("GET", "/songs/1/verses?lang=DE&sideBySide=true", http.NoBody)
+
+		mockService.On("GetSongVerses", mock.Anything, 1, domain.VersesOptions{
+			Unit:       domain.VerseUnitLine,
+			Language:   "de",
+			SideBySide: true,
+			Page:       1,
+			Size:       1,
+		}).Return([]domain.Verse{
+			{Line: 1, Lines: []string{"Sing along"}, Translation: []string{"Sing mit"}},
+		}, 2, nil).Once()
+
+		handlers.GetSongVerses(mockService)(c)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"unit":"line","language":"de","sideBySide":true,"verses":[`+
+			`{"line":1,"lines":["Sing along"],"translation":["Sing mit"]}],"total":2,"page":1,"size":1}`, w.Body.String())
+	})
+
+	t.Run("SideBySideWithoutLang", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: "1"}}
+		c.Request, _ = http.NewRequest("GET", "/songs/1/verses?sideBySide=true", http.NoBody)
+
+		handlers.GetSongVerses(mockService)(c)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestGetSongs_MatchMode(t *testing.T) {
+	mockService := mocks.NewSongsServiceInterfaceMock(t)
+
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Fuzzy", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest("GET", "/songs?group=muze&match=fuzzy", http.NoBody)
+
+		mockService.On("GetSongs", mock.Anything, mock.MatchedBy(func(filter *domain.SongFilter) bool {
+			return filter.Match == domain.MatchFuzzy && len(filter.Groups) == 1 && filter.Groups[0] == "muze"
+		}), mock.Anything).Return(&domain.Page[domain.Song]{
+			Items: []domain.Song{{ID: 1, Group: "Muse", Song: "Supermassive Black Hole"}},
+		}, nil).Once()
+
+		handlers.GetSongs(mockService)(c)
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("InvalidMode", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest("GET", "/songs?match=soundex", http.NoBody)
+
+		handlers.GetSongs(mockService)(c)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestGetSongs_Filter(t *testing.T) {
+	mockService := mocks.NewSongsServiceInterfaceMock(t)
+
+	gin.SetMode(gin.TestMode)
+
+	t.Run("RangeSortAndGroups", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest("GET",
+			"/songs?group=Muse&group=Queen&releasedFrom=2000-01-01&releasedTo=2010-12-31&sort=release_date,-song_name", http.NoBody)
+
+		mockService.On("GetSongs", mock.Anything, mock.MatchedBy(func(filter *domain.SongFilter) bool {
+			return assert.ObjectsAreEqual([]string{"Muse", "Queen"}, filter.Groups) &&
+				filter.ReleasedFrom.Year() == 2000 && filter.ReleasedTo.Year() == 2010 &&
+				assert.ObjectsAreEqual([]domain.SortField{{Field: "release_date"}, {Field: "song_name", Desc: true}}, filter.Sort)
+		}), mock.Anything).Return(&domain.Page[domain.Song]{Items: []domain.Song{{ID: 1, Group: "Muse"}}}, nil).Once()
+
+		handlers.GetSongs(mockService)(c)
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("Tags", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest("GET", "/songs?tag=workout&tag=wedding&tagMatch=all", http.NoBody)
+
+		mockService.On("GetSongs", mock.Anything, mock.MatchedBy(func(filter *domain.SongFilter) bool {
+			return assert.ObjectsAreEqual([]string{"workout", "wedding"}, filter.Tags) && filter.TagMatch == domain.TagMatchAll
+		}), mock.Anything).Return(&domain.Page[domain.Song]{Items: []domain.Song{{
+			ID:   1,
+			Tags: []domain.Tag{{ID: 2, Name: "workout", Kind: domain.TagKindTag}},
+		}}}, nil).Once()
+
+		handlers.GetSongs(mockService)(c)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"tags":[{"id":2,"name":"workout","kind":"tag"}]`)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("GroupRole", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest("GET", "/songs?group=Rihanna&role=featured", http.NoBody)
+
+		mockService.On("GetSongs", mock.Anything, mock.MatchedBy(func(filter *domain.SongFilter) bool {
+			return assert.ObjectsAreEqual([]string{"Rihanna"}, filter.Groups) && filter.Role == domain.RoleFeatured
+		}), mock.Anything).Return(&domain.Page[domain.Song]{Items: []domain.Song{{
+			ID:    1,
+			Group: "Eminem",
+			Artists: []domain.SongArtist{
+				{GroupID: 1, Name: "Eminem", Role: domain.RolePrimary},
+				{GroupID: 2, Name: "Rihanna", Role: domain.RoleFeatured},
+			},
+		}}}, nil).Once()
+
+		handlers.GetSongs(mockService)(c)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `{"group_id":2,"name":"Rihanna","role":"featured"}`)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("Language", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest("GET", "/songs?language=pt_BR", http.NoBody)
+
+		mockService.On("GetSongs", mock.Anything, mock.MatchedBy(func(filter *domain.SongFilter) bool {
+			return filter.Language == "pt-br"
+		}), mock.Anything).Return(&domain.Page[domain.Song]{Items: []domain.Song{{ID: 1, Language: "pt-br"}}}, nil).Once()
+
+		handlers.GetSongs(mockService)(c)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"language":"pt-br"`)
+	})
+
+	t.Run("InvalidLanguage", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest("GET", "/songs?language=english!", http.NoBody)
+
+		handlers.GetSongs(mockService)(c)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("UnknownRole", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest("GET", "/songs?group=Rihanna&role=singer", http.NoBody)
+
+		handlers.GetSongs(mockService)(c)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("InvalidTagMatch", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest("GET", "/songs?tag=workout&tagMatch=some", http.NoBody)
+
+		handlers.GetSongs(mockService)(c)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("UnknownSortField", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest("GET", "/songs?sort=password", http.NoBody)
+
+		handlers.GetSongs(mockService)(c)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("InvalidDate", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest("GET", "/songs?releasedFrom=01.01.2000", http.NoBody)
+
+		handlers.GetSongs(mockService)(c)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.JSONEq(t, `{"code":400,"message":"Invalid request","details":"releasedFrom must be in format YYYY-MM-DD"}`, w.Body.String())
+	})
+}
+
+func TestSongRevisions(t *testing.T) {
+	mockService := mocks.NewSongsServiceInterfaceMock(t)
+
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Diff", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: "1"}}
+		c.Request, _ = http.NewRequest("GET", "/songs/1/revisions/diff?from=1&to=2", http.NoBody)
+
+		mockService.On("DiffSongRevisions", mock.Anything, 1, 1, 2).
+			Return("--- revision 1\n+++ revision 2\n@@ -1 +1 @@\n-one\n+two\n", nil).Once()
+
+		handlers.DiffSongRevisions(mockService)(c)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "--- revision 1\n+++ revision 2\n@@ -1 +1 @@\n-one\n+two\n", w.Body.String())
+	})
+
+	t.Run("DiffMissingRevision", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: "1"}}
+		c.Request, _ = http.NewRequest("GET", "/songs/1/revisions/diff?from=1", http.NoBody)
+
+		handlers.DiffSongRevisions(mockService)(c)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("RestoreWithoutBody", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: "1"}, {Key: "rev", Value: "2"}}
+		c.Request, _ = http.NewRequest("POST", "/songs/1/revisions/2/restore", http.NoBody)
+
+		mockService.On("RestoreSongRevision", mock.Anything, 1, 2, "").Return(&domain.Song{ID: 1, Song: "Uprising"}, nil).Once()
+
+		handlers.RestoreSongRevision(mockService)(c)
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("RestoreNotFound", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: "1"}, {Key: "rev", Value: "9"}}
+		c.Request, _ = http.NewRequest("POST", "/songs/1/revisions/9/restore", http.NoBody)
+
+		mockService.On("RestoreSongRevision", mock.Anything, 1, 9, "").Return(nil, clientErrors.NewErrNotFound("revision")).Once()
+
+		handlers.RestoreSongRevision(mockService)(c)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestPatchSong(t *testing.T) {
+	mockService := mocks.NewSongsServiceInterfaceMock(t)
+
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Success", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: "1"}}
+		c.Request, _ = http.NewRequest("PATCH", "/songs/1?reason=typo", strings.NewReader(`{"link":null}`))
+		c.Request.Header.Set("Content-Type", "application/merge-patch+json")
+
+		mockService.On("PatchSong", mock.Anything, 1, 0, []byte(`{"link":null}`), "typo").
+			Return(&domain.Song{ID: 1, Group: "Muse", Song: "Starlight"}, nil).Once()
+
+		handlers.PatchSong(mockService)(c)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"id":1,"group":"Muse","song":"Starlight","release_date":"0001-01-01T00:00:00Z",`+
+			`"text":"","link":""}`, w.Body.String())
+	})
+
+	t.Run("UnsupportedMediaType", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: "1"}}
+		c.Request, _ = http.NewRequest("PATCH", "/songs/1", strings.NewReader(`song=Starlight`))
+		c.Request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		handlers.PatchSong(mockService)(c)
+		assert.Equal(t, http.StatusUnsupportedMediaType, w.Code)
+	})
+
+	t.Run("InvalidField", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: "1"}}
+		c.Request, _ = http.NewRequest("PATCH", "/songs/1", strings.NewReader(`{"song":null}`))
+		c.Request.Header.Set("Content-Type", "application/json")
+
+		mockService.On("PatchSong", mock.Anything, 1, 0, []byte(`{"song":null}`), "").
+			Return(nil, clientErrors.NewErrInvalidInput("song")).Once()
+
+		handlers.PatchSong(mockService)(c)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: "9"}}
+		c.Request, _ = http.NewRequest("PATCH", "/songs/9", strings.NewReader(`{"text":"new"}`))
+		c.Request.Header.Set("Content-Type", "application/merge-patch+json")
+
+		mockService.On("PatchSong", mock.Anything, 9, 0, []byte(`{"text":"new"}`), "").
+			Return(nil, clientErrors.NewErrNotFound("song with id: 9")).Once()
+
+		handlers.PatchSong(mockService)(c)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestSongETags(t *testing.T) {
+	mockService := mocks.NewSongsServiceInterfaceMock(t)
+	song := &domain.Song{ID: 1, Group: "Muse", Song: "Starlight", Version: 3}
+
+	gin.SetMode(gin.TestMode)
+
+	t.Run("GetSetsETag", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: "1"}}
+		c.Request, _ = http.NewRequest("GET", "/songs/1", http.NoBody)
+
+		mockService.On("GetSong", mock.Anything, 1).Return(song, nil).Once()
+
+		handlers.GetSong(mockService)(c)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, `"3"`, w.Header().Get("ETag"))
+	})
+
+	t.Run("GetNotModified", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: "1"}}
+		c.Request, _ = http.NewRequest("GET", "/songs/1", http.NoBody)
+		c.Request.Header.Set("If-None-Match", `"2", W/"3"`)
+
+		mockService.On("GetSong", mock.Anything, 1).Return(song, nil).Once()
+
+		handlers.GetSong(mockService)(c)
+		assert.Equal(t, http.StatusNotModified, c.Writer.Status())
+		assert.Empty(t, w.Body.String())
+	})
+
+	t.Run("UpdatePassesVersion", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: "1"}}
+		c.Request, _ = http.NewRequest("PUT", "/songs/1", strings.NewReader(`{"group":"Muse","song":"Starlight"}`))
+		c.Request.Header.Set("If-Match", `"3"`)
+
+		mockService.On("UpdateSong", mock.Anything, mock.MatchedBy(func(song *domain.Song) bool {
+			return song.Version == 3
+		}), "").Run(func(args mock.Arguments) {
+			args.Get(1).(*domain.Song).Version = 4
+		}).Return(nil).Once()
+
+		handlers.UpdateSong(mockService)(c)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, `"4"`, w.Header().Get("ETag"))
+		assert.Contains(t, w.Body.String(), `"version":4`)
+	})
+
+	t.Run("UpdateStaleVersion", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: "1"}}
+		c.Request, _ = http.NewRequest("PUT", "/songs/1", strings.NewReader(`{"group":"Muse","song":"Starlight"}`))
+		c.Request.Header.Set("If-Match", `"2"`)
+
+		mockService.On("UpdateSong", mock.Anything, mock.Anything, "").
+			Return(clientErrors.NewErrPreconditionFailed("song with id 1 changed since version 2")).Once()
+
+		handlers.UpdateSong(mockService)(c)
+		assert.Equal(t, http.StatusPreconditionFailed, w.Code)
+	})
+
+	t.Run("WeakIfMatchNeverMatches", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: "1"}}
+		c.Request, _ = http.NewRequest("PATCH", "/songs/1", strings.NewReader(`{"text":"new"}`))
+		c.Request.Header.Set("Content-Type", "application/merge-patch+json")
+		c.Request.Header.Set("If-Match", `W/"3"`)
+
+		handlers.PatchSong(mockService)(c)
+		assert.Equal(t, http.StatusPreconditionFailed, w.Code)
+	})
+
+	t.Run("DeletePassesVersion", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: "1"}}
+		c.Request, _ = http.NewRequest("DELETE", "/songs/1", http.NoBody)
+		c.Request.Header.Set("If-Match", `"3"`)
+
+		mockService.On("DeleteSong", mock.Anything, 1, 3).Return(nil).Once()
+
+		handlers.DeleteSong(mockService)(c)
+		assert.Equal(t, http.StatusNoContent, c.Writer.Status())
+	})
+}
+
+func TestTrash(t *testing.T) {
+	mockService := mocks.NewSongsServiceInterfaceMock(t)
+
+	gin.SetMode(gin.TestMode)
+
+	t.Run("ListTrashed", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest("GET", "/trash/songs?page=2&size=5", http.NoBody)
+
+		mockService.On("GetTrashedSongs", mock.Anything, domain.PageRequest{Page: 2, Size: 5}).
+			Return(&domain.Page[domain.Song]{Items: []domain.Song{{ID: 1}}, Total: 6, Page: 2, Size: 5}, nil).Once()
+
+		handlers.GetTrashedSongs(mockService)(c)
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("Restore", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: "1"}}
+		c.Request, _ = http.NewRequest("POST", "/songs/1/restore", http.NoBody)
+
+		mockService.On("RestoreSong", mock.Anything, 1).Return(&domain.Song{ID: 1, Version: 4}, nil).Once()
+
+		handlers.RestoreSong(mockService)(c)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, `"4"`, w.Header().Get("ETag"))
+	})
+
+	t.Run("RestoreNotTrashed", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: "2"}}
+		c.Request, _ = http.NewRequest("POST", "/songs/2/restore", http.NoBody)
+
+		mockService.On("RestoreSong", mock.Anything, 2).Return(nil, clientErrors.NewErrNotFound("trashed song with id: 2")).Once()
+
+		handlers.RestoreSong(mockService)(c)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("IncludeDeleted", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest("GET", "/songs?includeDeleted=true", http.NoBody)
+
+		mockService.On("GetSongs", mock.Anything, mock.MatchedBy(func(filter *domain.SongFilter) bool {
+			return filter.IncludeDeleted
+		}), mock.Anything).Return(&domain.Page[domain.Song]{Items: []domain.Song{}}, nil).Once()
+
+		handlers.GetSongs(mockService)(c)
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("InvalidIncludeDeleted", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest("GET", "/songs?includeDeleted=maybe", http.NoBody)
+
+		handlers.GetSongs(mockService)(c)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestAddSong_Async(t *testing.T) {
+	mockService := mocks.NewSongsServiceInterfaceMock(t)
+	req := &domain.AddSongRequest{Group: "Muse", Song: "Starlight"}
+
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Accepted", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest("POST", "/songs?async=true",
+			strings.NewReader(`{"group":"Muse","song":"Starlight"}`))
+
+		mockService.On("QueueSong", mock.Anything, req).Return(10, nil).Once()
+
+		handlers.AddSong(mockService)(c)
+		assert.Equal(t, http.StatusAccepted, w.Code)
+		assert.Equal(t, "/songs/10/status", w.Header().Get("Location"))
+		assert.JSONEq(t, `{"id":10,"status":"pending","statusUrl":"/songs/10/status"}`, w.Body.String())
+	})
+
+	t.Run("InvalidAsync", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest("POST", "/songs?async=later",
+			strings.NewReader(`{"group":"Muse","song":"Starlight"}`))
+
+		handlers.AddSong(mockService)(c)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestGetSongStatus(t *testing.T) {
+	mockService := mocks.NewSongsServiceInterfaceMock(t)
+
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Failed", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: "10"}}
+		c.Request, _ = http.NewRequest("GET", "/songs/10/status", http.NoBody)
+
+		mockService.On("GetSongStatus", mock.Anything, 10).Return(&domain.Song{
+			ID:          10,
+			Status:      domain.SongStatusFailed,
+			StatusError: "song not found by any metadata provider",
+		}, nil).Once()
+
+		handlers.GetSongStatus(mockService)(c)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"id":10,"status":"failed","error":"song not found by any metadata provider",`+
+			`"statusUrl":"/songs/10/status"}`, w.Body.String())
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: "11"}}
+		c.Request, _ = http.NewRequest("GET", "/songs/11/status", http.NoBody)
+
+		mockService.On("GetSongStatus", mock.Anything, 11).Return(nil, clientErrors.NewErrNotFound("song with id: 11")).Once()
+
+		handlers.GetSongStatus(mockService)(c)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestPurgeInfoCache(t *testing.T) {
+	mockService := mocks.NewInfoCacheServiceInterfaceMock(t)
+
+	gin.SetMode(gin.TestMode)
+
+	t.Run("ExpiredOfGroup", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest("DELETE", "/admin/music-info/cache?group=Muse&expired=true", http.NoBody)
+
+		mockService.On("Purge", mock.Anything, domain.InfoCacheFilter{Group: "Muse", Expired: true}).Return(3, nil).Once()
+
+		handlers.PurgeInfoCache(mockService)(c)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"purged":3}`, w.Body.String())
+	})
+
+	t.Run("InvalidExpired", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest("DELETE", "/admin/music-info/cache?expired=soon", http.NoBody)
+
+		handlers.PurgeInfoCache(mockService)(c)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
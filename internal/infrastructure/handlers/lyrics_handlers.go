@@ -0,0 +1,253 @@
+package handlers
+
+import (
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mashfeii/songs_library/internal/application"
+	"github.com/mashfeii/songs_library/internal/domain"
+	"github.com/sirupsen/logrus"
+
+	clientErrors "github.com/mashfeii/songs_library/internal/infrastructure/errors"
+)
+
+const (
+	// maxLyricsSize limits the size of imported lyrics.
+	maxLyricsSize = 1 << 20
+	// maxNextLines limits the upcoming lines returned for a playback position.
+	maxNextLines = 100
+	// maxLyricsSeconds keeps playback positions within the millisecond range of a line.
+	maxLyricsSeconds = domain.MaxSyncedTimeMs / 1000
+)
+
+// @Summary Get synced lyrics
+// @Description Retrieve time-synced lyrics of a song as JSON or in the LRC format
+// @Tags lyrics
+// @Produce json
+// @Produce plain
+// @Param id path int true "Song ID"
+// @Param format query string false "Response format" Enums(json, lrc) default(json)
+// @Success 200 {object} domain.SyncedLyrics "Synced lyrics"
+// @Failure 400 {object} domain.ErrorResponse "Invalid request"
+// @Failure 404 {object} domain.ErrorResponse "Synced lyrics not found"
+// @Failure 500 {object} domain.ErrorResponse "Internal server error"
+// @Router /songs/{id}/lyrics [get]
+func GetLyrics(service application.LyricsServiceInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := parseIDParam(c, "Song")
+		if !ok {
+			return
+		}
+
+		format, err := domain.ParseLyricsFormat(c.Query("format"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{
+				Code:    http.StatusBadRequest,
+				Message: "Invalid request",
+				Details: "format must be one of json or lrc",
+			})
+
+			return
+		}
+
+		lyrics, err := service.GetLyrics(c, id)
+		if err != nil {
+			respondLyricsError(c, err)
+
+			return
+		}
+
+		if format == domain.LyricsFormatLRC {
+			c.String(http.StatusOK, domain.FormatLRC(lyrics))
+
+			return
+		}
+
+		c.JSON(http.StatusOK, lyrics)
+	}
+}
+
+// @Summary Import synced lyrics
+// @Description Replace synced lyrics of a song with an LRC document, or with JSON when sent as application/json
+// @Tags lyrics
+// @Accept plain
+// @Accept json
+// @Produce json
+// @Param id path int true "Song ID"
+// @Param lyrics body string true "LRC document or domain.SyncedLyrics"
+// @Success 200 {object} domain.SyncedLyrics "Stored lyrics"
+// @Failure 400 {object} domain.ErrorResponse "Invalid request"
+// @Failure 404 {object} domain.ErrorResponse "Song not found"
+// @Failure 500 {object} domain.ErrorResponse "Internal server error"
+// @Router /songs/{id}/lyrics [put]
+func PutLyrics(service application.LyricsServiceInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := parseIDParam(c, "Song")
+		if !ok {
+			return
+		}
+
+		var (
+			lyrics *domain.SyncedLyrics
+			err    error
+		)
+
+		if c.ContentType() == gin.MIMEJSON {
+			var req domain.SyncedLyrics
+			if err := c.ShouldBindJSON(&req); err != nil {
+				c.JSON(http.StatusBadRequest, domain.ErrorResponse{
+					Code:    http.StatusBadRequest,
+					Message: "Invalid request",
+					Details: "Invalid lyrics data",
+				})
+
+				return
+			}
+
+			req.SongID = id
+			lyrics, err = service.SaveLyrics(c, &req)
+		} else {
+			body, readErr := io.ReadAll(io.LimitReader(c.Request.Body, maxLyricsSize))
+			if readErr != nil {
+				c.JSON(http.StatusBadRequest, domain.ErrorResponse{
+					Code:    http.StatusBadRequest,
+					Message: "Invalid request",
+					Details: "Failed to read lyrics",
+				})
+
+				return
+			}
+
+			lyrics, err = service.ImportLRC(c, id, string(body))
+		}
+
+		if err != nil {
+			respondLyricsError(c, err)
+
+			return
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"id":    id,
+			"lines": len(lyrics.Lines),
+		}).Info("Successfully stored synced lyrics")
+		c.JSON(http.StatusOK, lyrics)
+	}
+}
+
+// @Summary Delete synced lyrics
+// @Description Delete synced lyrics of a song, the song text is kept
+// @Tags lyrics
+// @Produce json
+// @Param id path int true "Song ID"
+// @Success 204 "Synced lyrics successfully removed"
+// @Failure 400 {object} domain.ErrorResponse "Invalid request"
+// @Failure 404 {object} domain.ErrorResponse "Synced lyrics not found"
+// @Failure 500 {object} domain.ErrorResponse "Internal server error"
+// @Router /songs/{id}/lyrics [delete]
+func DeleteLyrics(service application.LyricsServiceInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := parseIDParam(c, "Song")
+		if !ok {
+			return
+		}
+
+		if err := service.DeleteLyrics(c, id); err != nil {
+			respondLyricsError(c, err)
+
+			return
+		}
+
+		c.Status(http.StatusNoContent)
+	}
+}
+
+// @Summary Get lyrics at a playback position
+// @Description Retrieve the line active at the playback position followed by the next lines
+// @Tags lyrics
+// @Produce json
+// @Param id path int true "Song ID"
+// @Param t query number true "Playback position in seconds"
+// @Param next query int false "Number of upcoming lines, at most 100" default(3)
+// @Success 200 {object} domain.LyricsPosition "Active and upcoming lines"
+// @Failure 400 {object} domain.ErrorResponse "Invalid request"
+// @Failure 404 {object} domain.ErrorResponse "Synced lyrics not found"
+// @Failure 500 {object} domain.ErrorResponse "Internal server error"
+// @Router /songs/{id}/lyrics/at [get]
+func GetLyricsAt(service application.LyricsServiceInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := parseIDParam(c, "Song")
+		if !ok {
+			return
+		}
+
+		seconds, err := strconv.ParseFloat(c.Query("t"), 64)
+		if err != nil || math.IsNaN(seconds) || seconds < 0 || seconds > maxLyricsSeconds {
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{
+				Code:    http.StatusBadRequest,
+				Message: "Invalid request",
+				Details: "t must be a non-negative number of seconds",
+			})
+
+			return
+		}
+
+		next, err := strconv.Atoi(c.DefaultQuery("next", "3"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{
+				Code:    http.StatusBadRequest,
+				Message: "Invalid request",
+				Details: "next must be a number of lines",
+			})
+
+			return
+		}
+
+		if next < 0 {
+			logrus.Warn("next is less than 0, setting to 3")
+
+			next = 3
+		}
+
+		if next > maxNextLines {
+			logrus.Warn("next is greater than 100, setting to 100")
+
+			next = maxNextLines
+		}
+
+		position, err := service.GetLyricsAt(c, id, int(math.Round(seconds*1000)), next)
+		if err != nil {
+			respondLyricsError(c, err)
+
+			return
+		}
+
+		c.JSON(http.StatusOK, position)
+	}
+}
+
+func respondLyricsError(c *gin.Context, err error) {
+	switch err := err.(type) {
+	case clientErrors.ErrNotFound:
+		c.JSON(http.StatusNotFound, domain.ErrorResponse{
+			Code:    http.StatusNotFound,
+			Message: "Not found",
+			Details: err.Error(),
+		})
+	case clientErrors.ErrInvalidInput:
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request",
+			Details: err.Error(),
+		})
+	default:
+		logrus.WithField("error", err).Error("Lyrics request failed")
+		c.JSON(http.StatusInternalServerError, domain.ErrorResponse{
+			Code:    http.StatusInternalServerError,
+			Message: "Internal server error",
+		})
+	}
+}
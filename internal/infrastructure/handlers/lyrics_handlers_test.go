@@ -0,0 +1,116 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mashfeii/songs_library/internal/domain"
+	"github.com/mashfeii/songs_library/internal/infrastructure/handlers"
+	"github.com/mashfeii/songs_library/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetLyrics(t *testing.T) {
+	mockService := mocks.NewLyricsServiceInterfaceMock(t)
+
+	gin.SetMode(gin.TestMode)
+
+	t.Run("LRC", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: "1"}}
+		c.Request, _ = http.NewRequest("GET", "/songs/1/lyrics?format=lrc", http.NoBody)
+
+		mockService.On("GetLyrics", mock.Anything, 1).Return(&domain.SyncedLyrics{
+			SongID: 1,
+			Tags:   map[string]string{"ar": "Muse"},
+			Lines:  []domain.SyncedLine{{TimeMs: 83400, Text: "Ooh baby"}},
+		}, nil).Once()
+
+		handlers.GetLyrics(mockService)(c)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "[ar:Muse]\n[01:23.40]Ooh baby\n", w.Body.String())
+	})
+
+	t.Run("UnknownFormat", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: "1"}}
+		c.Request, _ = http.NewRequest("GET", "/songs/1/lyrics?format=srt", http.NoBody)
+
+		handlers.GetLyrics(mockService)(c)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestGetLyricsAt(t *testing.T) {
+	mockService := mocks.NewLyricsServiceInterfaceMock(t)
+
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Success", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: "1"}}
+		c.Request, _ = http.NewRequest("GET", "/songs/1/lyrics/at?t=83.5&next=1", http.NoBody)
+
+		mockService.On("GetLyricsAt", mock.Anything, 1, 83500, 1).Return(&domain.LyricsPosition{
+			TimeMs: 83500,
+			Active: &domain.SyncedLine{TimeMs: 83400, Text: "Ooh baby"},
+			Next:   []domain.SyncedLine{{TimeMs: 90000, Text: "Can you hear me moan?"}},
+		}, nil).Once()
+
+		handlers.GetLyricsAt(mockService)(c)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"timeMs":83500,"active":{"timeMs":83400,"text":"Ooh baby"},`+
+			`"next":[{"timeMs":90000,"text":"Can you hear me moan?"}]}`, w.Body.String())
+	})
+
+	t.Run("InvalidPosition", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: "1"}}
+		c.Request, _ = http.NewRequest("GET", "/songs/1/lyrics/at?t=-1", http.NoBody)
+
+		handlers.GetLyricsAt(mockService)(c)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("NaNPosition", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: "1"}}
+		c.Request, _ = http.NewRequest("GET", "/songs/1/lyrics/at?t=NaN", http.NoBody)
+
+		handlers.GetLyricsAt(mockService)(c)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("InvalidNext", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: "1"}}
+		c.Request, _ = http.NewRequest("GET", "/songs/1/lyrics/at?t=1&next=many", http.NoBody)
+
+		handlers.GetLyricsAt(mockService)(c)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("NextClamped", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: "1"}}
+		c.Request, _ = http.NewRequest("GET", "/songs/1/lyrics/at?t=1&next=9223372036854775807", http.NoBody)
+
+		mockService.On("GetLyricsAt", mock.Anything, 1, 1000, 100).Return(&domain.LyricsPosition{
+			TimeMs: 1000,
+			Next:   []domain.SyncedLine{},
+		}, nil).Once()
+
+		handlers.GetLyricsAt(mockService)(c)
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
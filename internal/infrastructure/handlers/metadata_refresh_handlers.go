@@ -0,0 +1,108 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mashfeii/songs_library/internal/application"
+	"github.com/mashfeii/songs_library/internal/domain"
+	"github.com/sirupsen/logrus"
+
+	clientErrors "github.com/mashfeii/songs_library/internal/infrastructure/errors"
+)
+
+// @Summary Run metadata refresh
+// @Description Start looking up stale songs again in the background, applying changed metadata and proposing it for manually edited fields
+// @Tags admin
+// @Produce json
+// @Success 202 {object} domain.RefreshRun "Started run, poll it by ID"
+// @Header 202 {string} Location "URL of the run"
+// @Failure 409 {object} domain.ErrorResponse "A run is in progress"
+// @Failure 500 {object} domain.ErrorResponse "Internal server error"
+// @Router /admin/metadata-refresh/runs [post]
+func RunMetadataRefresh(service application.MetadataRefreshServiceInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		run, err := service.StartRefresh(c.Request.Context())
+		if err != nil {
+			respondMetadataRefreshError(c, err)
+
+			return
+		}
+
+		c.Header("Location", fmt.Sprintf("/admin/metadata-refresh/runs/%d", run.ID))
+		c.JSON(http.StatusAccepted, run)
+	}
+}
+
+// @Summary Get metadata refresh runs
+// @Description Retrieve metadata refresh runs from the newest one with counts of changed, proposed, unchanged and failed songs
+// @Tags admin
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param size query int false "Page size" default(10)
+// @Success 200 {object} domain.Page[domain.RefreshRun] "Runs"
+// @Failure 500 {object} domain.ErrorResponse "Internal server error"
+// @Router /admin/metadata-refresh/runs [get]
+func GetMetadataRefreshRuns(service application.MetadataRefreshServiceInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		runs, err := service.GetRefreshRuns(c, parsePageRequest(c))
+		if err != nil {
+			respondMetadataRefreshError(c, err)
+
+			return
+		}
+
+		c.JSON(http.StatusOK, runs)
+	}
+}
+
+// @Summary Get metadata refresh run
+// @Description Retrieve a metadata refresh run with the values it proposed for manually edited fields
+// @Tags admin
+// @Produce json
+// @Param id path int true "Run ID"
+// @Success 200 {object} domain.RefreshRun "Run"
+// @Failure 400 {object} domain.ErrorResponse "Invalid request"
+// @Failure 404 {object} domain.ErrorResponse "Run not found"
+// @Failure 500 {object} domain.ErrorResponse "Internal server error"
+// @Router /admin/metadata-refresh/runs/{id} [get]
+func GetMetadataRefreshRun(service application.MetadataRefreshServiceInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := parseIDParam(c, "Run")
+		if !ok {
+			return
+		}
+
+		run, err := service.GetRefreshRun(c, id)
+		if err != nil {
+			respondMetadataRefreshError(c, err)
+
+			return
+		}
+
+		c.JSON(http.StatusOK, run)
+	}
+}
+
+func respondMetadataRefreshError(c *gin.Context, err error) {
+	switch err := err.(type) {
+	case clientErrors.ErrNotFound:
+		c.JSON(http.StatusNotFound, domain.ErrorResponse{
+			Code:    http.StatusNotFound,
+			Message: "Run not found",
+		})
+	case clientErrors.ErrConflict:
+		c.JSON(http.StatusConflict, domain.ErrorResponse{
+			Code:    http.StatusConflict,
+			Message: "Conflict",
+			Details: err.Reason,
+		})
+	default:
+		logrus.WithField("error", err).Error("Metadata refresh request failed")
+		c.JSON(http.StatusInternalServerError, domain.ErrorResponse{
+			Code:    http.StatusInternalServerError,
+			Message: "Internal server error",
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mashfeii/songs_library/internal/domain"
+	"github.com/mashfeii/songs_library/internal/infrastructure/handlers"
+	"github.com/mashfeii/songs_library/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	clientErrors "github.com/mashfeii/songs_library/internal/infrastructure/errors"
+)
+
+func TestMetadataRefreshHandlers(t *testing.T) {
+	mockService := mocks.NewMetadataRefreshServiceInterfaceMock(t)
+
+	gin.SetMode(gin.TestMode)
+
+	t.Run("RunInProgress", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest("POST", "/admin/metadata-refresh/runs", http.NoBody)
+
+		mockService.On("StartRefresh", mock.Anything).
+			Return(nil, clientErrors.NewErrConflict("a metadata refresh is already running")).Once()
+
+		handlers.RunMetadataRefresh(mockService)(c)
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("RunStarted", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest("POST", "/admin/metadata-refresh/runs", http.NoBody)
+
+		mockService.On("StartRefresh", mock.Anything).
+			Return(&domain.RefreshRun{ID: 7, Status: domain.RefreshRunRunning}, nil).Once()
+
+		handlers.RunMetadataRefresh(mockService)(c)
+		assert.Equal(t, http.StatusAccepted, w.Code)
+		assert.Equal(t, "/admin/metadata-refresh/runs/7", w.Header().Get("Location"))
+		assert.Contains(t, w.Body.String(), `"status":"running"`)
+	})
+
+	t.Run("GetRun", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: "7"}}
+		c.Request, _ = http.NewRequest("GET", "/admin/metadata-refresh/runs/7", http.NoBody)
+
+		mockService.On("GetRefreshRun", mock.Anything, 7).Return(&domain.RefreshRun{
+			ID:       7,
+			Status:   domain.RefreshRunFinished,
+			Checked:  1,
+			Proposed: 1,
+			Proposals: []domain.MetadataProposal{
+				{ID: 1, RunID: 7, SongID: 2, Field: "text", Current: "My own", Proposed: "Far away"},
+			},
+		}, nil).Once()
+
+		handlers.GetMetadataRefreshRun(mockService)(c)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"proposed":"Far away"`)
+	})
+
+	t.Run("RunNotFound", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: "8"}}
+		c.Request, _ = http.NewRequest("GET", "/admin/metadata-refresh/runs/8", http.NoBody)
+
+		mockService.On("GetRefreshRun", mock.Anything, 8).Return(nil, clientErrors.NewErrNotFound("refresh run with id: 8")).Once()
+
+		handlers.GetMetadataRefreshRun(mockService)(c)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mashfeii/songs_library/internal/domain"
+)
+
+const (
+	// ActorHeader names whoever performs a request, it is recorded with the changes it makes.
+	ActorHeader = "X-Author"
+	// RequestIDHeader carries the ID of a request, one is generated when the client sends none.
+	RequestIDHeader = "X-Request-ID"
+)
+
+// RequestContext stores request metadata in the request context so services can read it.
+// The engine needs ContextWithFallback for handlers passing the gin context along.
+func RequestContext() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		if actor := strings.TrimSpace(c.GetHeader(ActorHeader)); actor != "" {
+			ctx = domain.WithActor(ctx, actor)
+		}
+
+		requestID := strings.TrimSpace(c.GetHeader(RequestIDHeader))
+		if requestID == "" {
+			requestID = newRequestID()
+		}
+
+		c.Header(RequestIDHeader, requestID)
+		ctx = domain.WithRequestID(ctx, requestID)
+
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
+
+func newRequestID() string {
+	id := make([]byte, 16)
+	_, _ = rand.Read(id)
+
+	return hex.EncodeToString(id)
+}
@@ -0,0 +1,215 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mashfeii/songs_library/internal/application"
+	"github.com/mashfeii/songs_library/internal/domain"
+	"github.com/sirupsen/logrus"
+
+	clientErrors "github.com/mashfeii/songs_library/internal/infrastructure/errors"
+)
+
+// @Summary Get song revisions
+// @Description Retrieve revisions of a song from the newest one, without their lyrics
+// @Tags revisions
+// @Accept json
+// @Produce json
+// @Param id path int true "Song ID"
+// @Param page query int false "Page number" default(1)
+// @Param size query int false "Page size" default(10)
+// @Success 200 {object} domain.Page[domain.Revision] "Revisions successfully retrieved"
+// @Failure 400 {object} domain.ErrorResponse "Invalid request"
+// @Failure 404 {object} domain.ErrorResponse "Song not found"
+// @Failure 500 {object} domain.ErrorResponse "Internal server error"
+// @Router /songs/{id}/revisions [get]
+func GetSongRevisions(service application.SongsServiceInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := parseIDParam(c, "Song")
+		if !ok {
+			return
+		}
+
+		revisions, err := service.GetSongRevisions(c, id, parsePageRequest(c))
+		if err != nil {
+			respondRevisionError(c, err)
+
+			return
+		}
+
+		c.JSON(http.StatusOK, revisions)
+	}
+}
+
+// @Summary Get a song revision
+// @Description Retrieve a revision of a song with its lyrics
+// @Tags revisions
+// @Accept json
+// @Produce json
+// @Param id path int true "Song ID"
+// @Param rev path int true "Revision number"
+// @Success 200 {object} domain.Revision "Revision successfully retrieved"
+// @Failure 400 {object} domain.ErrorResponse "Invalid request"
+// @Failure 404 {object} domain.ErrorResponse "Revision not found"
+// @Failure 500 {object} domain.ErrorResponse "Internal server error"
+// @Router /songs/{id}/revisions/{rev} [get]
+func GetSongRevision(service application.SongsServiceInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := parseIDParam(c, "Song")
+		if !ok {
+			return
+		}
+
+		rev, ok := parseRevisionParam(c)
+		if !ok {
+			return
+		}
+
+		revision, err := service.GetSongRevision(c, id, rev)
+		if err != nil {
+			respondRevisionError(c, err)
+
+			return
+		}
+
+		c.JSON(http.StatusOK, revision)
+	}
+}
+
+// @Summary Diff song revisions
+// @Description Retrieve a line-level unified diff of the lyrics between two revisions, empty when they are equal
+// @Tags revisions
+// @Produce plain
+// @Param id path int true "Song ID"
+// @Param from query int true "Revision to diff from"
+// @Param to query int true "Revision to diff to"
+// @Success 200 {string} string "Unified diff"
+// @Failure 400 {object} domain.ErrorResponse "Invalid request"
+// @Failure 404 {object} domain.ErrorResponse "Revision not found"
+// @Failure 500 {object} domain.ErrorResponse "Internal server error"
+// @Router /songs/{id}/revisions/diff [get]
+func DiffSongRevisions(service application.SongsServiceInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := parseIDParam(c, "Song")
+		if !ok {
+			return
+		}
+
+		from, fromErr := strconv.Atoi(c.Query("from"))
+		to, toErr := strconv.Atoi(c.Query("to"))
+
+		if fromErr != nil || toErr != nil {
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{
+				Code:    http.StatusBadRequest,
+				Message: "Invalid request",
+				Details: "from and to must be revision numbers",
+			})
+
+			return
+		}
+
+		diff, err := service.DiffSongRevisions(c, id, from, to)
+		if err != nil {
+			respondRevisionError(c, err)
+
+			return
+		}
+
+		c.String(http.StatusOK, diff)
+	}
+}
+
+// @Summary Restore a song revision
+// @Description Bring a song back to the state of a revision, the restore is recorded as a new revision
+// @Tags revisions
+// @Accept json
+// @Produce json
+// @Param id path int true "Song ID"
+// @Param rev path int true "Revision number"
+// @Param X-Author header string false "Author recorded in the revision"
+// @Param request body domain.RestoreRevisionRequest false "Reason of the restore"
+// @Success 200 {object} domain.Song "Restored song"
+// @Failure 400 {object} domain.ErrorResponse "Invalid request"
+// @Failure 404 {object} domain.ErrorResponse "Revision not found"
+// @Failure 500 {object} domain.ErrorResponse "Internal server error"
+// @Router /songs/{id}/revisions/{rev}/restore [post]
+func RestoreSongRevision(service application.SongsServiceInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := parseIDParam(c, "Song")
+		if !ok {
+			return
+		}
+
+		rev, ok := parseRevisionParam(c)
+		if !ok {
+			return
+		}
+
+		var req domain.RestoreRevisionRequest
+		if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{
+				Code:    http.StatusBadRequest,
+				Message: "Invalid request",
+				Details: "Invalid restore data",
+			})
+
+			return
+		}
+
+		song, err := service.RestoreSongRevision(c, id, rev, req.Reason)
+		if err != nil {
+			respondRevisionError(c, err)
+
+			return
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"id":       id,
+			"revision": rev,
+		}).Info("Successfully restored song revision")
+		setSongETag(c, song)
+		c.JSON(http.StatusOK, song)
+	}
+}
+
+func parseRevisionParam(c *gin.Context) (int, bool) {
+	rev, err := strconv.Atoi(c.Param("rev"))
+	if err != nil || rev < 1 {
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request",
+			Details: "Revision must be a positive integer",
+		})
+
+		return 0, false
+	}
+
+	return rev, true
+}
+
+func respondRevisionError(c *gin.Context, err error) {
+	switch err := err.(type) {
+	case clientErrors.ErrNotFound:
+		c.JSON(http.StatusNotFound, domain.ErrorResponse{
+			Code:    http.StatusNotFound,
+			Message: "Not found",
+			Details: err.Error(),
+		})
+	case clientErrors.ErrInvalidInput:
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request",
+			Details: err.Error(),
+		})
+	default:
+		logrus.WithField("error", err).Error("Revision request failed")
+		c.JSON(http.StatusInternalServerError, domain.ErrorResponse{
+			Code:    http.StatusInternalServerError,
+			Message: "Internal server error",
+		})
+	}
+}
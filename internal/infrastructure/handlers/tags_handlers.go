@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mashfeii/songs_library/internal/application"
+	"github.com/mashfeii/songs_library/internal/domain"
+	"github.com/sirupsen/logrus"
+
+	clientErrors "github.com/mashfeii/songs_library/internal/infrastructure/errors"
+)
+
+// @Summary Get list of tags
+// @Description Retrieve genres and tags with the number of songs carrying each of them
+// @Tags tags
+// @Accept json
+// @Produce json
+// @Param kind query string false "Only tags of the kind" Enums(genre, tag)
+// @Success 200 {array} domain.TagCount "Tags successfully retrieved"
+// @Failure 400 {object} domain.ErrorResponse "Invalid request"
+// @Failure 500 {object} domain.ErrorResponse "Internal server error"
+// @Router /tags [get]
+func GetTags(service application.TagsServiceInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		kind, err := domain.ParseTagKind(c.Query("kind"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{
+				Code:    http.StatusBadRequest,
+				Message: "Invalid request",
+				Details: "kind must be one of genre or tag",
+			})
+
+			return
+		}
+
+		tags, err := service.GetTags(c, kind)
+		if err != nil {
+			respondTagError(c, err)
+
+			return
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"amount": len(tags),
+		}).Info("Successfully retrieved tags")
+		c.JSON(http.StatusOK, tags)
+	}
+}
+
+// @Summary Replace song tags
+// @Description Replace genres and tags of a song, unknown tags are created and an empty body clears them
+// @Tags tags
+// @Accept json
+// @Produce json
+// @Param id path int true "Song ID"
+// @Param tags body domain.SongTagsRequest true "Genres and tags of the song"
+// @Success 200 {array} domain.Tag "Tags of the song"
+// @Failure 400 {object} domain.ErrorResponse "Invalid request"
+// @Failure 404 {object} domain.ErrorResponse "Song not found"
+// @Failure 500 {object} domain.ErrorResponse "Internal server error"
+// @Router /songs/{id}/tags [put]
+func SetSongTags(service application.TagsServiceInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := parseIDParam(c, "Song")
+		if !ok {
+			return
+		}
+
+		var req domain.SongTagsRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{
+				Code:    http.StatusBadRequest,
+				Message: "Invalid request",
+				Details: "Genres and tags must be lists of strings",
+			})
+
+			return
+		}
+
+		tags, err := service.SetSongTags(c, id, &req)
+		if err != nil {
+			respondTagError(c, err)
+
+			return
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"id":   id,
+			"tags": tags,
+		}).Info("Successfully replaced song tags")
+		c.JSON(http.StatusOK, tags)
+	}
+}
+
+func respondTagError(c *gin.Context, err error) {
+	switch err.(type) {
+	case clientErrors.ErrNotFound:
+		c.JSON(http.StatusNotFound, domain.ErrorResponse{
+			Code:    http.StatusNotFound,
+			Message: "Song not found",
+		})
+	default:
+		logrus.WithField("error", err).Error("Tag request failed")
+		c.JSON(http.StatusInternalServerError, domain.ErrorResponse{
+			Code:    http.StatusInternalServerError,
+			Message: "Internal server error",
+		})
+	}
+}
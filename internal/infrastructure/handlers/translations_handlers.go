@@ -0,0 +1,188 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mashfeii/songs_library/internal/application"
+	"github.com/mashfeii/songs_library/internal/domain"
+	"github.com/sirupsen/logrus"
+
+	clientErrors "github.com/mashfeii/songs_library/internal/infrastructure/errors"
+)
+
+// @Summary Get song translations
+// @Description Retrieve translations of a song ordered by language
+// @Tags translations
+// @Produce json
+// @Param id path int true "Song ID"
+// @Success 200 {array} domain.Translation "Translations successfully retrieved"
+// @Failure 400 {object} domain.ErrorResponse "Invalid request"
+// @Failure 404 {object} domain.ErrorResponse "Song not found"
+// @Failure 500 {object} domain.ErrorResponse "Internal server error"
+// @Router /songs/{id}/translations [get]
+func GetTranslations(service application.TranslationsServiceInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := parseIDParam(c, "Song")
+		if !ok {
+			return
+		}
+
+		translations, err := service.GetTranslations(c, id)
+		if err != nil {
+			respondTranslationError(c, err)
+
+			return
+		}
+
+		c.JSON(http.StatusOK, translations)
+	}
+}
+
+// @Summary Get a song translation
+// @Description Retrieve the translation of a song into a language
+// @Tags translations
+// @Produce json
+// @Param id path int true "Song ID"
+// @Param lang path string true "Language code"
+// @Success 200 {object} domain.Translation "Translation successfully retrieved"
+// @Failure 400 {object} domain.ErrorResponse "Invalid request"
+// @Failure 404 {object} domain.ErrorResponse "Translation not found"
+// @Failure 500 {object} domain.ErrorResponse "Internal server error"
+// @Router /songs/{id}/translations/{lang} [get]
+func GetTranslation(service application.TranslationsServiceInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := parseIDParam(c, "Song")
+		if !ok {
+			return
+		}
+
+		translation, err := service.GetTranslation(c, id, c.Param("lang"))
+		if err != nil {
+			respondTranslationError(c, err)
+
+			return
+		}
+
+		c.JSON(http.StatusOK, translation)
+	}
+}
+
+// @Summary Add a song translation
+// @Description Add the translation of a song into a language other than its original one, the translator defaults to X-Author
+// @Tags translations
+// @Accept json
+// @Produce json
+// @Param id path int true "Song ID"
+// @Param X-Author header string false "Translator when none is given"
+// @Param request body domain.TranslationRequest true "Language and translated text"
+// @Success 201 {object} domain.Translation "Translation successfully added"
+// @Failure 400 {object} domain.ErrorResponse "Invalid request"
+// @Failure 404 {object} domain.ErrorResponse "Song not found"
+// @Failure 409 {object} domain.ErrorResponse "Translation already exists"
+// @Failure 500 {object} domain.ErrorResponse "Internal server error"
+// @Router /songs/{id}/translations [post]
+func AddTranslation(service application.TranslationsServiceInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := parseIDParam(c, "Song")
+		if !ok {
+			return
+		}
+
+		var req domain.TranslationRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{
+				Code:    http.StatusBadRequest,
+				Message: "Invalid request",
+				Details: "Invalid translation data",
+			})
+
+			return
+		}
+
+		translation, err := service.AddTranslation(c, id, &req)
+		if err != nil {
+			respondTranslationError(c, err)
+
+			return
+		}
+
+		c.JSON(http.StatusCreated, translation)
+	}
+}
+
+// @Summary Update a song translation
+// @Description Replace the text and translator of a translation
+// @Tags translations
+// @Accept json
+// @Produce json
+// @Param id path int true "Song ID"
+// @Param lang path string true "Language code"
+// @Param X-Author header string false "Translator when none is given"
+// @Param request body domain.UpdateTranslationRequest true "Translated text"
+// @Success 200 {object} domain.Translation "Translation successfully updated"
+// @Failure 400 {object} domain.ErrorResponse "Invalid request"
+// @Failure 404 {object} domain.ErrorResponse "Translation not found"
+// @Failure 500 {object} domain.ErrorResponse "Internal server error"
+// @Router /songs/{id}/translations/{lang} [put]
+func UpdateTranslation(service application.TranslationsServiceInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := parseIDParam(c, "Song")
+		if !ok {
+			return
+		}
+
+		var req domain.UpdateTranslationRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{
+				Code:    http.StatusBadRequest,
+				Message: "Invalid request",
+				Details: "Invalid translation data",
+			})
+
+			return
+		}
+
+		translation, err := service.UpdateTranslation(c, id, c.Param("lang"), &req)
+		if err != nil {
+			respondTranslationError(c, err)
+
+			return
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"id":       id,
+			"language": translation.Language,
+		}).Info("Successfully updated translation")
+		c.JSON(http.StatusOK, translation)
+	}
+}
+
+func respondTranslationError(c *gin.Context, err error) {
+	switch err := err.(type) {
+	case clientErrors.ErrNotFound:
+		c.JSON(http.StatusNotFound, domain.ErrorResponse{
+			Code:    http.StatusNotFound,
+			Message: "Not found",
+			Details: err.Error(),
+		})
+	case clientErrors.ErrInvalidInput:
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request",
+			Details: err.Error(),
+		})
+	case clientErrors.ErrConflict:
+		c.JSON(http.StatusConflict, domain.ErrorResponse{
+			Code:    http.StatusConflict,
+			Message: "Conflict",
+			Details: err.Reason,
+		})
+	default:
+		logrus.WithField("error", err).Error("Translation request failed")
+		c.JSON(http.StatusInternalServerError, domain.ErrorResponse{
+			Code:    http.StatusInternalServerError,
+			Message: "Internal server error",
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package handlers_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mashfeii/songs_library/internal/domain"
+	"github.com/mashfeii/songs_library/internal/infrastructure/handlers"
+	"github.com/mashfeii/songs_library/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	clientErrors "github.com/mashfeii/songs_library/internal/infrastructure/errors"
+)
+
+func TestAddTranslation(t *testing.T) {
+	mockService := mocks.NewTranslationsServiceInterfaceMock(t)
+
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Success", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: "1"}}
+		c.Request, _ = http.NewRequest("POST", "/songs/1/translations",
+			bytes.NewBufferString(`{"language":"de","text":"Sing mit","translator":"Anna"}`))
+		c.Request.Header.Set("Content-Type", "application/json")
+
+		mockService.On("AddTranslation", mock.Anything, 1, &domain.TranslationRequest{
+			Language:   "de",
+			Text:       "Sing mit",
+			Translator: "Anna",
+		}).Return(&domain.Translation{SongID: 1, Language: "de", Text: "Sing mit", Translator: "Anna"}, nil).Once()
+
+		handlers.AddTranslation(mockService)(c)
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Contains(t, w.Body.String(), `"language":"de"`)
+	})
+
+	t.Run("AlreadyExists", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: "1"}}
+		c.Request, _ = http.NewRequest("POST", "/songs/1/translations",
+			bytes.NewBufferString(`{"language":"de","text":"Sing mit"}`))
+		c.Request.Header.Set("Content-Type", "application/json")
+
+		mockService.On("AddTranslation", mock.Anything, 1, mock.Anything).
+			Return(nil, clientErrors.NewErrConflict("de translation already exists")).Once()
+
+		handlers.AddTranslation(mockService)(c)
+		assert.Equal(t, http.StatusConflict, w.Code)
+		assert.Contains(t, w.Body.String(), "de translation already exists")
+	})
+
+	t.Run("MissingText", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: "1"}}
+		c.Request, _ = http.NewRequest("POST", "/songs/1/translations", bytes.NewBufferString(`{"language":"de"}`))
+		c.Request.Header.Set("Content-Type", "application/json")
+
+		handlers.AddTranslation(mockService)(c)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mashfeii/songs_library/internal/application"
+	"github.com/mashfeii/songs_library/internal/domain"
+	"github.com/sirupsen/logrus"
+
+	clientErrors "github.com/mashfeii/songs_library/internal/infrastructure/errors"
+)
+
+// @Summary Get trashed songs
+// @Description Retrieve deleted songs still kept in the trash, most recently deleted first
+// @Tags trash
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param size query int false "Page size" default(10)
+// @Success 200 {object} domain.Page[domain.Song] "Trashed songs successfully retrieved"
+// @Failure 500 {object} domain.ErrorResponse "Internal server error"
+// @Router /trash/songs [get]
+func GetTrashedSongs(service application.SongsServiceInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		songs, err := service.GetTrashedSongs(c, parsePageRequest(c))
+		if err != nil {
+			respondTrashError(c, err)
+
+			return
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"amount": len(songs.Items),
+			"total":  songs.Total,
+		}).Info("Successfully retrieved trashed songs")
+		c.JSON(http.StatusOK, songs)
+	}
+}
+
+// @Summary Restore a trashed song
+// @Description Take a deleted song out of the trash
+// @Tags trash
+// @Accept json
+// @Produce json
+// @Param id path int true "Song ID"
+// @Success 200 {object} domain.Song "Restored song"
+// @Header 200 {string} ETag "New song version"
+// @Failure 400 {object} domain.ErrorResponse "Invalid request"
+// @Failure 404 {object} domain.ErrorResponse "Song not in the trash"
+// @Failure 500 {object} domain.ErrorResponse "Internal server error"
+// @Router /songs/{id}/restore [post]
+func RestoreSong(service application.SongsServiceInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := parseIDParam(c, "Song")
+		if !ok {
+			return
+		}
+
+		song, err := service.RestoreSong(c, id)
+		if err != nil {
+			respondTrashError(c, err)
+
+			return
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"id": id,
+		}).Info("Successfully restored song")
+		setSongETag(c, song)
+		c.JSON(http.StatusOK, song)
+	}
+}
+
+func respondTrashError(c *gin.Context, err error) {
+	switch err := err.(type) {
+	case clientErrors.ErrNotFound:
+		c.JSON(http.StatusNotFound, domain.ErrorResponse{
+			Code:    http.StatusNotFound,
+			Message: "Not found",
+			Details: err.Error(),
+		})
+	default:
+		logrus.WithField("error", err).Error("Trash request failed")
+		c.JSON(http.StatusInternalServerError, domain.ErrorResponse{
+			Code:    http.StatusInternalServerError,
+			Message: "Internal server error",
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package musicinfo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	client "github.com/mashfeii/songs_library/internal/api"
+	"github.com/mashfeii/songs_library/internal/domain"
+	clientErrors "github.com/mashfeii/songs_library/internal/infrastructure/errors"
+)
+
+// APIProviderName identifies the music info API in METADATA_PROVIDERS and in song sources.
+const APIProviderName = "music-info"
+
+// APIProvider is the metadata provider backed by the external music info API.
+type APIProvider struct {
+	client client.ClientWithResponsesInterface
+}
+
+func NewAPIProvider(client client.ClientWithResponsesInterface) *APIProvider {
+	return &APIProvider{client: client}
+}
+
+func (p *APIProvider) Name() string {
+	return APIProviderName
+}
+
+func (p *APIProvider) Lookup(ctx context.Context, group, song string) (*domain.SongMetadata, error) {
+	response, err := p.client.GetInfoWithResponse(ctx, &client.GetInfoParams{
+		Group: group,
+		Song:  song,
+	})
+	if err != nil {
+		return nil, clientErrors.NewErrExternal(err)
+	}
+
+	switch {
+	case response.StatusCode() == http.StatusNotFound:
+		return nil, clientErrors.NewErrNotFound("song info")
+	case response.StatusCode() != http.StatusOK || response.JSON200 == nil:
+		return nil, clientErrors.NewErrExternal(fmt.Errorf("status code: %d", response.StatusCode()))
+	}
+
+	detail := response.JSON200
+	metadata := domain.SongMetadata{
+		ReleaseDate: detail.ReleaseDate.Time,
+		Text:        detail.Text,
+		Link:        detail.Link,
+	}
+
+	if info := detail.Album; info != nil {
+		album := domain.AlbumMetadata{
+			Title:       info.Title,
+			TrackNumber: trackNumber(info.TrackNumber),
+		}
+
+		if info.ReleaseDate != nil {
+			album.ReleaseDate = &info.ReleaseDate.Time
+		}
+
+		if info.CoverLink != nil {
+			album.CoverLink = *info.CoverLink
+		}
+
+		metadata.Album = &album
+	}
+
+	return &metadata, nil
+}
+
+// trackNumber drops track numbers the songs table would reject, providers report zero
+// or negative numbers for tracks with an unknown position.
+func trackNumber(number *int) *int {
+	if number == nil || *number <= 0 {
+		return nil
+	}
+
+	return number
+}
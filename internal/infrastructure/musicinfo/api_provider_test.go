@@ -0,0 +1,46 @@
+package musicinfo_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/mashfeii/songs_library/internal/infrastructure/musicinfo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	clientErrors "github.com/mashfeii/songs_library/internal/infrastructure/errors"
+)
+
+func TestAPIProvider_Lookup(t *testing.T) {
+	opts := musicinfo.Options{Timeout: time.Second, FailureThreshold: 10, Cooldown: time.Minute}
+
+	t.Run("Found", func(t *testing.T) {
+		server, _ := infoServer(t, 0, http.StatusOK)
+		provider := musicinfo.NewAPIProvider(newClient(t, server, opts))
+
+		metadata, err := provider.Lookup(context.Background(), "Muse", "Supermassive Black Hole")
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2006, 7, 16, 0, 0, 0, 0, time.UTC), metadata.ReleaseDate)
+		assert.Equal(t, "https://www.youtube.com/watch?v=Xsp3_a-PMTw", metadata.Link)
+		assert.Nil(t, metadata.Album)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		server, _ := infoServer(t, 0, http.StatusNotFound)
+		provider := musicinfo.NewAPIProvider(newClient(t, server, opts))
+
+		_, err := provider.Lookup(context.Background(), "Muse", "Unknown")
+		assert.True(t, errors.As(err, &clientErrors.ErrNotFound{}))
+	})
+
+	t.Run("ServerError", func(t *testing.T) {
+		server, _ := infoServer(t, 0, http.StatusInternalServerError)
+		provider := musicinfo.NewAPIProvider(newClient(t, server, opts))
+
+		_, err := provider.Lookup(context.Background(), "Muse", "Supermassive Black Hole")
+		assert.True(t, errors.As(err, &clientErrors.ErrExternal{}))
+	})
+}
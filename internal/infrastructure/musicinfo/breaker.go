@@ -0,0 +1,141 @@
+package musicinfo
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// ErrCircuitOpen is returned without calling the provider while the breaker is open.
+var ErrCircuitOpen = errors.New("music info circuit breaker is open")
+
+type BreakerState string
+
+const (
+	// BreakerClosed lets every call through.
+	BreakerClosed BreakerState = "closed"
+	// BreakerOpen fails calls fast until the cooldown elapses.
+	BreakerOpen BreakerState = "open"
+	// BreakerHalfOpen lets a single probe through, its outcome closes or opens the breaker again.
+	BreakerHalfOpen BreakerState = "half-open"
+)
+
+// BreakerSnapshot describes the breaker at a point in time.
+type BreakerSnapshot struct {
+	State               BreakerState `json:"state"`
+	ConsecutiveFailures int          `json:"consecutiveFailures"`
+	Threshold           int          `json:"threshold"`
+	OpenedAt            *time.Time   `json:"openedAt,omitempty"`
+	RetryAt             *time.Time   `json:"retryAt,omitempty"`
+}
+
+// breaker opens after threshold consecutive failures and probes the provider again once the
+// cooldown has elapsed.
+type breaker struct {
+	mu        sync.Mutex
+	threshold int
+	cooldown  time.Duration
+
+	state    BreakerState
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+func newBreaker(threshold int, cooldown time.Duration) *breaker {
+	return &breaker{threshold: threshold, cooldown: cooldown, state: BreakerClosed}
+}
+
+// allow reports whether a call may be made, moving an open breaker to half-open once the
+// cooldown has elapsed.
+func (b *breaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case BreakerOpen:
+		if time.Since(b.openedAt) < b.cooldown {
+			return false
+		}
+
+		b.transition(BreakerHalfOpen)
+		b.probing = true
+
+		return true
+	case BreakerHalfOpen:
+		if b.probing {
+			return false
+		}
+
+		b.probing = true
+
+		return true
+	default:
+		return true
+	}
+}
+
+func (b *breaker) success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures = 0
+	b.probing = false
+
+	if b.state != BreakerClosed {
+		b.transition(BreakerClosed)
+	}
+}
+
+func (b *breaker) failure() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures++
+	b.probing = false
+
+	if b.state == BreakerHalfOpen || (b.state == BreakerClosed && b.threshold > 0 && b.failures >= b.threshold) {
+		b.openedAt = time.Now()
+		b.transition(BreakerOpen)
+	}
+}
+
+// release gives up a call whose outcome says nothing about the provider, such as one
+// cancelled by the caller.
+func (b *breaker) release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+}
+
+func (b *breaker) transition(state BreakerState) {
+	logrus.WithFields(logrus.Fields{
+		"from":     b.state,
+		"to":       state,
+		"failures": b.failures,
+	}).Warn("Music info circuit breaker changed state")
+
+	b.state = state
+}
+
+func (b *breaker) snapshot() BreakerSnapshot {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	snapshot := BreakerSnapshot{
+		State:               b.state,
+		ConsecutiveFailures: b.failures,
+		Threshold:           b.threshold,
+	}
+
+	if b.state != BreakerClosed {
+		openedAt := b.openedAt
+		retryAt := openedAt.Add(b.cooldown)
+		snapshot.OpenedAt, snapshot.RetryAt = &openedAt, &retryAt
+	}
+
+	return snapshot
+}
@@ -0,0 +1,145 @@
+package musicinfo
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/mashfeii/songs_library/config"
+	client "github.com/mashfeii/songs_library/internal/api"
+	"github.com/mashfeii/songs_library/internal/domain"
+	"github.com/mashfeii/songs_library/internal/infrastructure/database"
+	clientErrors "github.com/mashfeii/songs_library/internal/infrastructure/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// CacheHeader tells whether a response was served from the cache ("hit"), fetched ("miss") or
+// served from an expired entry because the provider failed ("stale").
+const CacheHeader = "X-Cache"
+
+func CachePolicyFromConfig(cfg *config.Config) domain.InfoCachePolicy {
+	return domain.InfoCachePolicy{
+		TTL:         cfg.APICacheTTL,
+		NegativeTTL: cfg.APICacheNegativeTTL,
+	}
+}
+
+// CachingClient answers lookups from the info cache while entries are fresh and stores
+// song details and missing songs fetched from the wrapped client. Cache failures are logged
+// and the wrapped client is called as if the entry was missing.
+type CachingClient struct {
+	next   client.ClientWithResponsesInterface
+	cache  database.InfoCacheRepository
+	policy domain.InfoCachePolicy
+}
+
+var _ client.ClientWithResponsesInterface = (*CachingClient)(nil)
+
+func NewCachingClient(
+	next client.ClientWithResponsesInterface,
+	cache database.InfoCacheRepository,
+	policy domain.InfoCachePolicy,
+) *CachingClient {
+	return &CachingClient{
+		next:   next,
+		cache:  cache,
+		policy: policy,
+	}
+}
+
+func (c *CachingClient) GetInfoWithResponse(
+	ctx context.Context,
+	params *client.GetInfoParams,
+	reqEditors ...client.RequestEditorFn,
+) (*client.GetInfoResponse, error) {
+	entry, err := c.cache.GetInfoCacheEntry(ctx, params.Group, params.Song)
+	if err != nil && !errors.As(err, &clientErrors.ErrNotFound{}) {
+		logrus.WithFields(logrus.Fields{
+			"error": err,
+			"group": params.Group,
+			"song":  params.Song,
+		}).Warn("Failed to read info cache, calling the provider")
+	}
+
+	if entry != nil {
+		c.policy.Annotate(entry, time.Now())
+
+		if !entry.Expired {
+			return cachedResponse(entry, "hit")
+		}
+	}
+
+	response, err := c.next.GetInfoWithResponse(ctx, params, reqEditors...)
+	if (err != nil || response.StatusCode() >= http.StatusInternalServerError) &&
+		entry != nil && entry.Status == http.StatusOK {
+		logrus.WithFields(logrus.Fields{
+			"error":      err,
+			"group":      params.Group,
+			"song":       params.Song,
+			"fetched_at": entry.FetchedAt,
+		}).Warn("Music info provider failed, serving a stale cache entry")
+
+		return cachedResponse(entry, "stale")
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if c.policy.Lifetime(response.StatusCode()) > 0 {
+		c.store(ctx, params, response)
+	}
+
+	if response.HTTPResponse != nil {
+		response.HTTPResponse.Header.Set(CacheHeader, "miss")
+	}
+
+	return response, nil
+}
+
+func (c *CachingClient) store(ctx context.Context, params *client.GetInfoParams, response *client.GetInfoResponse) {
+	entry := &domain.InfoCacheEntry{
+		Group:     params.Group,
+		Song:      params.Song,
+		Status:    response.StatusCode(),
+		FetchedAt: time.Now(),
+	}
+
+	if response.JSON200 != nil {
+		entry.Payload = response.Body
+	}
+
+	if err := c.cache.SaveInfoCacheEntry(ctx, entry); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"error": err,
+			"group": params.Group,
+			"song":  params.Song,
+		}).Warn("Failed to store info cache entry")
+	}
+}
+
+// cachedResponse rebuilds the response of the provider from a cache entry.
+func cachedResponse(entry *domain.InfoCacheEntry, source string) (*client.GetInfoResponse, error) {
+	header := http.Header{}
+	header.Set(CacheHeader, source)
+
+	if entry.Payload != nil {
+		header.Set("Content-Type", "application/json")
+	}
+
+	response, err := client.ParseGetInfoResponse(&http.Response{
+		Status:     fmt.Sprintf("%d %s", entry.Status, http.StatusText(entry.Status)),
+		StatusCode: entry.Status,
+		Header:     header,
+		Body:       io.NopCloser(bytes.NewReader(entry.Payload)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("decoding cached info: %w", err)
+	}
+
+	return response, nil
+}
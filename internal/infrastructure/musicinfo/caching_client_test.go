@@ -0,0 +1,117 @@
+package musicinfo_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	client "github.com/mashfeii/songs_library/internal/api"
+	"github.com/mashfeii/songs_library/internal/domain"
+	"github.com/mashfeii/songs_library/internal/infrastructure/musicinfo"
+	"github.com/mashfeii/songs_library/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	clientErrors "github.com/mashfeii/songs_library/internal/infrastructure/errors"
+)
+
+var policy = domain.InfoCachePolicy{TTL: time.Hour, NegativeTTL: time.Minute}
+
+func TestCachingClient(t *testing.T) {
+	mockCache := mocks.NewInfoCacheRepositoryMock(t)
+	mockClient := mocks.NewClientWithResponsesInterfaceMock(t)
+
+	c := musicinfo.NewCachingClient(mockClient, mockCache, policy)
+	notCached := clientErrors.NewErrNotFound("cached info")
+
+	t.Run("Hit", func(t *testing.T) {
+		mockCache.On("GetInfoCacheEntry", mock.Anything, "Muse", "Supermassive Black Hole").Return(&domain.InfoCacheEntry{
+			Group:     "muse",
+			Song:      "supermassive black hole",
+			Status:    http.StatusOK,
+			Payload:   json.RawMessage(songDetail),
+			FetchedAt: time.Now().Add(-30 * time.Minute),
+		}, nil).Once()
+
+		response, err := c.GetInfoWithResponse(context.Background(), params)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+		assert.Equal(t, "hit", response.HTTPResponse.Header.Get(musicinfo.CacheHeader))
+		assert.Equal(t, "https://www.youtube.com/watch?v=Xsp3_a-PMTw", response.JSON200.Link)
+	})
+
+	t.Run("MissIsStored", func(t *testing.T) {
+		mockCache.On("GetInfoCacheEntry", mock.Anything, "Muse", "Supermassive Black Hole").Return(nil, notCached).Once()
+		mockClient.On("GetInfoWithResponse", mock.Anything, params).Return(&client.GetInfoResponse{
+			Body:         []byte(songDetail),
+			HTTPResponse: &http.Response{StatusCode: http.StatusOK, Header: http.Header{}},
+			JSON200:      &client.SongDetail{Link: "https://www.youtube.com/watch?v=Xsp3_a-PMTw"},
+		}, nil).Once()
+		mockCache.On("SaveInfoCacheEntry", mock.Anything, mock.MatchedBy(func(entry *domain.InfoCacheEntry) bool {
+			return entry.Status == http.StatusOK && string(entry.Payload) == songDetail
+		})).Return(nil).Once()
+
+		response, err := c.GetInfoWithResponse(context.Background(), params)
+		require.NoError(t, err)
+		assert.Equal(t, "miss", response.HTTPResponse.Header.Get(musicinfo.CacheHeader))
+	})
+
+	t.Run("NegativeHit", func(t *testing.T) {
+		mockCache.On("GetInfoCacheEntry", mock.Anything, "Muse", "Supermassive Black Hole").Return(&domain.InfoCacheEntry{
+			Status:    http.StatusNotFound,
+			FetchedAt: time.Now().Add(-30 * time.Second),
+		}, nil).Once()
+
+		response, err := c.GetInfoWithResponse(context.Background(), params)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, response.StatusCode())
+		assert.Nil(t, response.JSON200)
+	})
+
+	t.Run("ExpiredNotFoundIsRefetched", func(t *testing.T) {
+		mockCache.On("GetInfoCacheEntry", mock.Anything, "Muse", "Supermassive Black Hole").Return(&domain.InfoCacheEntry{
+			Status:    http.StatusNotFound,
+			FetchedAt: time.Now().Add(-2 * time.Minute),
+		}, nil).Once()
+		mockClient.On("GetInfoWithResponse", mock.Anything, params).Return(&client.GetInfoResponse{
+			HTTPResponse: &http.Response{StatusCode: http.StatusNotFound, Header: http.Header{}},
+		}, nil).Once()
+		mockCache.On("SaveInfoCacheEntry", mock.Anything, mock.MatchedBy(func(entry *domain.InfoCacheEntry) bool {
+			return entry.Status == http.StatusNotFound && entry.Payload == nil
+		})).Return(nil).Once()
+
+		response, err := c.GetInfoWithResponse(context.Background(), params)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, response.StatusCode())
+	})
+
+	t.Run("ServerErrorIsNotStored", func(t *testing.T) {
+		mockCache.On("GetInfoCacheEntry", mock.Anything, "Muse", "Supermassive Black Hole").
+			Return(nil, errors.New("connection refused")).Once()
+		mockClient.On("GetInfoWithResponse", mock.Anything, params).Return(&client.GetInfoResponse{
+			HTTPResponse: &http.Response{StatusCode: http.StatusBadGateway, Header: http.Header{}},
+		}, nil).Once()
+
+		response, err := c.GetInfoWithResponse(context.Background(), params)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadGateway, response.StatusCode())
+	})
+
+	t.Run("StaleWhenProviderFails", func(t *testing.T) {
+		mockCache.On("GetInfoCacheEntry", mock.Anything, "Muse", "Supermassive Black Hole").Return(&domain.InfoCacheEntry{
+			Status:    http.StatusOK,
+			Payload:   json.RawMessage(songDetail),
+			FetchedAt: time.Now().Add(-2 * time.Hour),
+		}, nil).Once()
+		mockClient.On("GetInfoWithResponse", mock.Anything, params).Return(nil, musicinfo.ErrCircuitOpen).Once()
+
+		response, err := c.GetInfoWithResponse(context.Background(), params)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+		assert.Equal(t, "stale", response.HTTPResponse.Header.Get(musicinfo.CacheHeader))
+	})
+}
@@ -0,0 +1,202 @@
+package musicinfo
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mashfeii/songs_library/internal/domain"
+	clientErrors "github.com/mashfeii/songs_library/internal/infrastructure/errors"
+)
+
+// CatalogProviderName identifies the local catalog in METADATA_PROVIDERS and in song sources.
+const CatalogProviderName = "catalog"
+
+const catalogDateLayout = "2006-01-02"
+
+// catalogRecord is a song of the catalog file. JSON catalogs hold an array of records and
+// CSV catalogs a header row with the same names, columns other than group and song may be
+// left out or empty.
+type catalogRecord struct {
+	Group            string `json:"group"`
+	Song             string `json:"song"`
+	ReleaseDate      string `json:"releaseDate"`
+	Text             string `json:"text"`
+	Link             string `json:"link"`
+	Album            string `json:"album"`
+	AlbumCoverLink   string `json:"albumCoverLink"`
+	AlbumReleaseDate string `json:"albumReleaseDate"`
+	TrackNumber      *int   `json:"trackNumber"`
+}
+
+type catalogKey struct {
+	group string
+	song  string
+}
+
+// CatalogProvider is a metadata provider answering from a JSON or CSV file loaded in memory.
+type CatalogProvider struct {
+	songs map[catalogKey]domain.SongMetadata
+}
+
+// LoadCatalog reads the catalog at path, the format is chosen by the .json or .csv extension.
+func LoadCatalog(path string) (*CatalogProvider, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening catalog: %w", err)
+	}
+	defer file.Close()
+
+	var records []catalogRecord
+
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".json":
+		if err := json.NewDecoder(file).Decode(&records); err != nil {
+			return nil, fmt.Errorf("decoding catalog: %w", err)
+		}
+	case ".csv":
+		if records, err = readCatalogCSV(file); err != nil {
+			return nil, fmt.Errorf("decoding catalog: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported catalog format %q", ext)
+	}
+
+	provider := &CatalogProvider{songs: make(map[catalogKey]domain.SongMetadata, len(records))}
+
+	for i, record := range records {
+		metadata, err := record.metadata()
+		if err != nil {
+			return nil, fmt.Errorf("catalog record %d: %w", i+1, err)
+		}
+
+		provider.songs[catalogKey{
+			group: domain.NormalizeLookupKey(record.Group),
+			song:  domain.NormalizeLookupKey(record.Song),
+		}] = metadata
+	}
+
+	return provider, nil
+}
+
+func (p *CatalogProvider) Name() string {
+	return CatalogProviderName
+}
+
+func (p *CatalogProvider) Lookup(_ context.Context, group, song string) (*domain.SongMetadata, error) {
+	metadata, ok := p.songs[catalogKey{
+		group: domain.NormalizeLookupKey(group),
+		song:  domain.NormalizeLookupKey(song),
+	}]
+	if !ok {
+		return nil, clientErrors.NewErrNotFound("catalog song")
+	}
+
+	return &metadata, nil
+}
+
+func (r *catalogRecord) metadata() (domain.SongMetadata, error) {
+	if strings.TrimSpace(r.Group) == "" || strings.TrimSpace(r.Song) == "" {
+		return domain.SongMetadata{}, fmt.Errorf("group and song are required")
+	}
+
+	metadata := domain.SongMetadata{
+		Text: r.Text,
+		Link: r.Link,
+	}
+
+	if r.ReleaseDate != "" {
+		releaseDate, err := time.Parse(catalogDateLayout, r.ReleaseDate)
+		if err != nil {
+			return domain.SongMetadata{}, fmt.Errorf("parsing releaseDate: %w", err)
+		}
+
+		metadata.ReleaseDate = releaseDate
+	}
+
+	if r.Album != "" {
+		album := domain.AlbumMetadata{
+			Title:       r.Album,
+			CoverLink:   r.AlbumCoverLink,
+			TrackNumber: trackNumber(r.TrackNumber),
+		}
+
+		if r.AlbumReleaseDate != "" {
+			releaseDate, err := time.Parse(catalogDateLayout, r.AlbumReleaseDate)
+			if err != nil {
+				return domain.SongMetadata{}, fmt.Errorf("parsing albumReleaseDate: %w", err)
+			}
+
+			album.ReleaseDate = &releaseDate
+		}
+
+		metadata.Album = &album
+	}
+
+	return metadata, nil
+}
+
+func readCatalogCSV(r io.Reader) ([]catalogRecord, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.TrimSpace(name)] = i
+	}
+
+	var records []catalogRecord
+
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			return records, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		field := func(name string) string {
+			if i, ok := columns[name]; ok && i < len(row) {
+				return strings.TrimSpace(row[i])
+			}
+
+			return ""
+		}
+
+		record := catalogRecord{
+			Group:            field("group"),
+			Song:             field("song"),
+			ReleaseDate:      field("releaseDate"),
+			Text:             field("text"),
+			Link:             field("link"),
+			Album:            field("album"),
+			AlbumCoverLink:   field("albumCoverLink"),
+			AlbumReleaseDate: field("albumReleaseDate"),
+		}
+
+		if track := field("trackNumber"); track != "" {
+			number, err := strconv.Atoi(track)
+			if err != nil {
+				return nil, fmt.Errorf("parsing trackNumber %q: %w", track, err)
+			}
+
+			record.TrackNumber = &number
+		}
+
+		records = append(records, record)
+	}
+}
@@ -0,0 +1,91 @@
+package musicinfo_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/mashfeii/songs_library/internal/infrastructure/musicinfo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	clientErrors "github.com/mashfeii/songs_library/internal/infrastructure/errors"
+)
+
+func writeCatalog(t *testing.T, name, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	return path
+}
+
+func TestLoadCatalog(t *testing.T) {
+	t.Run("JSON", func(t *testing.T) {
+		path := writeCatalog(t, "catalog.json", `[{
+			"group": "Muse",
+			"song": "Supermassive Black Hole",
+			"releaseDate": "2006-06-19",
+			"link": "https://www.youtube.com/watch?v=Xsp3_a-PMTw",
+			"album": "Black Holes and Revelations",
+			"trackNumber": 3
+		}]`)
+
+		catalog, err := musicinfo.LoadCatalog(path)
+		require.NoError(t, err)
+
+		metadata, err := catalog.Lookup(context.Background(), "  muse ", "Supermassive  black hole")
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2006, 6, 19, 0, 0, 0, 0, time.UTC), metadata.ReleaseDate)
+		assert.Empty(t, metadata.Text)
+		assert.Equal(t, "Black Holes and Revelations", metadata.Album.Title)
+		assert.Equal(t, 3, *metadata.Album.TrackNumber)
+	})
+
+	t.Run("CSV", func(t *testing.T) {
+		path := writeCatalog(t, "catalog.csv", "group,song,link,trackNumber\n"+
+			"Muse,Starlight,https://www.youtube.com/watch?v=Pgum6OT_VH8,\n")
+
+		catalog, err := musicinfo.LoadCatalog(path)
+		require.NoError(t, err)
+
+		metadata, err := catalog.Lookup(context.Background(), "Muse", "Starlight")
+		require.NoError(t, err)
+		assert.Equal(t, "https://www.youtube.com/watch?v=Pgum6OT_VH8", metadata.Link)
+		assert.Nil(t, metadata.Album)
+
+		_, err = catalog.Lookup(context.Background(), "Muse", "Uprising")
+		assert.True(t, errors.As(err, &clientErrors.ErrNotFound{}))
+	})
+
+	t.Run("UnknownTrackNumber", func(t *testing.T) {
+		path := writeCatalog(t, "catalog.csv", "group,song,album,trackNumber\n"+
+			"Muse,Starlight,Black Holes and Revelations,0\n")
+
+		catalog, err := musicinfo.LoadCatalog(path)
+		require.NoError(t, err)
+
+		metadata, err := catalog.Lookup(context.Background(), "Muse", "Starlight")
+		require.NoError(t, err)
+		assert.Equal(t, "Black Holes and Revelations", metadata.Album.Title)
+		assert.Nil(t, metadata.Album.TrackNumber)
+	})
+
+	t.Run("InvalidDate", func(t *testing.T) {
+		path := writeCatalog(t, "catalog.csv", "group,song,releaseDate\nMuse,Starlight,19.06.2006\n")
+
+		_, err := musicinfo.LoadCatalog(path)
+		assert.ErrorContains(t, err, "releaseDate")
+	})
+
+	t.Run("UnsupportedFormat", func(t *testing.T) {
+		path := writeCatalog(t, "catalog.yaml", "- group: Muse\n")
+
+		_, err := musicinfo.LoadCatalog(path)
+		assert.ErrorContains(t, err, "unsupported catalog format")
+	})
+}
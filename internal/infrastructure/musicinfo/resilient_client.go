@@ -0,0 +1,172 @@
+package musicinfo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/mashfeii/songs_library/config"
+	client "github.com/mashfeii/songs_library/internal/api"
+	"github.com/sirupsen/logrus"
+)
+
+// Options configure the resilient client, zero durations disable the timeout and backoff
+// and a zero threshold keeps the breaker closed.
+type Options struct {
+	Timeout          time.Duration
+	MaxRetries       int
+	BackoffBase      time.Duration
+	BackoffMax       time.Duration
+	FailureThreshold int
+	Cooldown         time.Duration
+}
+
+func OptionsFromConfig(cfg *config.Config) Options {
+	return Options{
+		Timeout:          cfg.APITimeout,
+		MaxRetries:       cfg.APIMaxRetries,
+		BackoffBase:      cfg.APIBackoffBase,
+		BackoffMax:       cfg.APIBackoffMax,
+		FailureThreshold: cfg.APIBreakerThreshold,
+		Cooldown:         cfg.APIBreakerCooldown,
+	}
+}
+
+// BreakerReporter exposes the state of a circuit breaker.
+type BreakerReporter interface {
+	Breaker() BreakerSnapshot
+}
+
+// ResilientClient wraps the music info client with per-call timeouts, retries of network
+// errors and 5xx responses with jittered exponential backoff, and a circuit breaker.
+type ResilientClient struct {
+	next    client.ClientWithResponsesInterface
+	opts    Options
+	breaker *breaker
+}
+
+var _ client.ClientWithResponsesInterface = (*ResilientClient)(nil)
+
+func NewResilientClient(next client.ClientWithResponsesInterface, opts Options) *ResilientClient {
+	return &ResilientClient{
+		next:    next,
+		opts:    opts,
+		breaker: newBreaker(opts.FailureThreshold, opts.Cooldown),
+	}
+}
+
+func (c *ResilientClient) Breaker() BreakerSnapshot {
+	return c.breaker.snapshot()
+}
+
+// GetInfoWithResponse returns the last response once retries are exhausted, so callers still
+// see the status code of a failing provider.
+func (c *ResilientClient) GetInfoWithResponse(
+	ctx context.Context,
+	params *client.GetInfoParams,
+	reqEditors ...client.RequestEditorFn,
+) (*client.GetInfoResponse, error) {
+	var (
+		response *client.GetInfoResponse
+		err      error
+	)
+
+	for attempt := 0; ; attempt++ {
+		if !c.breaker.allow() {
+			if attempt > 0 {
+				return response, err
+			}
+
+			return nil, ErrCircuitOpen
+		}
+
+		response, err = c.attempt(ctx, params, reqEditors)
+		if ctx.Err() != nil {
+			c.breaker.release()
+
+			return response, err
+		}
+
+		if !retryable(response, err) {
+			c.breaker.success()
+
+			return response, err
+		}
+
+		c.breaker.failure()
+
+		if attempt >= c.opts.MaxRetries {
+			return response, err
+		}
+
+		delay := c.backoff(attempt)
+
+		logrus.WithFields(logrus.Fields{
+			"attempt": attempt + 1,
+			"delay":   delay,
+			"status":  statusOf(response),
+			"error":   err,
+		}).Warn("Retrying music info request")
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("waiting to retry music info request: %w", ctx.Err())
+		case <-time.After(delay):
+		}
+	}
+}
+
+func (c *ResilientClient) attempt(
+	ctx context.Context,
+	params *client.GetInfoParams,
+	reqEditors []client.RequestEditorFn,
+) (*client.GetInfoResponse, error) {
+	if c.opts.Timeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, c.opts.Timeout)
+		defer cancel()
+	}
+
+	return c.next.GetInfoWithResponse(ctx, params, reqEditors...)
+}
+
+// backoff doubles the base delay with every attempt up to the maximum, then picks a random
+// delay in its upper half so clients retrying together spread out.
+func (c *ResilientClient) backoff(attempt int) time.Duration {
+	delay := c.opts.BackoffBase << min(attempt, 30)
+	if c.opts.BackoffMax > 0 && (delay > c.opts.BackoffMax || delay <= 0) {
+		delay = c.opts.BackoffMax
+	}
+
+	if delay <= 1 {
+		return max(delay, 0)
+	}
+
+	return delay/2 + rand.N(delay/2)
+}
+
+// retryable reports whether the outcome points at an unavailable provider: transport errors,
+// which the HTTP client wraps in *url.Error, and 5xx responses. Responses such as 404 and
+// malformed bodies mean the provider is reachable and are returned as is.
+func retryable(response *client.GetInfoResponse, err error) bool {
+	if err != nil {
+		var urlErr *url.Error
+
+		return errors.As(err, &urlErr)
+	}
+
+	return response.StatusCode() >= http.StatusInternalServerError
+}
+
+func statusOf(response *client.GetInfoResponse) int {
+	if response == nil {
+		return 0
+	}
+
+	return response.StatusCode()
+}
@@ -0,0 +1,190 @@
+package musicinfo_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	client "github.com/mashfeii/songs_library/internal/api"
+	"github.com/mashfeii/songs_library/internal/infrastructure/musicinfo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const songDetail = `{"releaseDate":"2006-07-16","text":"Ooh baby, don't you know I suffer?","link":"https://www.youtube.com/watch?v=Xsp3_a-PMTw"}`
+
+// infoServer stands in for the /info API, answering with the statuses in order and repeating
+// the last one.
+func infoServer(t *testing.T, delay time.Duration, statuses ...int) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := int(calls.Add(1))
+		status := statuses[min(call, len(statuses))-1]
+
+		if r.URL.Path != "/info" || r.URL.Query().Get("group") == "" {
+			status = http.StatusBadRequest
+		}
+
+		select {
+		case <-time.After(delay):
+		case <-r.Context().Done():
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+
+		if status == http.StatusOK {
+			_, _ = w.Write([]byte(songDetail))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &calls
+}
+
+func newClient(t *testing.T, server *httptest.Server, opts musicinfo.Options) *musicinfo.ResilientClient {
+	t.Helper()
+
+	generated, err := client.NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+
+	return musicinfo.NewResilientClient(generated, opts)
+}
+
+var params = &client.GetInfoParams{Group: "Muse", Song: "Supermassive Black Hole"}
+
+func TestResilientClient_Retries(t *testing.T) {
+	t.Run("ServerErrors", func(t *testing.T) {
+		server, calls := infoServer(t, 0, http.StatusInternalServerError, http.StatusBadGateway, http.StatusOK)
+		c := newClient(t, server, musicinfo.Options{MaxRetries: 3, BackoffBase: time.Millisecond, BackoffMax: 5 * time.Millisecond})
+
+		response, err := c.GetInfoWithResponse(context.Background(), params)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+		assert.Equal(t, "2006-07-16", response.JSON200.ReleaseDate.Format("2006-01-02"))
+		assert.Equal(t, int32(3), calls.Load())
+		assert.Equal(t, musicinfo.BreakerClosed, c.Breaker().State)
+	})
+
+	t.Run("NotFoundIsNotRetried", func(t *testing.T) {
+		server, calls := infoServer(t, 0, http.StatusNotFound)
+		c := newClient(t, server, musicinfo.Options{MaxRetries: 3, BackoffBase: time.Millisecond})
+
+		response, err := c.GetInfoWithResponse(context.Background(), params)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, response.StatusCode())
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("ExhaustedReturnsLastResponse", func(t *testing.T) {
+		server, calls := infoServer(t, 0, http.StatusServiceUnavailable)
+		c := newClient(t, server, musicinfo.Options{MaxRetries: 2, BackoffBase: time.Millisecond})
+
+		response, err := c.GetInfoWithResponse(context.Background(), params)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, response.StatusCode())
+		assert.Equal(t, int32(3), calls.Load())
+		assert.Equal(t, 3, c.Breaker().ConsecutiveFailures)
+	})
+
+	t.Run("MalformedBodyIsNotRetried", func(t *testing.T) {
+		var calls atomic.Int32
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			calls.Add(1)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"releaseDate":`))
+		}))
+		t.Cleanup(server.Close)
+
+		c := newClient(t, server, musicinfo.Options{MaxRetries: 3, FailureThreshold: 1})
+
+		_, err := c.GetInfoWithResponse(context.Background(), params)
+		assert.Error(t, err)
+		assert.Equal(t, int32(1), calls.Load())
+		assert.Equal(t, musicinfo.BreakerClosed, c.Breaker().State)
+	})
+
+	t.Run("Timeout", func(t *testing.T) {
+		server, calls := infoServer(t, time.Second, http.StatusOK)
+		c := newClient(t, server, musicinfo.Options{Timeout: 20 * time.Millisecond, MaxRetries: 1})
+
+		started := time.Now()
+		response, err := c.GetInfoWithResponse(context.Background(), params)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Nil(t, response)
+		assert.Less(t, time.Since(started), 500*time.Millisecond)
+		assert.Eventually(t, func() bool { return calls.Load() == 2 }, time.Second, 5*time.Millisecond)
+	})
+
+	t.Run("CallerCancellation", func(t *testing.T) {
+		server, _ := infoServer(t, time.Second, http.StatusOK)
+		c := newClient(t, server, musicinfo.Options{MaxRetries: 3, FailureThreshold: 1, Cooldown: time.Minute})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		_, err := c.GetInfoWithResponse(ctx, params)
+		assert.Error(t, err)
+		assert.Equal(t, musicinfo.BreakerClosed, c.Breaker().State)
+	})
+}
+
+func TestResilientClient_Breaker(t *testing.T) {
+	var healthy atomic.Bool
+
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+
+		if !healthy.Load() {
+			w.WriteHeader(http.StatusInternalServerError)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(songDetail))
+	}))
+	t.Cleanup(server.Close)
+
+	c := newClient(t, server, musicinfo.Options{FailureThreshold: 2, Cooldown: 50 * time.Millisecond})
+
+	for range 2 {
+		response, err := c.GetInfoWithResponse(context.Background(), params)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusInternalServerError, response.StatusCode())
+	}
+
+	snapshot := c.Breaker()
+	assert.Equal(t, musicinfo.BreakerOpen, snapshot.State)
+	assert.Equal(t, 2, snapshot.ConsecutiveFailures)
+	require.NotNil(t, snapshot.RetryAt)
+
+	_, err := c.GetInfoWithResponse(context.Background(), params)
+	assert.ErrorIs(t, err, musicinfo.ErrCircuitOpen)
+	assert.Equal(t, int32(2), calls.Load())
+
+	time.Sleep(60 * time.Millisecond)
+
+	_, err = c.GetInfoWithResponse(context.Background(), params)
+	assert.NoError(t, err)
+	assert.Equal(t, musicinfo.BreakerOpen, c.Breaker().State, "a failed probe opens the breaker again")
+
+	time.Sleep(60 * time.Millisecond)
+	healthy.Store(true)
+
+	response, err := c.GetInfoWithResponse(context.Background(), params)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode())
+	assert.Equal(t, musicinfo.BreakerSnapshot{State: musicinfo.BreakerClosed, Threshold: 2}, c.Breaker())
+	assert.Equal(t, int32(4), calls.Load())
+}
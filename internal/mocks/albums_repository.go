@@ -0,0 +1,424 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/mashfeii/songs_library/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AlbumsRepositoryMock is an autogenerated mock type for the AlbumsRepository type
+type AlbumsRepositoryMock struct {
+	mock.Mock
+}
+
+type AlbumsRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AlbumsRepositoryMock) EXPECT() *AlbumsRepositoryMock_Expecter {
+	return &AlbumsRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// AddAlbum provides a mock function with given fields: ctx, album
+func (_m *AlbumsRepositoryMock) AddAlbum(ctx context.Context, album *domain.Album) (int, error) {
+	ret := _m.Called(ctx, album)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddAlbum")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Album) (int, error)); ok {
+		return rf(ctx, album)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Album) int); ok {
+		r0 = rf(ctx, album)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.Album) error); ok {
+		r1 = rf(ctx, album)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AlbumsRepositoryMock_AddAlbum_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddAlbum'
+type AlbumsRepositoryMock_AddAlbum_Call struct {
+	*mock.Call
+}
+
+// AddAlbum is a helper method to define mock.On call
+//   - ctx context.Context
+//   - album *domain.Album
+func (_e *AlbumsRepositoryMock_Expecter) AddAlbum(ctx interface{}, album interface{}) *AlbumsRepositoryMock_AddAlbum_Call {
+	return &AlbumsRepositoryMock_AddAlbum_Call{Call: _e.mock.On("AddAlbum", ctx, album)}
+}
+
+func (_c *AlbumsRepositoryMock_AddAlbum_Call) Run(run func(ctx context.Context, album *domain.Album)) *AlbumsRepositoryMock_AddAlbum_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.Album))
+	})
+	return _c
+}
+
+func (_c *AlbumsRepositoryMock_AddAlbum_Call) Return(_a0 int, _a1 error) *AlbumsRepositoryMock_AddAlbum_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AlbumsRepositoryMock_AddAlbum_Call) RunAndReturn(run func(context.Context, *domain.Album) (int, error)) *AlbumsRepositoryMock_AddAlbum_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAlbum provides a mock function with given fields: ctx, id
+func (_m *AlbumsRepositoryMock) DeleteAlbum(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAlbum")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AlbumsRepositoryMock_DeleteAlbum_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAlbum'
+type AlbumsRepositoryMock_DeleteAlbum_Call struct {
+	*mock.Call
+}
+
+// DeleteAlbum is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *AlbumsRepositoryMock_Expecter) DeleteAlbum(ctx interface{}, id interface{}) *AlbumsRepositoryMock_DeleteAlbum_Call {
+	return &AlbumsRepositoryMock_DeleteAlbum_Call{Call: _e.mock.On("DeleteAlbum", ctx, id)}
+}
+
+func (_c *AlbumsRepositoryMock_DeleteAlbum_Call) Run(run func(ctx context.Context, id int)) *AlbumsRepositoryMock_DeleteAlbum_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *AlbumsRepositoryMock_DeleteAlbum_Call) Return(_a0 error) *AlbumsRepositoryMock_DeleteAlbum_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AlbumsRepositoryMock_DeleteAlbum_Call) RunAndReturn(run func(context.Context, int) error) *AlbumsRepositoryMock_DeleteAlbum_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAlbumByID provides a mock function with given fields: ctx, id
+func (_m *AlbumsRepositoryMock) GetAlbumByID(ctx context.Context, id int) (*domain.Album, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAlbumByID")
+	}
+
+	var r0 *domain.Album
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*domain.Album, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *domain.Album); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Album)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AlbumsRepositoryMock_GetAlbumByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAlbumByID'
+type AlbumsRepositoryMock_GetAlbumByID_Call struct {
+	*mock.Call
+}
+
+// GetAlbumByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *AlbumsRepositoryMock_Expecter) GetAlbumByID(ctx interface{}, id interface{}) *AlbumsRepositoryMock_GetAlbumByID_Call {
+	return &AlbumsRepositoryMock_GetAlbumByID_Call{Call: _e.mock.On("GetAlbumByID", ctx, id)}
+}
+
+func (_c *AlbumsRepositoryMock_GetAlbumByID_Call) Run(run func(ctx context.Context, id int)) *AlbumsRepositoryMock_GetAlbumByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *AlbumsRepositoryMock_GetAlbumByID_Call) Return(_a0 *domain.Album, _a1 error) *AlbumsRepositoryMock_GetAlbumByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AlbumsRepositoryMock_GetAlbumByID_Call) RunAndReturn(run func(context.Context, int) (*domain.Album, error)) *AlbumsRepositoryMock_GetAlbumByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAlbumTracks provides a mock function with given fields: ctx, id
+func (_m *AlbumsRepositoryMock) GetAlbumTracks(ctx context.Context, id int) ([]domain.Song, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAlbumTracks")
+	}
+
+	var r0 []domain.Song
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]domain.Song, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []domain.Song); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Song)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AlbumsRepositoryMock_GetAlbumTracks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAlbumTracks'
+type AlbumsRepositoryMock_GetAlbumTracks_Call struct {
+	*mock.Call
+}
+
+// GetAlbumTracks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *AlbumsRepositoryMock_Expecter) GetAlbumTracks(ctx interface{}, id interface{}) *AlbumsRepositoryMock_GetAlbumTracks_Call {
+	return &AlbumsRepositoryMock_GetAlbumTracks_Call{Call: _e.mock.On("GetAlbumTracks", ctx, id)}
+}
+
+func (_c *AlbumsRepositoryMock_GetAlbumTracks_Call) Run(run func(ctx context.Context, id int)) *AlbumsRepositoryMock_GetAlbumTracks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *AlbumsRepositoryMock_GetAlbumTracks_Call) Return(_a0 []domain.Song, _a1 error) *AlbumsRepositoryMock_GetAlbumTracks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AlbumsRepositoryMock_GetAlbumTracks_Call) RunAndReturn(run func(context.Context, int) ([]domain.Song, error)) *AlbumsRepositoryMock_GetAlbumTracks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAlbums provides a mock function with given fields: ctx, groupID, pageReq
+func (_m *AlbumsRepositoryMock) GetAlbums(ctx context.Context, groupID int, pageReq domain.PageRequest) (*domain.Page[domain.Album], error) {
+	ret := _m.Called(ctx, groupID, pageReq)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAlbums")
+	}
+
+	var r0 *domain.Page[domain.Album]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, domain.PageRequest) (*domain.Page[domain.Album], error)); ok {
+		return rf(ctx, groupID, pageReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, domain.PageRequest) *domain.Page[domain.Album]); ok {
+		r0 = rf(ctx, groupID, pageReq)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Page[domain.Album])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, domain.PageRequest) error); ok {
+		r1 = rf(ctx, groupID, pageReq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AlbumsRepositoryMock_GetAlbums_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAlbums'
+type AlbumsRepositoryMock_GetAlbums_Call struct {
+	*mock.Call
+}
+
+// GetAlbums is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupID int
+//   - pageReq domain.PageRequest
+func (_e *AlbumsRepositoryMock_Expecter) GetAlbums(ctx interface{}, groupID interface{}, pageReq interface{}) *AlbumsRepositoryMock_GetAlbums_Call {
+	return &AlbumsRepositoryMock_GetAlbums_Call{Call: _e.mock.On("GetAlbums", ctx, groupID, pageReq)}
+}
+
+func (_c *AlbumsRepositoryMock_GetAlbums_Call) Run(run func(ctx context.Context, groupID int, pageReq domain.PageRequest)) *AlbumsRepositoryMock_GetAlbums_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(domain.PageRequest))
+	})
+	return _c
+}
+
+func (_c *AlbumsRepositoryMock_GetAlbums_Call) Return(_a0 *domain.Page[domain.Album], _a1 error) *AlbumsRepositoryMock_GetAlbums_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AlbumsRepositoryMock_GetAlbums_Call) RunAndReturn(run func(context.Context, int, domain.PageRequest) (*domain.Page[domain.Album], error)) *AlbumsRepositoryMock_GetAlbums_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateAlbum provides a mock function with given fields: ctx, album
+func (_m *AlbumsRepositoryMock) UpdateAlbum(ctx context.Context, album *domain.Album) error {
+	ret := _m.Called(ctx, album)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAlbum")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Album) error); ok {
+		r0 = rf(ctx, album)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AlbumsRepositoryMock_UpdateAlbum_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAlbum'
+type AlbumsRepositoryMock_UpdateAlbum_Call struct {
+	*mock.Call
+}
+
+// UpdateAlbum is a helper method to define mock.On call
+//   - ctx context.Context
+//   - album *domain.Album
+func (_e *AlbumsRepositoryMock_Expecter) UpdateAlbum(ctx interface{}, album interface{}) *AlbumsRepositoryMock_UpdateAlbum_Call {
+	return &AlbumsRepositoryMock_UpdateAlbum_Call{Call: _e.mock.On("UpdateAlbum", ctx, album)}
+}
+
+func (_c *AlbumsRepositoryMock_UpdateAlbum_Call) Run(run func(ctx context.Context, album *domain.Album)) *AlbumsRepositoryMock_UpdateAlbum_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.Album))
+	})
+	return _c
+}
+
+func (_c *AlbumsRepositoryMock_UpdateAlbum_Call) Return(_a0 error) *AlbumsRepositoryMock_UpdateAlbum_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AlbumsRepositoryMock_UpdateAlbum_Call) RunAndReturn(run func(context.Context, *domain.Album) error) *AlbumsRepositoryMock_UpdateAlbum_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpsertAlbum provides a mock function with given fields: ctx, album
+func (_m *AlbumsRepositoryMock) UpsertAlbum(ctx context.Context, album *domain.Album) (int, error) {
+	ret := _m.Called(ctx, album)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertAlbum")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Album) (int, error)); ok {
+		return rf(ctx, album)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Album) int); ok {
+		r0 = rf(ctx, album)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.Album) error); ok {
+		r1 = rf(ctx, album)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AlbumsRepositoryMock_UpsertAlbum_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertAlbum'
+type AlbumsRepositoryMock_UpsertAlbum_Call struct {
+	*mock.Call
+}
+
+// UpsertAlbum is a helper method to define mock.On call
+//   - ctx context.Context
+//   - album *domain.Album
+func (_e *AlbumsRepositoryMock_Expecter) UpsertAlbum(ctx interface{}, album interface{}) *AlbumsRepositoryMock_UpsertAlbum_Call {
+	return &AlbumsRepositoryMock_UpsertAlbum_Call{Call: _e.mock.On("UpsertAlbum", ctx, album)}
+}
+
+func (_c *AlbumsRepositoryMock_UpsertAlbum_Call) Run(run func(ctx context.Context, album *domain.Album)) *AlbumsRepositoryMock_UpsertAlbum_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.Album))
+	})
+	return _c
+}
+
+func (_c *AlbumsRepositoryMock_UpsertAlbum_Call) Return(_a0 int, _a1 error) *AlbumsRepositoryMock_UpsertAlbum_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AlbumsRepositoryMock_UpsertAlbum_Call) RunAndReturn(run func(context.Context, *domain.Album) (int, error)) *AlbumsRepositoryMock_UpsertAlbum_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAlbumsRepositoryMock creates a new instance of AlbumsRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAlbumsRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AlbumsRepositoryMock {
+	mock := &AlbumsRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
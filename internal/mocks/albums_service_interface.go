@@ -0,0 +1,381 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/mashfeii/songs_library/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AlbumsServiceInterfaceMock is an autogenerated mock type for the AlbumsServiceInterface type
+type AlbumsServiceInterfaceMock struct {
+	mock.Mock
+}
+
+type AlbumsServiceInterfaceMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AlbumsServiceInterfaceMock) EXPECT() *AlbumsServiceInterfaceMock_Expecter {
+	return &AlbumsServiceInterfaceMock_Expecter{mock: &_m.Mock}
+}
+
+// AddAlbum provides a mock function with given fields: ctx, req
+func (_m *AlbumsServiceInterfaceMock) AddAlbum(ctx context.Context, req *domain.AlbumRequest) (*domain.Album, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddAlbum")
+	}
+
+	var r0 *domain.Album
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.AlbumRequest) (*domain.Album, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.AlbumRequest) *domain.Album); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Album)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.AlbumRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AlbumsServiceInterfaceMock_AddAlbum_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddAlbum'
+type AlbumsServiceInterfaceMock_AddAlbum_Call struct {
+	*mock.Call
+}
+
+// AddAlbum is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.AlbumRequest
+func (_e *AlbumsServiceInterfaceMock_Expecter) AddAlbum(ctx interface{}, req interface{}) *AlbumsServiceInterfaceMock_AddAlbum_Call {
+	return &AlbumsServiceInterfaceMock_AddAlbum_Call{Call: _e.mock.On("AddAlbum", ctx, req)}
+}
+
+func (_c *AlbumsServiceInterfaceMock_AddAlbum_Call) Run(run func(ctx context.Context, req *domain.AlbumRequest)) *AlbumsServiceInterfaceMock_AddAlbum_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.AlbumRequest))
+	})
+	return _c
+}
+
+func (_c *AlbumsServiceInterfaceMock_AddAlbum_Call) Return(_a0 *domain.Album, _a1 error) *AlbumsServiceInterfaceMock_AddAlbum_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AlbumsServiceInterfaceMock_AddAlbum_Call) RunAndReturn(run func(context.Context, *domain.AlbumRequest) (*domain.Album, error)) *AlbumsServiceInterfaceMock_AddAlbum_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAlbum provides a mock function with given fields: ctx, id
+func (_m *AlbumsServiceInterfaceMock) DeleteAlbum(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAlbum")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AlbumsServiceInterfaceMock_DeleteAlbum_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAlbum'
+type AlbumsServiceInterfaceMock_DeleteAlbum_Call struct {
+	*mock.Call
+}
+
+// DeleteAlbum is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *AlbumsServiceInterfaceMock_Expecter) DeleteAlbum(ctx interface{}, id interface{}) *AlbumsServiceInterfaceMock_DeleteAlbum_Call {
+	return &AlbumsServiceInterfaceMock_DeleteAlbum_Call{Call: _e.mock.On("DeleteAlbum", ctx, id)}
+}
+
+func (_c *AlbumsServiceInterfaceMock_DeleteAlbum_Call) Run(run func(ctx context.Context, id int)) *AlbumsServiceInterfaceMock_DeleteAlbum_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *AlbumsServiceInterfaceMock_DeleteAlbum_Call) Return(_a0 error) *AlbumsServiceInterfaceMock_DeleteAlbum_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AlbumsServiceInterfaceMock_DeleteAlbum_Call) RunAndReturn(run func(context.Context, int) error) *AlbumsServiceInterfaceMock_DeleteAlbum_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAlbum provides a mock function with given fields: ctx, id
+func (_m *AlbumsServiceInterfaceMock) GetAlbum(ctx context.Context, id int) (*domain.Album, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAlbum")
+	}
+
+	var r0 *domain.Album
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*domain.Album, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *domain.Album); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Album)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AlbumsServiceInterfaceMock_GetAlbum_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAlbum'
+type AlbumsServiceInterfaceMock_GetAlbum_Call struct {
+	*mock.Call
+}
+
+// GetAlbum is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *AlbumsServiceInterfaceMock_Expecter) GetAlbum(ctx interface{}, id interface{}) *AlbumsServiceInterfaceMock_GetAlbum_Call {
+	return &AlbumsServiceInterfaceMock_GetAlbum_Call{Call: _e.mock.On("GetAlbum", ctx, id)}
+}
+
+func (_c *AlbumsServiceInterfaceMock_GetAlbum_Call) Run(run func(ctx context.Context, id int)) *AlbumsServiceInterfaceMock_GetAlbum_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *AlbumsServiceInterfaceMock_GetAlbum_Call) Return(_a0 *domain.Album, _a1 error) *AlbumsServiceInterfaceMock_GetAlbum_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AlbumsServiceInterfaceMock_GetAlbum_Call) RunAndReturn(run func(context.Context, int) (*domain.Album, error)) *AlbumsServiceInterfaceMock_GetAlbum_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAlbumTracks provides a mock function with given fields: ctx, id
+func (_m *AlbumsServiceInterfaceMock) GetAlbumTracks(ctx context.Context, id int) ([]domain.Song, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAlbumTracks")
+	}
+
+	var r0 []domain.Song
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]domain.Song, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []domain.Song); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Song)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AlbumsServiceInterfaceMock_GetAlbumTracks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAlbumTracks'
+type AlbumsServiceInterfaceMock_GetAlbumTracks_Call struct {
+	*mock.Call
+}
+
+// GetAlbumTracks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *AlbumsServiceInterfaceMock_Expecter) GetAlbumTracks(ctx interface{}, id interface{}) *AlbumsServiceInterfaceMock_GetAlbumTracks_Call {
+	return &AlbumsServiceInterfaceMock_GetAlbumTracks_Call{Call: _e.mock.On("GetAlbumTracks", ctx, id)}
+}
+
+func (_c *AlbumsServiceInterfaceMock_GetAlbumTracks_Call) Run(run func(ctx context.Context, id int)) *AlbumsServiceInterfaceMock_GetAlbumTracks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *AlbumsServiceInterfaceMock_GetAlbumTracks_Call) Return(_a0 []domain.Song, _a1 error) *AlbumsServiceInterfaceMock_GetAlbumTracks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AlbumsServiceInterfaceMock_GetAlbumTracks_Call) RunAndReturn(run func(context.Context, int) ([]domain.Song, error)) *AlbumsServiceInterfaceMock_GetAlbumTracks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAlbums provides a mock function with given fields: ctx, groupID, pageReq
+func (_m *AlbumsServiceInterfaceMock) GetAlbums(ctx context.Context, groupID int, pageReq domain.PageRequest) (*domain.Page[domain.Album], error) {
+	ret := _m.Called(ctx, groupID, pageReq)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAlbums")
+	}
+
+	var r0 *domain.Page[domain.Album]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, domain.PageRequest) (*domain.Page[domain.Album], error)); ok {
+		return rf(ctx, groupID, pageReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, domain.PageRequest) *domain.Page[domain.Album]); ok {
+		r0 = rf(ctx, groupID, pageReq)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Page[domain.Album])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, domain.PageRequest) error); ok {
+		r1 = rf(ctx, groupID, pageReq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AlbumsServiceInterfaceMock_GetAlbums_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAlbums'
+type AlbumsServiceInterfaceMock_GetAlbums_Call struct {
+	*mock.Call
+}
+
+// GetAlbums is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupID int
+//   - pageReq domain.PageRequest
+func (_e *AlbumsServiceInterfaceMock_Expecter) GetAlbums(ctx interface{}, groupID interface{}, pageReq interface{}) *AlbumsServiceInterfaceMock_GetAlbums_Call {
+	return &AlbumsServiceInterfaceMock_GetAlbums_Call{Call: _e.mock.On("GetAlbums", ctx, groupID, pageReq)}
+}
+
+func (_c *AlbumsServiceInterfaceMock_GetAlbums_Call) Run(run func(ctx context.Context, groupID int, pageReq domain.PageRequest)) *AlbumsServiceInterfaceMock_GetAlbums_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(domain.PageRequest))
+	})
+	return _c
+}
+
+func (_c *AlbumsServiceInterfaceMock_GetAlbums_Call) Return(_a0 *domain.Page[domain.Album], _a1 error) *AlbumsServiceInterfaceMock_GetAlbums_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AlbumsServiceInterfaceMock_GetAlbums_Call) RunAndReturn(run func(context.Context, int, domain.PageRequest) (*domain.Page[domain.Album], error)) *AlbumsServiceInterfaceMock_GetAlbums_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateAlbum provides a mock function with given fields: ctx, id, req
+func (_m *AlbumsServiceInterfaceMock) UpdateAlbum(ctx context.Context, id int, req *domain.AlbumRequest) (*domain.Album, error) {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAlbum")
+	}
+
+	var r0 *domain.Album
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *domain.AlbumRequest) (*domain.Album, error)); ok {
+		return rf(ctx, id, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, *domain.AlbumRequest) *domain.Album); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Album)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, *domain.AlbumRequest) error); ok {
+		r1 = rf(ctx, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AlbumsServiceInterfaceMock_UpdateAlbum_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAlbum'
+type AlbumsServiceInterfaceMock_UpdateAlbum_Call struct {
+	*mock.Call
+}
+
+// UpdateAlbum is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - req *domain.AlbumRequest
+func (_e *AlbumsServiceInterfaceMock_Expecter) UpdateAlbum(ctx interface{}, id interface{}, req interface{}) *AlbumsServiceInterfaceMock_UpdateAlbum_Call {
+	return &AlbumsServiceInterfaceMock_UpdateAlbum_Call{Call: _e.mock.On("UpdateAlbum", ctx, id, req)}
+}
+
+func (_c *AlbumsServiceInterfaceMock_UpdateAlbum_Call) Run(run func(ctx context.Context, id int, req *domain.AlbumRequest)) *AlbumsServiceInterfaceMock_UpdateAlbum_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(*domain.AlbumRequest))
+	})
+	return _c
+}
+
+func (_c *AlbumsServiceInterfaceMock_UpdateAlbum_Call) Return(_a0 *domain.Album, _a1 error) *AlbumsServiceInterfaceMock_UpdateAlbum_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AlbumsServiceInterfaceMock_UpdateAlbum_Call) RunAndReturn(run func(context.Context, int, *domain.AlbumRequest) (*domain.Album, error)) *AlbumsServiceInterfaceMock_UpdateAlbum_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAlbumsServiceInterfaceMock creates a new instance of AlbumsServiceInterfaceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAlbumsServiceInterfaceMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AlbumsServiceInterfaceMock {
+	mock := &AlbumsServiceInterfaceMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
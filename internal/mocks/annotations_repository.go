@@ -0,0 +1,299 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/mashfeii/songs_library/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AnnotationsRepositoryMock is an autogenerated mock type for the AnnotationsRepository type
+type AnnotationsRepositoryMock struct {
+	mock.Mock
+}
+
+type AnnotationsRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AnnotationsRepositoryMock) EXPECT() *AnnotationsRepositoryMock_Expecter {
+	return &AnnotationsRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// AddAnnotation provides a mock function with given fields: ctx, annotation
+func (_m *AnnotationsRepositoryMock) AddAnnotation(ctx context.Context, annotation *domain.Annotation) error {
+	ret := _m.Called(ctx, annotation)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddAnnotation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Annotation) error); ok {
+		r0 = rf(ctx, annotation)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AnnotationsRepositoryMock_AddAnnotation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddAnnotation'
+type AnnotationsRepositoryMock_AddAnnotation_Call struct {
+	*mock.Call
+}
+
+// AddAnnotation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - annotation *domain.Annotation
+func (_e *AnnotationsRepositoryMock_Expecter) AddAnnotation(ctx interface{}, annotation interface{}) *AnnotationsRepositoryMock_AddAnnotation_Call {
+	return &AnnotationsRepositoryMock_AddAnnotation_Call{Call: _e.mock.On("AddAnnotation", ctx, annotation)}
+}
+
+func (_c *AnnotationsRepositoryMock_AddAnnotation_Call) Run(run func(ctx context.Context, annotation *domain.Annotation)) *AnnotationsRepositoryMock_AddAnnotation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.Annotation))
+	})
+	return _c
+}
+
+func (_c *AnnotationsRepositoryMock_AddAnnotation_Call) Return(_a0 error) *AnnotationsRepositoryMock_AddAnnotation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AnnotationsRepositoryMock_AddAnnotation_Call) RunAndReturn(run func(context.Context, *domain.Annotation) error) *AnnotationsRepositoryMock_AddAnnotation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAnnotation provides a mock function with given fields: ctx, songID, id
+func (_m *AnnotationsRepositoryMock) DeleteAnnotation(ctx context.Context, songID int, id int) error {
+	ret := _m.Called(ctx, songID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAnnotation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) error); ok {
+		r0 = rf(ctx, songID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AnnotationsRepositoryMock_DeleteAnnotation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAnnotation'
+type AnnotationsRepositoryMock_DeleteAnnotation_Call struct {
+	*mock.Call
+}
+
+// DeleteAnnotation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - songID int
+//   - id int
+func (_e *AnnotationsRepositoryMock_Expecter) DeleteAnnotation(ctx interface{}, songID interface{}, id interface{}) *AnnotationsRepositoryMock_DeleteAnnotation_Call {
+	return &AnnotationsRepositoryMock_DeleteAnnotation_Call{Call: _e.mock.On("DeleteAnnotation", ctx, songID, id)}
+}
+
+func (_c *AnnotationsRepositoryMock_DeleteAnnotation_Call) Run(run func(ctx context.Context, songID int, id int)) *AnnotationsRepositoryMock_DeleteAnnotation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *AnnotationsRepositoryMock_DeleteAnnotation_Call) Return(_a0 error) *AnnotationsRepositoryMock_DeleteAnnotation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AnnotationsRepositoryMock_DeleteAnnotation_Call) RunAndReturn(run func(context.Context, int, int) error) *AnnotationsRepositoryMock_DeleteAnnotation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAnnotation provides a mock function with given fields: ctx, songID, id
+func (_m *AnnotationsRepositoryMock) GetAnnotation(ctx context.Context, songID int, id int) (*domain.Annotation, error) {
+	ret := _m.Called(ctx, songID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAnnotation")
+	}
+
+	var r0 *domain.Annotation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) (*domain.Annotation, error)); ok {
+		return rf(ctx, songID, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) *domain.Annotation); ok {
+		r0 = rf(ctx, songID, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Annotation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, songID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AnnotationsRepositoryMock_GetAnnotation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAnnotation'
+type AnnotationsRepositoryMock_GetAnnotation_Call struct {
+	*mock.Call
+}
+
+// GetAnnotation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - songID int
+//   - id int
+func (_e *AnnotationsRepositoryMock_Expecter) GetAnnotation(ctx interface{}, songID interface{}, id interface{}) *AnnotationsRepositoryMock_GetAnnotation_Call {
+	return &AnnotationsRepositoryMock_GetAnnotation_Call{Call: _e.mock.On("GetAnnotation", ctx, songID, id)}
+}
+
+func (_c *AnnotationsRepositoryMock_GetAnnotation_Call) Run(run func(ctx context.Context, songID int, id int)) *AnnotationsRepositoryMock_GetAnnotation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *AnnotationsRepositoryMock_GetAnnotation_Call) Return(_a0 *domain.Annotation, _a1 error) *AnnotationsRepositoryMock_GetAnnotation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AnnotationsRepositoryMock_GetAnnotation_Call) RunAndReturn(run func(context.Context, int, int) (*domain.Annotation, error)) *AnnotationsRepositoryMock_GetAnnotation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAnnotations provides a mock function with given fields: ctx, songID
+func (_m *AnnotationsRepositoryMock) GetAnnotations(ctx context.Context, songID int) ([]domain.Annotation, error) {
+	ret := _m.Called(ctx, songID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAnnotations")
+	}
+
+	var r0 []domain.Annotation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]domain.Annotation, error)); ok {
+		return rf(ctx, songID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []domain.Annotation); ok {
+		r0 = rf(ctx, songID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Annotation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, songID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AnnotationsRepositoryMock_GetAnnotations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAnnotations'
+type AnnotationsRepositoryMock_GetAnnotations_Call struct {
+	*mock.Call
+}
+
+// GetAnnotations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - songID int
+func (_e *AnnotationsRepositoryMock_Expecter) GetAnnotations(ctx interface{}, songID interface{}) *AnnotationsRepositoryMock_GetAnnotations_Call {
+	return &AnnotationsRepositoryMock_GetAnnotations_Call{Call: _e.mock.On("GetAnnotations", ctx, songID)}
+}
+
+func (_c *AnnotationsRepositoryMock_GetAnnotations_Call) Run(run func(ctx context.Context, songID int)) *AnnotationsRepositoryMock_GetAnnotations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *AnnotationsRepositoryMock_GetAnnotations_Call) Return(_a0 []domain.Annotation, _a1 error) *AnnotationsRepositoryMock_GetAnnotations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AnnotationsRepositoryMock_GetAnnotations_Call) RunAndReturn(run func(context.Context, int) ([]domain.Annotation, error)) *AnnotationsRepositoryMock_GetAnnotations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateAnnotation provides a mock function with given fields: ctx, annotation
+func (_m *AnnotationsRepositoryMock) UpdateAnnotation(ctx context.Context, annotation *domain.Annotation) error {
+	ret := _m.Called(ctx, annotation)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAnnotation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Annotation) error); ok {
+		r0 = rf(ctx, annotation)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AnnotationsRepositoryMock_UpdateAnnotation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAnnotation'
+type AnnotationsRepositoryMock_UpdateAnnotation_Call struct {
+	*mock.Call
+}
+
+// UpdateAnnotation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - annotation *domain.Annotation
+func (_e *AnnotationsRepositoryMock_Expecter) UpdateAnnotation(ctx interface{}, annotation interface{}) *AnnotationsRepositoryMock_UpdateAnnotation_Call {
+	return &AnnotationsRepositoryMock_UpdateAnnotation_Call{Call: _e.mock.On("UpdateAnnotation", ctx, annotation)}
+}
+
+func (_c *AnnotationsRepositoryMock_UpdateAnnotation_Call) Run(run func(ctx context.Context, annotation *domain.Annotation)) *AnnotationsRepositoryMock_UpdateAnnotation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.Annotation))
+	})
+	return _c
+}
+
+func (_c *AnnotationsRepositoryMock_UpdateAnnotation_Call) Return(_a0 error) *AnnotationsRepositoryMock_UpdateAnnotation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AnnotationsRepositoryMock_UpdateAnnotation_Call) RunAndReturn(run func(context.Context, *domain.Annotation) error) *AnnotationsRepositoryMock_UpdateAnnotation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAnnotationsRepositoryMock creates a new instance of AnnotationsRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAnnotationsRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AnnotationsRepositoryMock {
+	mock := &AnnotationsRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,325 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/mashfeii/songs_library/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AnnotationsServiceInterfaceMock is an autogenerated mock type for the AnnotationsServiceInterface type
+type AnnotationsServiceInterfaceMock struct {
+	mock.Mock
+}
+
+type AnnotationsServiceInterfaceMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AnnotationsServiceInterfaceMock) EXPECT() *AnnotationsServiceInterfaceMock_Expecter {
+	return &AnnotationsServiceInterfaceMock_Expecter{mock: &_m.Mock}
+}
+
+// AddAnnotation provides a mock function with given fields: ctx, songID, req
+func (_m *AnnotationsServiceInterfaceMock) AddAnnotation(ctx context.Context, songID int, req *domain.AnnotationRequest) (*domain.Annotation, error) {
+	ret := _m.Called(ctx, songID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddAnnotation")
+	}
+
+	var r0 *domain.Annotation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *domain.AnnotationRequest) (*domain.Annotation, error)); ok {
+		return rf(ctx, songID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, *domain.AnnotationRequest) *domain.Annotation); ok {
+		r0 = rf(ctx, songID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Annotation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, *domain.AnnotationRequest) error); ok {
+		r1 = rf(ctx, songID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AnnotationsServiceInterfaceMock_AddAnnotation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddAnnotation'
+type AnnotationsServiceInterfaceMock_AddAnnotation_Call struct {
+	*mock.Call
+}
+
+// AddAnnotation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - songID int
+//   - req *domain.AnnotationRequest
+func (_e *AnnotationsServiceInterfaceMock_Expecter) AddAnnotation(ctx interface{}, songID interface{}, req interface{}) *AnnotationsServiceInterfaceMock_AddAnnotation_Call {
+	return &AnnotationsServiceInterfaceMock_AddAnnotation_Call{Call: _e.mock.On("AddAnnotation", ctx, songID, req)}
+}
+
+func (_c *AnnotationsServiceInterfaceMock_AddAnnotation_Call) Run(run func(ctx context.Context, songID int, req *domain.AnnotationRequest)) *AnnotationsServiceInterfaceMock_AddAnnotation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(*domain.AnnotationRequest))
+	})
+	return _c
+}
+
+func (_c *AnnotationsServiceInterfaceMock_AddAnnotation_Call) Return(_a0 *domain.Annotation, _a1 error) *AnnotationsServiceInterfaceMock_AddAnnotation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AnnotationsServiceInterfaceMock_AddAnnotation_Call) RunAndReturn(run func(context.Context, int, *domain.AnnotationRequest) (*domain.Annotation, error)) *AnnotationsServiceInterfaceMock_AddAnnotation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAnnotation provides a mock function with given fields: ctx, songID, id
+func (_m *AnnotationsServiceInterfaceMock) DeleteAnnotation(ctx context.Context, songID int, id int) error {
+	ret := _m.Called(ctx, songID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAnnotation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) error); ok {
+		r0 = rf(ctx, songID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AnnotationsServiceInterfaceMock_DeleteAnnotation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAnnotation'
+type AnnotationsServiceInterfaceMock_DeleteAnnotation_Call struct {
+	*mock.Call
+}
+
+// DeleteAnnotation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - songID int
+//   - id int
+func (_e *AnnotationsServiceInterfaceMock_Expecter) DeleteAnnotation(ctx interface{}, songID interface{}, id interface{}) *AnnotationsServiceInterfaceMock_DeleteAnnotation_Call {
+	return &AnnotationsServiceInterfaceMock_DeleteAnnotation_Call{Call: _e.mock.On("DeleteAnnotation", ctx, songID, id)}
+}
+
+func (_c *AnnotationsServiceInterfaceMock_DeleteAnnotation_Call) Run(run func(ctx context.Context, songID int, id int)) *AnnotationsServiceInterfaceMock_DeleteAnnotation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *AnnotationsServiceInterfaceMock_DeleteAnnotation_Call) Return(_a0 error) *AnnotationsServiceInterfaceMock_DeleteAnnotation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AnnotationsServiceInterfaceMock_DeleteAnnotation_Call) RunAndReturn(run func(context.Context, int, int) error) *AnnotationsServiceInterfaceMock_DeleteAnnotation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAnnotation provides a mock function with given fields: ctx, songID, id
+func (_m *AnnotationsServiceInterfaceMock) GetAnnotation(ctx context.Context, songID int, id int) (*domain.Annotation, error) {
+	ret := _m.Called(ctx, songID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAnnotation")
+	}
+
+	var r0 *domain.Annotation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) (*domain.Annotation, error)); ok {
+		return rf(ctx, songID, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) *domain.Annotation); ok {
+		r0 = rf(ctx, songID, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Annotation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, songID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AnnotationsServiceInterfaceMock_GetAnnotation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAnnotation'
+type AnnotationsServiceInterfaceMock_GetAnnotation_Call struct {
+	*mock.Call
+}
+
+// GetAnnotation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - songID int
+//   - id int
+func (_e *AnnotationsServiceInterfaceMock_Expecter) GetAnnotation(ctx interface{}, songID interface{}, id interface{}) *AnnotationsServiceInterfaceMock_GetAnnotation_Call {
+	return &AnnotationsServiceInterfaceMock_GetAnnotation_Call{Call: _e.mock.On("GetAnnotation", ctx, songID, id)}
+}
+
+func (_c *AnnotationsServiceInterfaceMock_GetAnnotation_Call) Run(run func(ctx context.Context, songID int, id int)) *AnnotationsServiceInterfaceMock_GetAnnotation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *AnnotationsServiceInterfaceMock_GetAnnotation_Call) Return(_a0 *domain.Annotation, _a1 error) *AnnotationsServiceInterfaceMock_GetAnnotation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AnnotationsServiceInterfaceMock_GetAnnotation_Call) RunAndReturn(run func(context.Context, int, int) (*domain.Annotation, error)) *AnnotationsServiceInterfaceMock_GetAnnotation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAnnotations provides a mock function with given fields: ctx, songID
+func (_m *AnnotationsServiceInterfaceMock) GetAnnotations(ctx context.Context, songID int) ([]domain.Annotation, error) {
+	ret := _m.Called(ctx, songID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAnnotations")
+	}
+
+	var r0 []domain.Annotation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]domain.Annotation, error)); ok {
+		return rf(ctx, songID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []domain.Annotation); ok {
+		r0 = rf(ctx, songID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Annotation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, songID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AnnotationsServiceInterfaceMock_GetAnnotations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAnnotations'
+type AnnotationsServiceInterfaceMock_GetAnnotations_Call struct {
+	*mock.Call
+}
+
+// GetAnnotations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - songID int
+func (_e *AnnotationsServiceInterfaceMock_Expecter) GetAnnotations(ctx interface{}, songID interface{}) *AnnotationsServiceInterfaceMock_GetAnnotations_Call {
+	return &AnnotationsServiceInterfaceMock_GetAnnotations_Call{Call: _e.mock.On("GetAnnotations", ctx, songID)}
+}
+
+func (_c *AnnotationsServiceInterfaceMock_GetAnnotations_Call) Run(run func(ctx context.Context, songID int)) *AnnotationsServiceInterfaceMock_GetAnnotations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *AnnotationsServiceInterfaceMock_GetAnnotations_Call) Return(_a0 []domain.Annotation, _a1 error) *AnnotationsServiceInterfaceMock_GetAnnotations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AnnotationsServiceInterfaceMock_GetAnnotations_Call) RunAndReturn(run func(context.Context, int) ([]domain.Annotation, error)) *AnnotationsServiceInterfaceMock_GetAnnotations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateAnnotation provides a mock function with given fields: ctx, songID, id, req
+func (_m *AnnotationsServiceInterfaceMock) UpdateAnnotation(ctx context.Context, songID int, id int, req *domain.AnnotationRequest) (*domain.Annotation, error) {
+	ret := _m.Called(ctx, songID, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAnnotation")
+	}
+
+	var r0 *domain.Annotation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, *domain.AnnotationRequest) (*domain.Annotation, error)); ok {
+		return rf(ctx, songID, id, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, *domain.AnnotationRequest) *domain.Annotation); ok {
+		r0 = rf(ctx, songID, id, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Annotation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, *domain.AnnotationRequest) error); ok {
+		r1 = rf(ctx, songID, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AnnotationsServiceInterfaceMock_UpdateAnnotation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAnnotation'
+type AnnotationsServiceInterfaceMock_UpdateAnnotation_Call struct {
+	*mock.Call
+}
+
+// UpdateAnnotation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - songID int
+//   - id int
+//   - req *domain.AnnotationRequest
+func (_e *AnnotationsServiceInterfaceMock_Expecter) UpdateAnnotation(ctx interface{}, songID interface{}, id interface{}, req interface{}) *AnnotationsServiceInterfaceMock_UpdateAnnotation_Call {
+	return &AnnotationsServiceInterfaceMock_UpdateAnnotation_Call{Call: _e.mock.On("UpdateAnnotation", ctx, songID, id, req)}
+}
+
+func (_c *AnnotationsServiceInterfaceMock_UpdateAnnotation_Call) Run(run func(ctx context.Context, songID int, id int, req *domain.AnnotationRequest)) *AnnotationsServiceInterfaceMock_UpdateAnnotation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int), args[3].(*domain.AnnotationRequest))
+	})
+	return _c
+}
+
+func (_c *AnnotationsServiceInterfaceMock_UpdateAnnotation_Call) Return(_a0 *domain.Annotation, _a1 error) *AnnotationsServiceInterfaceMock_UpdateAnnotation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AnnotationsServiceInterfaceMock_UpdateAnnotation_Call) RunAndReturn(run func(context.Context, int, int, *domain.AnnotationRequest) (*domain.Annotation, error)) *AnnotationsServiceInterfaceMock_UpdateAnnotation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAnnotationsServiceInterfaceMock creates a new instance of AnnotationsServiceInterfaceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAnnotationsServiceInterfaceMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AnnotationsServiceInterfaceMock {
+	mock := &AnnotationsServiceInterfaceMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,145 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/mashfeii/songs_library/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AuditRepositoryMock is an autogenerated mock type for the AuditRepository type
+type AuditRepositoryMock struct {
+	mock.Mock
+}
+
+type AuditRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AuditRepositoryMock) EXPECT() *AuditRepositoryMock_Expecter {
+	return &AuditRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// AddAuditEvent provides a mock function with given fields: ctx, event
+func (_m *AuditRepositoryMock) AddAuditEvent(ctx context.Context, event *domain.AuditEvent) error {
+	ret := _m.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddAuditEvent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.AuditEvent) error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuditRepositoryMock_AddAuditEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddAuditEvent'
+type AuditRepositoryMock_AddAuditEvent_Call struct {
+	*mock.Call
+}
+
+// AddAuditEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event *domain.AuditEvent
+func (_e *AuditRepositoryMock_Expecter) AddAuditEvent(ctx interface{}, event interface{}) *AuditRepositoryMock_AddAuditEvent_Call {
+	return &AuditRepositoryMock_AddAuditEvent_Call{Call: _e.mock.On("AddAuditEvent", ctx, event)}
+}
+
+func (_c *AuditRepositoryMock_AddAuditEvent_Call) Run(run func(ctx context.Context, event *domain.AuditEvent)) *AuditRepositoryMock_AddAuditEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.AuditEvent))
+	})
+	return _c
+}
+
+func (_c *AuditRepositoryMock_AddAuditEvent_Call) Return(_a0 error) *AuditRepositoryMock_AddAuditEvent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AuditRepositoryMock_AddAuditEvent_Call) RunAndReturn(run func(context.Context, *domain.AuditEvent) error) *AuditRepositoryMock_AddAuditEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAuditEvents provides a mock function with given fields: ctx, filter, pageReq
+func (_m *AuditRepositoryMock) GetAuditEvents(ctx context.Context, filter domain.AuditFilter, pageReq domain.PageRequest) (*domain.Page[domain.AuditEvent], error) {
+	ret := _m.Called(ctx, filter, pageReq)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAuditEvents")
+	}
+
+	var r0 *domain.Page[domain.AuditEvent]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.AuditFilter, domain.PageRequest) (*domain.Page[domain.AuditEvent], error)); ok {
+		return rf(ctx, filter, pageReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.AuditFilter, domain.PageRequest) *domain.Page[domain.AuditEvent]); ok {
+		r0 = rf(ctx, filter, pageReq)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Page[domain.AuditEvent])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.AuditFilter, domain.PageRequest) error); ok {
+		r1 = rf(ctx, filter, pageReq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuditRepositoryMock_GetAuditEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAuditEvents'
+type AuditRepositoryMock_GetAuditEvents_Call struct {
+	*mock.Call
+}
+
+// GetAuditEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.AuditFilter
+//   - pageReq domain.PageRequest
+func (_e *AuditRepositoryMock_Expecter) GetAuditEvents(ctx interface{}, filter interface{}, pageReq interface{}) *AuditRepositoryMock_GetAuditEvents_Call {
+	return &AuditRepositoryMock_GetAuditEvents_Call{Call: _e.mock.On("GetAuditEvents", ctx, filter, pageReq)}
+}
+
+func (_c *AuditRepositoryMock_GetAuditEvents_Call) Run(run func(ctx context.Context, filter domain.AuditFilter, pageReq domain.PageRequest)) *AuditRepositoryMock_GetAuditEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.AuditFilter), args[2].(domain.PageRequest))
+	})
+	return _c
+}
+
+func (_c *AuditRepositoryMock_GetAuditEvents_Call) Return(_a0 *domain.Page[domain.AuditEvent], _a1 error) *AuditRepositoryMock_GetAuditEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuditRepositoryMock_GetAuditEvents_Call) RunAndReturn(run func(context.Context, domain.AuditFilter, domain.PageRequest) (*domain.Page[domain.AuditEvent], error)) *AuditRepositoryMock_GetAuditEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAuditRepositoryMock creates a new instance of AuditRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAuditRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AuditRepositoryMock {
+	mock := &AuditRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,112 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	client "github.com/mashfeii/songs_library/internal/api"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ClientWithResponsesInterfaceMock is an autogenerated mock type for the ClientWithResponsesInterface type
+type ClientWithResponsesInterfaceMock struct {
+	mock.Mock
+}
+
+type ClientWithResponsesInterfaceMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ClientWithResponsesInterfaceMock) EXPECT() *ClientWithResponsesInterfaceMock_Expecter {
+	return &ClientWithResponsesInterfaceMock_Expecter{mock: &_m.Mock}
+}
+
+// GetInfoWithResponse provides a mock function with given fields: ctx, params, reqEditors
+func (_m *ClientWithResponsesInterfaceMock) GetInfoWithResponse(ctx context.Context, params *client.GetInfoParams, reqEditors ...client.RequestEditorFn) (*client.GetInfoResponse, error) {
+	_va := make([]interface{}, len(reqEditors))
+	for _i := range reqEditors {
+		_va[_i] = reqEditors[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInfoWithResponse")
+	}
+
+	var r0 *client.GetInfoResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *client.GetInfoParams, ...client.RequestEditorFn) (*client.GetInfoResponse, error)); ok {
+		return rf(ctx, params, reqEditors...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *client.GetInfoParams, ...client.RequestEditorFn) *client.GetInfoResponse); ok {
+		r0 = rf(ctx, params, reqEditors...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.GetInfoResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *client.GetInfoParams, ...client.RequestEditorFn) error); ok {
+		r1 = rf(ctx, params, reqEditors...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClientWithResponsesInterfaceMock_GetInfoWithResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInfoWithResponse'
+type ClientWithResponsesInterfaceMock_GetInfoWithResponse_Call struct {
+	*mock.Call
+}
+
+// GetInfoWithResponse is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *client.GetInfoParams
+//   - reqEditors ...client.RequestEditorFn
+func (_e *ClientWithResponsesInterfaceMock_Expecter) GetInfoWithResponse(ctx interface{}, params interface{}, reqEditors ...interface{}) *ClientWithResponsesInterfaceMock_GetInfoWithResponse_Call {
+	return &ClientWithResponsesInterfaceMock_GetInfoWithResponse_Call{Call: _e.mock.On("GetInfoWithResponse",
+		append([]interface{}{ctx, params}, reqEditors...)...)}
+}
+
+func (_c *ClientWithResponsesInterfaceMock_GetInfoWithResponse_Call) Run(run func(ctx context.Context, params *client.GetInfoParams, reqEditors ...client.RequestEditorFn)) *ClientWithResponsesInterfaceMock_GetInfoWithResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]client.RequestEditorFn, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(client.RequestEditorFn)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*client.GetInfoParams), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ClientWithResponsesInterfaceMock_GetInfoWithResponse_Call) Return(_a0 *client.GetInfoResponse, _a1 error) *ClientWithResponsesInterfaceMock_GetInfoWithResponse_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClientWithResponsesInterfaceMock_GetInfoWithResponse_Call) RunAndReturn(run func(context.Context, *client.GetInfoParams, ...client.RequestEditorFn) (*client.GetInfoResponse, error)) *ClientWithResponsesInterfaceMock_GetInfoWithResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewClientWithResponsesInterfaceMock creates a new instance of ClientWithResponsesInterfaceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewClientWithResponsesInterfaceMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ClientWithResponsesInterfaceMock {
+	mock := &ClientWithResponsesInterfaceMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
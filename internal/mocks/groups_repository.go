@@ -5,6 +5,8 @@ package mocks
 import (
 	context "context"
 
+	domain "github.com/mashfeii/songs_library/internal/domain"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -21,6 +23,490 @@ func (_m *GroupsRepositoryMock) EXPECT() *GroupsRepositoryMock_Expecter {
 	return &GroupsRepositoryMock_Expecter{mock: &_m.Mock}
 }
 
+// AddGroup provides a mock function with given fields: ctx, name
+func (_m *GroupsRepositoryMock) AddGroup(ctx context.Context, name string) (int, error) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddGroup")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GroupsRepositoryMock_AddGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddGroup'
+type GroupsRepositoryMock_AddGroup_Call struct {
+	*mock.Call
+}
+
+// AddGroup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *GroupsRepositoryMock_Expecter) AddGroup(ctx interface{}, name interface{}) *GroupsRepositoryMock_AddGroup_Call {
+	return &GroupsRepositoryMock_AddGroup_Call{Call: _e.mock.On("AddGroup", ctx, name)}
+}
+
+func (_c *GroupsRepositoryMock_AddGroup_Call) Run(run func(ctx context.Context, name string)) *GroupsRepositoryMock_AddGroup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *GroupsRepositoryMock_AddGroup_Call) Return(_a0 int, _a1 error) *GroupsRepositoryMock_AddGroup_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GroupsRepositoryMock_AddGroup_Call) RunAndReturn(run func(context.Context, string) (int, error)) *GroupsRepositoryMock_AddGroup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddGroupAlias provides a mock function with given fields: ctx, id, alias
+func (_m *GroupsRepositoryMock) AddGroupAlias(ctx context.Context, id int, alias string) error {
+	ret := _m.Called(ctx, id, alias)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddGroupAlias")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) error); ok {
+		r0 = rf(ctx, id, alias)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GroupsRepositoryMock_AddGroupAlias_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddGroupAlias'
+type GroupsRepositoryMock_AddGroupAlias_Call struct {
+	*mock.Call
+}
+
+// AddGroupAlias is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - alias string
+func (_e *GroupsRepositoryMock_Expecter) AddGroupAlias(ctx interface{}, id interface{}, alias interface{}) *GroupsRepositoryMock_AddGroupAlias_Call {
+	return &GroupsRepositoryMock_AddGroupAlias_Call{Call: _e.mock.On("AddGroupAlias", ctx, id, alias)}
+}
+
+func (_c *GroupsRepositoryMock_AddGroupAlias_Call) Run(run func(ctx context.Context, id int, alias string)) *GroupsRepositoryMock_AddGroupAlias_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *GroupsRepositoryMock_AddGroupAlias_Call) Return(_a0 error) *GroupsRepositoryMock_AddGroupAlias_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GroupsRepositoryMock_AddGroupAlias_Call) RunAndReturn(run func(context.Context, int, string) error) *GroupsRepositoryMock_AddGroupAlias_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteGroup provides a mock function with given fields: ctx, id, cascade
+func (_m *GroupsRepositoryMock) DeleteGroup(ctx context.Context, id int, cascade bool) error {
+	ret := _m.Called(ctx, id, cascade)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteGroup")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, bool) error); ok {
+		r0 = rf(ctx, id, cascade)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GroupsRepositoryMock_DeleteGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteGroup'
+type GroupsRepositoryMock_DeleteGroup_Call struct {
+	*mock.Call
+}
+
+// DeleteGroup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - cascade bool
+func (_e *GroupsRepositoryMock_Expecter) DeleteGroup(ctx interface{}, id interface{}, cascade interface{}) *GroupsRepositoryMock_DeleteGroup_Call {
+	return &GroupsRepositoryMock_DeleteGroup_Call{Call: _e.mock.On("DeleteGroup", ctx, id, cascade)}
+}
+
+func (_c *GroupsRepositoryMock_DeleteGroup_Call) Run(run func(ctx context.Context, id int, cascade bool)) *GroupsRepositoryMock_DeleteGroup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *GroupsRepositoryMock_DeleteGroup_Call) Return(_a0 error) *GroupsRepositoryMock_DeleteGroup_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GroupsRepositoryMock_DeleteGroup_Call) RunAndReturn(run func(context.Context, int, bool) error) *GroupsRepositoryMock_DeleteGroup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteGroupAlias provides a mock function with given fields: ctx, id, alias
+func (_m *GroupsRepositoryMock) DeleteGroupAlias(ctx context.Context, id int, alias string) error {
+	ret := _m.Called(ctx, id, alias)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteGroupAlias")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) error); ok {
+		r0 = rf(ctx, id, alias)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GroupsRepositoryMock_DeleteGroupAlias_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteGroupAlias'
+type GroupsRepositoryMock_DeleteGroupAlias_Call struct {
+	*mock.Call
+}
+
+// DeleteGroupAlias is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - alias string
+func (_e *GroupsRepositoryMock_Expecter) DeleteGroupAlias(ctx interface{}, id interface{}, alias interface{}) *GroupsRepositoryMock_DeleteGroupAlias_Call {
+	return &GroupsRepositoryMock_DeleteGroupAlias_Call{Call: _e.mock.On("DeleteGroupAlias", ctx, id, alias)}
+}
+
+func (_c *GroupsRepositoryMock_DeleteGroupAlias_Call) Run(run func(ctx context.Context, id int, alias string)) *GroupsRepositoryMock_DeleteGroupAlias_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *GroupsRepositoryMock_DeleteGroupAlias_Call) Return(_a0 error) *GroupsRepositoryMock_DeleteGroupAlias_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GroupsRepositoryMock_DeleteGroupAlias_Call) RunAndReturn(run func(context.Context, int, string) error) *GroupsRepositoryMock_DeleteGroupAlias_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGroupAliases provides a mock function with given fields: ctx, id
+func (_m *GroupsRepositoryMock) GetGroupAliases(ctx context.Context, id int) ([]string, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGroupAliases")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]string, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []string); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GroupsRepositoryMock_GetGroupAliases_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGroupAliases'
+type GroupsRepositoryMock_GetGroupAliases_Call struct {
+	*mock.Call
+}
+
+// GetGroupAliases is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *GroupsRepositoryMock_Expecter) GetGroupAliases(ctx interface{}, id interface{}) *GroupsRepositoryMock_GetGroupAliases_Call {
+	return &GroupsRepositoryMock_GetGroupAliases_Call{Call: _e.mock.On("GetGroupAliases", ctx, id)}
+}
+
+func (_c *GroupsRepositoryMock_GetGroupAliases_Call) Run(run func(ctx context.Context, id int)) *GroupsRepositoryMock_GetGroupAliases_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *GroupsRepositoryMock_GetGroupAliases_Call) Return(_a0 []string, _a1 error) *GroupsRepositoryMock_GetGroupAliases_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GroupsRepositoryMock_GetGroupAliases_Call) RunAndReturn(run func(context.Context, int) ([]string, error)) *GroupsRepositoryMock_GetGroupAliases_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGroupByID provides a mock function with given fields: ctx, id
+func (_m *GroupsRepositoryMock) GetGroupByID(ctx context.Context, id int) (*domain.Group, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGroupByID")
+	}
+
+	var r0 *domain.Group
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*domain.Group, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *domain.Group); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Group)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GroupsRepositoryMock_GetGroupByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGroupByID'
+type GroupsRepositoryMock_GetGroupByID_Call struct {
+	*mock.Call
+}
+
+// GetGroupByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *GroupsRepositoryMock_Expecter) GetGroupByID(ctx interface{}, id interface{}) *GroupsRepositoryMock_GetGroupByID_Call {
+	return &GroupsRepositoryMock_GetGroupByID_Call{Call: _e.mock.On("GetGroupByID", ctx, id)}
+}
+
+func (_c *GroupsRepositoryMock_GetGroupByID_Call) Run(run func(ctx context.Context, id int)) *GroupsRepositoryMock_GetGroupByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *GroupsRepositoryMock_GetGroupByID_Call) Return(_a0 *domain.Group, _a1 error) *GroupsRepositoryMock_GetGroupByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GroupsRepositoryMock_GetGroupByID_Call) RunAndReturn(run func(context.Context, int) (*domain.Group, error)) *GroupsRepositoryMock_GetGroupByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGroups provides a mock function with given fields: ctx, pageReq
+func (_m *GroupsRepositoryMock) GetGroups(ctx context.Context, pageReq domain.PageRequest) (*domain.Page[domain.Group], error) {
+	ret := _m.Called(ctx, pageReq)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGroups")
+	}
+
+	var r0 *domain.Page[domain.Group]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PageRequest) (*domain.Page[domain.Group], error)); ok {
+		return rf(ctx, pageReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PageRequest) *domain.Page[domain.Group]); ok {
+		r0 = rf(ctx, pageReq)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Page[domain.Group])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.PageRequest) error); ok {
+		r1 = rf(ctx, pageReq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GroupsRepositoryMock_GetGroups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGroups'
+type GroupsRepositoryMock_GetGroups_Call struct {
+	*mock.Call
+}
+
+// GetGroups is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pageReq domain.PageRequest
+func (_e *GroupsRepositoryMock_Expecter) GetGroups(ctx interface{}, pageReq interface{}) *GroupsRepositoryMock_GetGroups_Call {
+	return &GroupsRepositoryMock_GetGroups_Call{Call: _e.mock.On("GetGroups", ctx, pageReq)}
+}
+
+func (_c *GroupsRepositoryMock_GetGroups_Call) Run(run func(ctx context.Context, pageReq domain.PageRequest)) *GroupsRepositoryMock_GetGroups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.PageRequest))
+	})
+	return _c
+}
+
+func (_c *GroupsRepositoryMock_GetGroups_Call) Return(_a0 *domain.Page[domain.Group], _a1 error) *GroupsRepositoryMock_GetGroups_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GroupsRepositoryMock_GetGroups_Call) RunAndReturn(run func(context.Context, domain.PageRequest) (*domain.Page[domain.Group], error)) *GroupsRepositoryMock_GetGroups_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MergeGroups provides a mock function with given fields: ctx, targetID, sourceIDs
+func (_m *GroupsRepositoryMock) MergeGroups(ctx context.Context, targetID int, sourceIDs []int) (int, error) {
+	ret := _m.Called(ctx, targetID, sourceIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MergeGroups")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, []int) (int, error)); ok {
+		return rf(ctx, targetID, sourceIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, []int) int); ok {
+		r0 = rf(ctx, targetID, sourceIDs)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, []int) error); ok {
+		r1 = rf(ctx, targetID, sourceIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GroupsRepositoryMock_MergeGroups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MergeGroups'
+type GroupsRepositoryMock_MergeGroups_Call struct {
+	*mock.Call
+}
+
+// MergeGroups is a helper method to define mock.On call
+//   - ctx context.Context
+//   - targetID int
+//   - sourceIDs []int
+func (_e *GroupsRepositoryMock_Expecter) MergeGroups(ctx interface{}, targetID interface{}, sourceIDs interface{}) *GroupsRepositoryMock_MergeGroups_Call {
+	return &GroupsRepositoryMock_MergeGroups_Call{Call: _e.mock.On("MergeGroups", ctx, targetID, sourceIDs)}
+}
+
+func (_c *GroupsRepositoryMock_MergeGroups_Call) Run(run func(ctx context.Context, targetID int, sourceIDs []int)) *GroupsRepositoryMock_MergeGroups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].([]int))
+	})
+	return _c
+}
+
+func (_c *GroupsRepositoryMock_MergeGroups_Call) Return(_a0 int, _a1 error) *GroupsRepositoryMock_MergeGroups_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GroupsRepositoryMock_MergeGroups_Call) RunAndReturn(run func(context.Context, int, []int) (int, error)) *GroupsRepositoryMock_MergeGroups_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenameGroup provides a mock function with given fields: ctx, id, name
+func (_m *GroupsRepositoryMock) RenameGroup(ctx context.Context, id int, name string) error {
+	ret := _m.Called(ctx, id, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenameGroup")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) error); ok {
+		r0 = rf(ctx, id, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GroupsRepositoryMock_RenameGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenameGroup'
+type GroupsRepositoryMock_RenameGroup_Call struct {
+	*mock.Call
+}
+
+// RenameGroup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - name string
+func (_e *GroupsRepositoryMock_Expecter) RenameGroup(ctx interface{}, id interface{}, name interface{}) *GroupsRepositoryMock_RenameGroup_Call {
+	return &GroupsRepositoryMock_RenameGroup_Call{Call: _e.mock.On("RenameGroup", ctx, id, name)}
+}
+
+func (_c *GroupsRepositoryMock_RenameGroup_Call) Run(run func(ctx context.Context, id int, name string)) *GroupsRepositoryMock_RenameGroup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *GroupsRepositoryMock_RenameGroup_Call) Return(_a0 error) *GroupsRepositoryMock_RenameGroup_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GroupsRepositoryMock_RenameGroup_Call) RunAndReturn(run func(context.Context, int, string) error) *GroupsRepositoryMock_RenameGroup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpsertGroup provides a mock function with given fields: ctx, groupName
 func (_m *GroupsRepositoryMock) UpsertGroup(ctx context.Context, groupName string) (int, error) {
 	ret := _m.Called(ctx, groupName)
@@ -0,0 +1,597 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/mashfeii/songs_library/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// GroupsServiceInterfaceMock is an autogenerated mock type for the GroupsServiceInterface type
+type GroupsServiceInterfaceMock struct {
+	mock.Mock
+}
+
+type GroupsServiceInterfaceMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *GroupsServiceInterfaceMock) EXPECT() *GroupsServiceInterfaceMock_Expecter {
+	return &GroupsServiceInterfaceMock_Expecter{mock: &_m.Mock}
+}
+
+// AddGroup provides a mock function with given fields: ctx, name
+func (_m *GroupsServiceInterfaceMock) AddGroup(ctx context.Context, name string) (*domain.Group, error) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddGroup")
+	}
+
+	var r0 *domain.Group
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.Group, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.Group); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Group)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GroupsServiceInterfaceMock_AddGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddGroup'
+type GroupsServiceInterfaceMock_AddGroup_Call struct {
+	*mock.Call
+}
+
+// AddGroup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *GroupsServiceInterfaceMock_Expecter) AddGroup(ctx interface{}, name interface{}) *GroupsServiceInterfaceMock_AddGroup_Call {
+	return &GroupsServiceInterfaceMock_AddGroup_Call{Call: _e.mock.On("AddGroup", ctx, name)}
+}
+
+func (_c *GroupsServiceInterfaceMock_AddGroup_Call) Run(run func(ctx context.Context, name string)) *GroupsServiceInterfaceMock_AddGroup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *GroupsServiceInterfaceMock_AddGroup_Call) Return(_a0 *domain.Group, _a1 error) *GroupsServiceInterfaceMock_AddGroup_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GroupsServiceInterfaceMock_AddGroup_Call) RunAndReturn(run func(context.Context, string) (*domain.Group, error)) *GroupsServiceInterfaceMock_AddGroup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddGroupAlias provides a mock function with given fields: ctx, id, alias
+func (_m *GroupsServiceInterfaceMock) AddGroupAlias(ctx context.Context, id int, alias string) error {
+	ret := _m.Called(ctx, id, alias)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddGroupAlias")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) error); ok {
+		r0 = rf(ctx, id, alias)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GroupsServiceInterfaceMock_AddGroupAlias_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddGroupAlias'
+type GroupsServiceInterfaceMock_AddGroupAlias_Call struct {
+	*mock.Call
+}
+
+// AddGroupAlias is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - alias string
+func (_e *GroupsServiceInterfaceMock_Expecter) AddGroupAlias(ctx interface{}, id interface{}, alias interface{}) *GroupsServiceInterfaceMock_AddGroupAlias_Call {
+	return &GroupsServiceInterfaceMock_AddGroupAlias_Call{Call: _e.mock.On("AddGroupAlias", ctx, id, alias)}
+}
+
+func (_c *GroupsServiceInterfaceMock_AddGroupAlias_Call) Run(run func(ctx context.Context, id int, alias string)) *GroupsServiceInterfaceMock_AddGroupAlias_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *GroupsServiceInterfaceMock_AddGroupAlias_Call) Return(_a0 error) *GroupsServiceInterfaceMock_AddGroupAlias_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GroupsServiceInterfaceMock_AddGroupAlias_Call) RunAndReturn(run func(context.Context, int, string) error) *GroupsServiceInterfaceMock_AddGroupAlias_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteGroup provides a mock function with given fields: ctx, id, cascade
+func (_m *GroupsServiceInterfaceMock) DeleteGroup(ctx context.Context, id int, cascade bool) error {
+	ret := _m.Called(ctx, id, cascade)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteGroup")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, bool) error); ok {
+		r0 = rf(ctx, id, cascade)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GroupsServiceInterfaceMock_DeleteGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteGroup'
+type GroupsServiceInterfaceMock_DeleteGroup_Call struct {
+	*mock.Call
+}
+
+// DeleteGroup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - cascade bool
+func (_e *GroupsServiceInterfaceMock_Expecter) DeleteGroup(ctx interface{}, id interface{}, cascade interface{}) *GroupsServiceInterfaceMock_DeleteGroup_Call {
+	return &GroupsServiceInterfaceMock_DeleteGroup_Call{Call: _e.mock.On("DeleteGroup", ctx, id, cascade)}
+}
+
+func (_c *GroupsServiceInterfaceMock_DeleteGroup_Call) Run(run func(ctx context.Context, id int, cascade bool)) *GroupsServiceInterfaceMock_DeleteGroup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *GroupsServiceInterfaceMock_DeleteGroup_Call) Return(_a0 error) *GroupsServiceInterfaceMock_DeleteGroup_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GroupsServiceInterfaceMock_DeleteGroup_Call) RunAndReturn(run func(context.Context, int, bool) error) *GroupsServiceInterfaceMock_DeleteGroup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteGroupAlias provides a mock function with given fields: ctx, id, alias
+func (_m *GroupsServiceInterfaceMock) DeleteGroupAlias(ctx context.Context, id int, alias string) error {
+	ret := _m.Called(ctx, id, alias)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteGroupAlias")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) error); ok {
+		r0 = rf(ctx, id, alias)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GroupsServiceInterfaceMock_DeleteGroupAlias_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteGroupAlias'
+type GroupsServiceInterfaceMock_DeleteGroupAlias_Call struct {
+	*mock.Call
+}
+
+// DeleteGroupAlias is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - alias string
+func (_e *GroupsServiceInterfaceMock_Expecter) DeleteGroupAlias(ctx interface{}, id interface{}, alias interface{}) *GroupsServiceInterfaceMock_DeleteGroupAlias_Call {
+	return &GroupsServiceInterfaceMock_DeleteGroupAlias_Call{Call: _e.mock.On("DeleteGroupAlias", ctx, id, alias)}
+}
+
+func (_c *GroupsServiceInterfaceMock_DeleteGroupAlias_Call) Run(run func(ctx context.Context, id int, alias string)) *GroupsServiceInterfaceMock_DeleteGroupAlias_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *GroupsServiceInterfaceMock_DeleteGroupAlias_Call) Return(_a0 error) *GroupsServiceInterfaceMock_DeleteGroupAlias_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GroupsServiceInterfaceMock_DeleteGroupAlias_Call) RunAndReturn(run func(context.Context, int, string) error) *GroupsServiceInterfaceMock_DeleteGroupAlias_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGroup provides a mock function with given fields: ctx, id
+func (_m *GroupsServiceInterfaceMock) GetGroup(ctx context.Context, id int) (*domain.Group, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGroup")
+	}
+
+	var r0 *domain.Group
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*domain.Group, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *domain.Group); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Group)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GroupsServiceInterfaceMock_GetGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGroup'
+type GroupsServiceInterfaceMock_GetGroup_Call struct {
+	*mock.Call
+}
+
+// GetGroup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *GroupsServiceInterfaceMock_Expecter) GetGroup(ctx interface{}, id interface{}) *GroupsServiceInterfaceMock_GetGroup_Call {
+	return &GroupsServiceInterfaceMock_GetGroup_Call{Call: _e.mock.On("GetGroup", ctx, id)}
+}
+
+func (_c *GroupsServiceInterfaceMock_GetGroup_Call) Run(run func(ctx context.Context, id int)) *GroupsServiceInterfaceMock_GetGroup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *GroupsServiceInterfaceMock_GetGroup_Call) Return(_a0 *domain.Group, _a1 error) *GroupsServiceInterfaceMock_GetGroup_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GroupsServiceInterfaceMock_GetGroup_Call) RunAndReturn(run func(context.Context, int) (*domain.Group, error)) *GroupsServiceInterfaceMock_GetGroup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGroupAliases provides a mock function with given fields: ctx, id
+func (_m *GroupsServiceInterfaceMock) GetGroupAliases(ctx context.Context, id int) ([]string, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGroupAliases")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]string, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []string); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GroupsServiceInterfaceMock_GetGroupAliases_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGroupAliases'
+type GroupsServiceInterfaceMock_GetGroupAliases_Call struct {
+	*mock.Call
+}
+
+// GetGroupAliases is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *GroupsServiceInterfaceMock_Expecter) GetGroupAliases(ctx interface{}, id interface{}) *GroupsServiceInterfaceMock_GetGroupAliases_Call {
+	return &GroupsServiceInterfaceMock_GetGroupAliases_Call{Call: _e.mock.On("GetGroupAliases", ctx, id)}
+}
+
+func (_c *GroupsServiceInterfaceMock_GetGroupAliases_Call) Run(run func(ctx context.Context, id int)) *GroupsServiceInterfaceMock_GetGroupAliases_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *GroupsServiceInterfaceMock_GetGroupAliases_Call) Return(_a0 []string, _a1 error) *GroupsServiceInterfaceMock_GetGroupAliases_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GroupsServiceInterfaceMock_GetGroupAliases_Call) RunAndReturn(run func(context.Context, int) ([]string, error)) *GroupsServiceInterfaceMock_GetGroupAliases_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGroupSongs provides a mock function with given fields: ctx, id, pageReq
+func (_m *GroupsServiceInterfaceMock) GetGroupSongs(ctx context.Context, id int, pageReq domain.PageRequest) (*domain.Page[domain.Song], error) {
+	ret := _m.Called(ctx, id, pageReq)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGroupSongs")
+	}
+
+	var r0 *domain.Page[domain.Song]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, domain.PageRequest) (*domain.Page[domain.Song], error)); ok {
+		return rf(ctx, id, pageReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, domain.PageRequest) *domain.Page[domain.Song]); ok {
+		r0 = rf(ctx, id, pageReq)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Page[domain.Song])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, domain.PageRequest) error); ok {
+		r1 = rf(ctx, id, pageReq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GroupsServiceInterfaceMock_GetGroupSongs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGroupSongs'
+type GroupsServiceInterfaceMock_GetGroupSongs_Call struct {
+	*mock.Call
+}
+
+// GetGroupSongs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - pageReq domain.PageRequest
+func (_e *GroupsServiceInterfaceMock_Expecter) GetGroupSongs(ctx interface{}, id interface{}, pageReq interface{}) *GroupsServiceInterfaceMock_GetGroupSongs_Call {
+	return &GroupsServiceInterfaceMock_GetGroupSongs_Call{Call: _e.mock.On("GetGroupSongs", ctx, id, pageReq)}
+}
+
+func (_c *GroupsServiceInterfaceMock_GetGroupSongs_Call) Run(run func(ctx context.Context, id int, pageReq domain.PageRequest)) *GroupsServiceInterfaceMock_GetGroupSongs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(domain.PageRequest))
+	})
+	return _c
+}
+
+func (_c *GroupsServiceInterfaceMock_GetGroupSongs_Call) Return(_a0 *domain.Page[domain.Song], _a1 error) *GroupsServiceInterfaceMock_GetGroupSongs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GroupsServiceInterfaceMock_GetGroupSongs_Call) RunAndReturn(run func(context.Context, int, domain.PageRequest) (*domain.Page[domain.Song], error)) *GroupsServiceInterfaceMock_GetGroupSongs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGroups provides a mock function with given fields: ctx, pageReq
+func (_m *GroupsServiceInterfaceMock) GetGroups(ctx context.Context, pageReq domain.PageRequest) (*domain.Page[domain.Group], error) {
+	ret := _m.Called(ctx, pageReq)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGroups")
+	}
+
+	var r0 *domain.Page[domain.Group]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PageRequest) (*domain.Page[domain.Group], error)); ok {
+		return rf(ctx, pageReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PageRequest) *domain.Page[domain.Group]); ok {
+		r0 = rf(ctx, pageReq)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Page[domain.Group])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.PageRequest) error); ok {
+		r1 = rf(ctx, pageReq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GroupsServiceInterfaceMock_GetGroups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGroups'
+type GroupsServiceInterfaceMock_GetGroups_Call struct {
+	*mock.Call
+}
+
+// GetGroups is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pageReq domain.PageRequest
+func (_e *GroupsServiceInterfaceMock_Expecter) GetGroups(ctx interface{}, pageReq interface{}) *GroupsServiceInterfaceMock_GetGroups_Call {
+	return &GroupsServiceInterfaceMock_GetGroups_Call{Call: _e.mock.On("GetGroups", ctx, pageReq)}
+}
+
+func (_c *GroupsServiceInterfaceMock_GetGroups_Call) Run(run func(ctx context.Context, pageReq domain.PageRequest)) *GroupsServiceInterfaceMock_GetGroups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.PageRequest))
+	})
+	return _c
+}
+
+func (_c *GroupsServiceInterfaceMock_GetGroups_Call) Return(_a0 *domain.Page[domain.Group], _a1 error) *GroupsServiceInterfaceMock_GetGroups_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GroupsServiceInterfaceMock_GetGroups_Call) RunAndReturn(run func(context.Context, domain.PageRequest) (*domain.Page[domain.Group], error)) *GroupsServiceInterfaceMock_GetGroups_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MergeGroups provides a mock function with given fields: ctx, targetID, sourceIDs
+func (_m *GroupsServiceInterfaceMock) MergeGroups(ctx context.Context, targetID int, sourceIDs []int) (*domain.MergeGroupsResponse, error) {
+	ret := _m.Called(ctx, targetID, sourceIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MergeGroups")
+	}
+
+	var r0 *domain.MergeGroupsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, []int) (*domain.MergeGroupsResponse, error)); ok {
+		return rf(ctx, targetID, sourceIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, []int) *domain.MergeGroupsResponse); ok {
+		r0 = rf(ctx, targetID, sourceIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.MergeGroupsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, []int) error); ok {
+		r1 = rf(ctx, targetID, sourceIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GroupsServiceInterfaceMock_MergeGroups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MergeGroups'
+type GroupsServiceInterfaceMock_MergeGroups_Call struct {
+	*mock.Call
+}
+
+// MergeGroups is a helper method to define mock.On call
+//   - ctx context.Context
+//   - targetID int
+//   - sourceIDs []int
+func (_e *GroupsServiceInterfaceMock_Expecter) MergeGroups(ctx interface{}, targetID interface{}, sourceIDs interface{}) *GroupsServiceInterfaceMock_MergeGroups_Call {
+	return &GroupsServiceInterfaceMock_MergeGroups_Call{Call: _e.mock.On("MergeGroups", ctx, targetID, sourceIDs)}
+}
+
+func (_c *GroupsServiceInterfaceMock_MergeGroups_Call) Run(run func(ctx context.Context, targetID int, sourceIDs []int)) *GroupsServiceInterfaceMock_MergeGroups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].([]int))
+	})
+	return _c
+}
+
+func (_c *GroupsServiceInterfaceMock_MergeGroups_Call) Return(_a0 *domain.MergeGroupsResponse, _a1 error) *GroupsServiceInterfaceMock_MergeGroups_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GroupsServiceInterfaceMock_MergeGroups_Call) RunAndReturn(run func(context.Context, int, []int) (*domain.MergeGroupsResponse, error)) *GroupsServiceInterfaceMock_MergeGroups_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenameGroup provides a mock function with given fields: ctx, id, name
+func (_m *GroupsServiceInterfaceMock) RenameGroup(ctx context.Context, id int, name string) (*domain.Group, error) {
+	ret := _m.Called(ctx, id, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenameGroup")
+	}
+
+	var r0 *domain.Group
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) (*domain.Group, error)); ok {
+		return rf(ctx, id, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) *domain.Group); ok {
+		r0 = rf(ctx, id, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Group)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, string) error); ok {
+		r1 = rf(ctx, id, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GroupsServiceInterfaceMock_RenameGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenameGroup'
+type GroupsServiceInterfaceMock_RenameGroup_Call struct {
+	*mock.Call
+}
+
+// RenameGroup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - name string
+func (_e *GroupsServiceInterfaceMock_Expecter) RenameGroup(ctx interface{}, id interface{}, name interface{}) *GroupsServiceInterfaceMock_RenameGroup_Call {
+	return &GroupsServiceInterfaceMock_RenameGroup_Call{Call: _e.mock.On("RenameGroup", ctx, id, name)}
+}
+
+func (_c *GroupsServiceInterfaceMock_RenameGroup_Call) Run(run func(ctx context.Context, id int, name string)) *GroupsServiceInterfaceMock_RenameGroup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *GroupsServiceInterfaceMock_RenameGroup_Call) Return(_a0 *domain.Group, _a1 error) *GroupsServiceInterfaceMock_RenameGroup_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GroupsServiceInterfaceMock_RenameGroup_Call) RunAndReturn(run func(context.Context, int, string) (*domain.Group, error)) *GroupsServiceInterfaceMock_RenameGroup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewGroupsServiceInterfaceMock creates a new instance of GroupsServiceInterfaceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewGroupsServiceInterfaceMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *GroupsServiceInterfaceMock {
+	mock := &GroupsServiceInterfaceMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,264 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/mashfeii/songs_library/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// InfoCacheRepositoryMock is an autogenerated mock type for the InfoCacheRepository type
+type InfoCacheRepositoryMock struct {
+	mock.Mock
+}
+
+type InfoCacheRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *InfoCacheRepositoryMock) EXPECT() *InfoCacheRepositoryMock_Expecter {
+	return &InfoCacheRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// DeleteInfoCacheEntries provides a mock function with given fields: ctx, filter, policy
+func (_m *InfoCacheRepositoryMock) DeleteInfoCacheEntries(ctx context.Context, filter domain.InfoCacheFilter, policy domain.InfoCachePolicy) (int, error) {
+	ret := _m.Called(ctx, filter, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteInfoCacheEntries")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.InfoCacheFilter, domain.InfoCachePolicy) (int, error)); ok {
+		return rf(ctx, filter, policy)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.InfoCacheFilter, domain.InfoCachePolicy) int); ok {
+		r0 = rf(ctx, filter, policy)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.InfoCacheFilter, domain.InfoCachePolicy) error); ok {
+		r1 = rf(ctx, filter, policy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InfoCacheRepositoryMock_DeleteInfoCacheEntries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteInfoCacheEntries'
+type InfoCacheRepositoryMock_DeleteInfoCacheEntries_Call struct {
+	*mock.Call
+}
+
+// DeleteInfoCacheEntries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.InfoCacheFilter
+//   - policy domain.InfoCachePolicy
+func (_e *InfoCacheRepositoryMock_Expecter) DeleteInfoCacheEntries(ctx interface{}, filter interface{}, policy interface{}) *InfoCacheRepositoryMock_DeleteInfoCacheEntries_Call {
+	return &InfoCacheRepositoryMock_DeleteInfoCacheEntries_Call{Call: _e.mock.On("DeleteInfoCacheEntries", ctx, filter, policy)}
+}
+
+func (_c *InfoCacheRepositoryMock_DeleteInfoCacheEntries_Call) Run(run func(ctx context.Context, filter domain.InfoCacheFilter, policy domain.InfoCachePolicy)) *InfoCacheRepositoryMock_DeleteInfoCacheEntries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.InfoCacheFilter), args[2].(domain.InfoCachePolicy))
+	})
+	return _c
+}
+
+func (_c *InfoCacheRepositoryMock_DeleteInfoCacheEntries_Call) Return(_a0 int, _a1 error) *InfoCacheRepositoryMock_DeleteInfoCacheEntries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InfoCacheRepositoryMock_DeleteInfoCacheEntries_Call) RunAndReturn(run func(context.Context, domain.InfoCacheFilter, domain.InfoCachePolicy) (int, error)) *InfoCacheRepositoryMock_DeleteInfoCacheEntries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetInfoCacheEntries provides a mock function with given fields: ctx, filter, policy, pageReq
+func (_m *InfoCacheRepositoryMock) GetInfoCacheEntries(ctx context.Context, filter domain.InfoCacheFilter, policy domain.InfoCachePolicy, pageReq domain.PageRequest) (*domain.Page[domain.InfoCacheEntry], error) {
+	ret := _m.Called(ctx, filter, policy, pageReq)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInfoCacheEntries")
+	}
+
+	var r0 *domain.Page[domain.InfoCacheEntry]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.InfoCacheFilter, domain.InfoCachePolicy, domain.PageRequest) (*domain.Page[domain.InfoCacheEntry], error)); ok {
+		return rf(ctx, filter, policy, pageReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.InfoCacheFilter, domain.InfoCachePolicy, domain.PageRequest) *domain.Page[domain.InfoCacheEntry]); ok {
+		r0 = rf(ctx, filter, policy, pageReq)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Page[domain.InfoCacheEntry])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.InfoCacheFilter, domain.InfoCachePolicy, domain.PageRequest) error); ok {
+		r1 = rf(ctx, filter, policy, pageReq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InfoCacheRepositoryMock_GetInfoCacheEntries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInfoCacheEntries'
+type InfoCacheRepositoryMock_GetInfoCacheEntries_Call struct {
+	*mock.Call
+}
+
+// GetInfoCacheEntries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.InfoCacheFilter
+//   - policy domain.InfoCachePolicy
+//   - pageReq domain.PageRequest
+func (_e *InfoCacheRepositoryMock_Expecter) GetInfoCacheEntries(ctx interface{}, filter interface{}, policy interface{}, pageReq interface{}) *InfoCacheRepositoryMock_GetInfoCacheEntries_Call {
+	return &InfoCacheRepositoryMock_GetInfoCacheEntries_Call{Call: _e.mock.On("GetInfoCacheEntries", ctx, filter, policy, pageReq)}
+}
+
+func (_c *InfoCacheRepositoryMock_GetInfoCacheEntries_Call) Run(run func(ctx context.Context, filter domain.InfoCacheFilter, policy domain.InfoCachePolicy, pageReq domain.PageRequest)) *InfoCacheRepositoryMock_GetInfoCacheEntries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.InfoCacheFilter), args[2].(domain.InfoCachePolicy), args[3].(domain.PageRequest))
+	})
+	return _c
+}
+
+func (_c *InfoCacheRepositoryMock_GetInfoCacheEntries_Call) Return(_a0 *domain.Page[domain.InfoCacheEntry], _a1 error) *InfoCacheRepositoryMock_GetInfoCacheEntries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InfoCacheRepositoryMock_GetInfoCacheEntries_Call) RunAndReturn(run func(context.Context, domain.InfoCacheFilter, domain.InfoCachePolicy, domain.PageRequest) (*domain.Page[domain.InfoCacheEntry], error)) *InfoCacheRepositoryMock_GetInfoCacheEntries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetInfoCacheEntry provides a mock function with given fields: ctx, group, song
+func (_m *InfoCacheRepositoryMock) GetInfoCacheEntry(ctx context.Context, group string, song string) (*domain.InfoCacheEntry, error) {
+	ret := _m.Called(ctx, group, song)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInfoCacheEntry")
+	}
+
+	var r0 *domain.InfoCacheEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*domain.InfoCacheEntry, error)); ok {
+		return rf(ctx, group, song)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *domain.InfoCacheEntry); ok {
+		r0 = rf(ctx, group, song)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.InfoCacheEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, group, song)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InfoCacheRepositoryMock_GetInfoCacheEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInfoCacheEntry'
+type InfoCacheRepositoryMock_GetInfoCacheEntry_Call struct {
+	*mock.Call
+}
+
+// GetInfoCacheEntry is a helper method to define mock.On call
+//   - ctx context.Context
+//   - group string
+//   - song string
+func (_e *InfoCacheRepositoryMock_Expecter) GetInfoCacheEntry(ctx interface{}, group interface{}, song interface{}) *InfoCacheRepositoryMock_GetInfoCacheEntry_Call {
+	return &InfoCacheRepositoryMock_GetInfoCacheEntry_Call{Call: _e.mock.On("GetInfoCacheEntry", ctx, group, song)}
+}
+
+func (_c *InfoCacheRepositoryMock_GetInfoCacheEntry_Call) Run(run func(ctx context.Context, group string, song string)) *InfoCacheRepositoryMock_GetInfoCacheEntry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *InfoCacheRepositoryMock_GetInfoCacheEntry_Call) Return(_a0 *domain.InfoCacheEntry, _a1 error) *InfoCacheRepositoryMock_GetInfoCacheEntry_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InfoCacheRepositoryMock_GetInfoCacheEntry_Call) RunAndReturn(run func(context.Context, string, string) (*domain.InfoCacheEntry, error)) *InfoCacheRepositoryMock_GetInfoCacheEntry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveInfoCacheEntry provides a mock function with given fields: ctx, entry
+func (_m *InfoCacheRepositoryMock) SaveInfoCacheEntry(ctx context.Context, entry *domain.InfoCacheEntry) error {
+	ret := _m.Called(ctx, entry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveInfoCacheEntry")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.InfoCacheEntry) error); ok {
+		r0 = rf(ctx, entry)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InfoCacheRepositoryMock_SaveInfoCacheEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveInfoCacheEntry'
+type InfoCacheRepositoryMock_SaveInfoCacheEntry_Call struct {
+	*mock.Call
+}
+
+// SaveInfoCacheEntry is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entry *domain.InfoCacheEntry
+func (_e *InfoCacheRepositoryMock_Expecter) SaveInfoCacheEntry(ctx interface{}, entry interface{}) *InfoCacheRepositoryMock_SaveInfoCacheEntry_Call {
+	return &InfoCacheRepositoryMock_SaveInfoCacheEntry_Call{Call: _e.mock.On("SaveInfoCacheEntry", ctx, entry)}
+}
+
+func (_c *InfoCacheRepositoryMock_SaveInfoCacheEntry_Call) Run(run func(ctx context.Context, entry *domain.InfoCacheEntry)) *InfoCacheRepositoryMock_SaveInfoCacheEntry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.InfoCacheEntry))
+	})
+	return _c
+}
+
+func (_c *InfoCacheRepositoryMock_SaveInfoCacheEntry_Call) Return(_a0 error) *InfoCacheRepositoryMock_SaveInfoCacheEntry_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InfoCacheRepositoryMock_SaveInfoCacheEntry_Call) RunAndReturn(run func(context.Context, *domain.InfoCacheEntry) error) *InfoCacheRepositoryMock_SaveInfoCacheEntry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewInfoCacheRepositoryMock creates a new instance of InfoCacheRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewInfoCacheRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *InfoCacheRepositoryMock {
+	mock := &InfoCacheRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
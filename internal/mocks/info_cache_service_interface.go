@@ -0,0 +1,154 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/mashfeii/songs_library/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// InfoCacheServiceInterfaceMock is an autogenerated mock type for the InfoCacheServiceInterface type
+type InfoCacheServiceInterfaceMock struct {
+	mock.Mock
+}
+
+type InfoCacheServiceInterfaceMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *InfoCacheServiceInterfaceMock) EXPECT() *InfoCacheServiceInterfaceMock_Expecter {
+	return &InfoCacheServiceInterfaceMock_Expecter{mock: &_m.Mock}
+}
+
+// GetEntries provides a mock function with given fields: ctx, filter, pageReq
+func (_m *InfoCacheServiceInterfaceMock) GetEntries(ctx context.Context, filter domain.InfoCacheFilter, pageReq domain.PageRequest) (*domain.Page[domain.InfoCacheEntry], error) {
+	ret := _m.Called(ctx, filter, pageReq)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEntries")
+	}
+
+	var r0 *domain.Page[domain.InfoCacheEntry]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.InfoCacheFilter, domain.PageRequest) (*domain.Page[domain.InfoCacheEntry], error)); ok {
+		return rf(ctx, filter, pageReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.InfoCacheFilter, domain.PageRequest) *domain.Page[domain.InfoCacheEntry]); ok {
+		r0 = rf(ctx, filter, pageReq)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Page[domain.InfoCacheEntry])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.InfoCacheFilter, domain.PageRequest) error); ok {
+		r1 = rf(ctx, filter, pageReq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InfoCacheServiceInterfaceMock_GetEntries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEntries'
+type InfoCacheServiceInterfaceMock_GetEntries_Call struct {
+	*mock.Call
+}
+
+// GetEntries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.InfoCacheFilter
+//   - pageReq domain.PageRequest
+func (_e *InfoCacheServiceInterfaceMock_Expecter) GetEntries(ctx interface{}, filter interface{}, pageReq interface{}) *InfoCacheServiceInterfaceMock_GetEntries_Call {
+	return &InfoCacheServiceInterfaceMock_GetEntries_Call{Call: _e.mock.On("GetEntries", ctx, filter, pageReq)}
+}
+
+func (_c *InfoCacheServiceInterfaceMock_GetEntries_Call) Run(run func(ctx context.Context, filter domain.InfoCacheFilter, pageReq domain.PageRequest)) *InfoCacheServiceInterfaceMock_GetEntries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.InfoCacheFilter), args[2].(domain.PageRequest))
+	})
+	return _c
+}
+
+func (_c *InfoCacheServiceInterfaceMock_GetEntries_Call) Return(_a0 *domain.Page[domain.InfoCacheEntry], _a1 error) *InfoCacheServiceInterfaceMock_GetEntries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InfoCacheServiceInterfaceMock_GetEntries_Call) RunAndReturn(run func(context.Context, domain.InfoCacheFilter, domain.PageRequest) (*domain.Page[domain.InfoCacheEntry], error)) *InfoCacheServiceInterfaceMock_GetEntries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Purge provides a mock function with given fields: ctx, filter
+func (_m *InfoCacheServiceInterfaceMock) Purge(ctx context.Context, filter domain.InfoCacheFilter) (int, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Purge")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.InfoCacheFilter) (int, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.InfoCacheFilter) int); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.InfoCacheFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InfoCacheServiceInterfaceMock_Purge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Purge'
+type InfoCacheServiceInterfaceMock_Purge_Call struct {
+	*mock.Call
+}
+
+// Purge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.InfoCacheFilter
+func (_e *InfoCacheServiceInterfaceMock_Expecter) Purge(ctx interface{}, filter interface{}) *InfoCacheServiceInterfaceMock_Purge_Call {
+	return &InfoCacheServiceInterfaceMock_Purge_Call{Call: _e.mock.On("Purge", ctx, filter)}
+}
+
+func (_c *InfoCacheServiceInterfaceMock_Purge_Call) Run(run func(ctx context.Context, filter domain.InfoCacheFilter)) *InfoCacheServiceInterfaceMock_Purge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.InfoCacheFilter))
+	})
+	return _c
+}
+
+func (_c *InfoCacheServiceInterfaceMock_Purge_Call) Return(_a0 int, _a1 error) *InfoCacheServiceInterfaceMock_Purge_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InfoCacheServiceInterfaceMock_Purge_Call) RunAndReturn(run func(context.Context, domain.InfoCacheFilter) (int, error)) *InfoCacheServiceInterfaceMock_Purge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewInfoCacheServiceInterfaceMock creates a new instance of InfoCacheServiceInterfaceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewInfoCacheServiceInterfaceMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *InfoCacheServiceInterfaceMock {
+	mock := &InfoCacheServiceInterfaceMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
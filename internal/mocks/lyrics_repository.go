@@ -0,0 +1,191 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/mashfeii/songs_library/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// LyricsRepositoryMock is an autogenerated mock type for the LyricsRepository type
+type LyricsRepositoryMock struct {
+	mock.Mock
+}
+
+type LyricsRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *LyricsRepositoryMock) EXPECT() *LyricsRepositoryMock_Expecter {
+	return &LyricsRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// DeleteSyncedLyrics provides a mock function with given fields: ctx, songID
+func (_m *LyricsRepositoryMock) DeleteSyncedLyrics(ctx context.Context, songID int) error {
+	ret := _m.Called(ctx, songID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSyncedLyrics")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, songID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LyricsRepositoryMock_DeleteSyncedLyrics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSyncedLyrics'
+type LyricsRepositoryMock_DeleteSyncedLyrics_Call struct {
+	*mock.Call
+}
+
+// DeleteSyncedLyrics is a helper method to define mock.On call
+//   - ctx context.Context
+//   - songID int
+func (_e *LyricsRepositoryMock_Expecter) DeleteSyncedLyrics(ctx interface{}, songID interface{}) *LyricsRepositoryMock_DeleteSyncedLyrics_Call {
+	return &LyricsRepositoryMock_DeleteSyncedLyrics_Call{Call: _e.mock.On("DeleteSyncedLyrics", ctx, songID)}
+}
+
+func (_c *LyricsRepositoryMock_DeleteSyncedLyrics_Call) Run(run func(ctx context.Context, songID int)) *LyricsRepositoryMock_DeleteSyncedLyrics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *LyricsRepositoryMock_DeleteSyncedLyrics_Call) Return(_a0 error) *LyricsRepositoryMock_DeleteSyncedLyrics_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LyricsRepositoryMock_DeleteSyncedLyrics_Call) RunAndReturn(run func(context.Context, int) error) *LyricsRepositoryMock_DeleteSyncedLyrics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSyncedLyrics provides a mock function with given fields: ctx, songID
+func (_m *LyricsRepositoryMock) GetSyncedLyrics(ctx context.Context, songID int) (*domain.SyncedLyrics, error) {
+	ret := _m.Called(ctx, songID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSyncedLyrics")
+	}
+
+	var r0 *domain.SyncedLyrics
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*domain.SyncedLyrics, error)); ok {
+		return rf(ctx, songID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *domain.SyncedLyrics); ok {
+		r0 = rf(ctx, songID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.SyncedLyrics)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, songID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LyricsRepositoryMock_GetSyncedLyrics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSyncedLyrics'
+type LyricsRepositoryMock_GetSyncedLyrics_Call struct {
+	*mock.Call
+}
+
+// GetSyncedLyrics is a helper method to define mock.On call
+//   - ctx context.Context
+//   - songID int
+func (_e *LyricsRepositoryMock_Expecter) GetSyncedLyrics(ctx interface{}, songID interface{}) *LyricsRepositoryMock_GetSyncedLyrics_Call {
+	return &LyricsRepositoryMock_GetSyncedLyrics_Call{Call: _e.mock.On("GetSyncedLyrics", ctx, songID)}
+}
+
+func (_c *LyricsRepositoryMock_GetSyncedLyrics_Call) Run(run func(ctx context.Context, songID int)) *LyricsRepositoryMock_GetSyncedLyrics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *LyricsRepositoryMock_GetSyncedLyrics_Call) Return(_a0 *domain.SyncedLyrics, _a1 error) *LyricsRepositoryMock_GetSyncedLyrics_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LyricsRepositoryMock_GetSyncedLyrics_Call) RunAndReturn(run func(context.Context, int) (*domain.SyncedLyrics, error)) *LyricsRepositoryMock_GetSyncedLyrics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveSyncedLyrics provides a mock function with given fields: ctx, lyrics
+func (_m *LyricsRepositoryMock) SaveSyncedLyrics(ctx context.Context, lyrics *domain.SyncedLyrics) error {
+	ret := _m.Called(ctx, lyrics)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveSyncedLyrics")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.SyncedLyrics) error); ok {
+		r0 = rf(ctx, lyrics)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LyricsRepositoryMock_SaveSyncedLyrics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveSyncedLyrics'
+type LyricsRepositoryMock_SaveSyncedLyrics_Call struct {
+	*mock.Call
+}
+
+// SaveSyncedLyrics is a helper method to define mock.On call
+//   - ctx context.Context
+//   - lyrics *domain.SyncedLyrics
+func (_e *LyricsRepositoryMock_Expecter) SaveSyncedLyrics(ctx interface{}, lyrics interface{}) *LyricsRepositoryMock_SaveSyncedLyrics_Call {
+	return &LyricsRepositoryMock_SaveSyncedLyrics_Call{Call: _e.mock.On("SaveSyncedLyrics", ctx, lyrics)}
+}
+
+func (_c *LyricsRepositoryMock_SaveSyncedLyrics_Call) Run(run func(ctx context.Context, lyrics *domain.SyncedLyrics)) *LyricsRepositoryMock_SaveSyncedLyrics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.SyncedLyrics))
+	})
+	return _c
+}
+
+func (_c *LyricsRepositoryMock_SaveSyncedLyrics_Call) Return(_a0 error) *LyricsRepositoryMock_SaveSyncedLyrics_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LyricsRepositoryMock_SaveSyncedLyrics_Call) RunAndReturn(run func(context.Context, *domain.SyncedLyrics) error) *LyricsRepositoryMock_SaveSyncedLyrics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewLyricsRepositoryMock creates a new instance of LyricsRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLyricsRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *LyricsRepositoryMock {
+	mock := &LyricsRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
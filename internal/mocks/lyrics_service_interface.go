@@ -0,0 +1,323 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/mashfeii/songs_library/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// LyricsServiceInterfaceMock is an autogenerated mock type for the LyricsServiceInterface type
+type LyricsServiceInterfaceMock struct {
+	mock.Mock
+}
+
+type LyricsServiceInterfaceMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *LyricsServiceInterfaceMock) EXPECT() *LyricsServiceInterfaceMock_Expecter {
+	return &LyricsServiceInterfaceMock_Expecter{mock: &_m.Mock}
+}
+
+// DeleteLyrics provides a mock function with given fields: ctx, songID
+func (_m *LyricsServiceInterfaceMock) DeleteLyrics(ctx context.Context, songID int) error {
+	ret := _m.Called(ctx, songID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteLyrics")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, songID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LyricsServiceInterfaceMock_DeleteLyrics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteLyrics'
+type LyricsServiceInterfaceMock_DeleteLyrics_Call struct {
+	*mock.Call
+}
+
+// DeleteLyrics is a helper method to define mock.On call
+//   - ctx context.Context
+//   - songID int
+func (_e *LyricsServiceInterfaceMock_Expecter) DeleteLyrics(ctx interface{}, songID interface{}) *LyricsServiceInterfaceMock_DeleteLyrics_Call {
+	return &LyricsServiceInterfaceMock_DeleteLyrics_Call{Call: _e.mock.On("DeleteLyrics", ctx, songID)}
+}
+
+func (_c *LyricsServiceInterfaceMock_DeleteLyrics_Call) Run(run func(ctx context.Context, songID int)) *LyricsServiceInterfaceMock_DeleteLyrics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *LyricsServiceInterfaceMock_DeleteLyrics_Call) Return(_a0 error) *LyricsServiceInterfaceMock_DeleteLyrics_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LyricsServiceInterfaceMock_DeleteLyrics_Call) RunAndReturn(run func(context.Context, int) error) *LyricsServiceInterfaceMock_DeleteLyrics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLyrics provides a mock function with given fields: ctx, songID
+func (_m *LyricsServiceInterfaceMock) GetLyrics(ctx context.Context, songID int) (*domain.SyncedLyrics, error) {
+	ret := _m.Called(ctx, songID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLyrics")
+	}
+
+	var r0 *domain.SyncedLyrics
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*domain.SyncedLyrics, error)); ok {
+		return rf(ctx, songID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *domain.SyncedLyrics); ok {
+		r0 = rf(ctx, songID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.SyncedLyrics)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, songID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LyricsServiceInterfaceMock_GetLyrics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLyrics'
+type LyricsServiceInterfaceMock_GetLyrics_Call struct {
+	*mock.Call
+}
+
+// GetLyrics is a helper method to define mock.On call
+//   - ctx context.Context
+//   - songID int
+func (_e *LyricsServiceInterfaceMock_Expecter) GetLyrics(ctx interface{}, songID interface{}) *LyricsServiceInterfaceMock_GetLyrics_Call {
+	return &LyricsServiceInterfaceMock_GetLyrics_Call{Call: _e.mock.On("GetLyrics", ctx, songID)}
+}
+
+func (_c *LyricsServiceInterfaceMock_GetLyrics_Call) Run(run func(ctx context.Context, songID int)) *LyricsServiceInterfaceMock_GetLyrics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *LyricsServiceInterfaceMock_GetLyrics_Call) Return(_a0 *domain.SyncedLyrics, _a1 error) *LyricsServiceInterfaceMock_GetLyrics_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LyricsServiceInterfaceMock_GetLyrics_Call) RunAndReturn(run func(context.Context, int) (*domain.SyncedLyrics, error)) *LyricsServiceInterfaceMock_GetLyrics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLyricsAt provides a mock function with given fields: ctx, songID, timeMs, next
+func (_m *LyricsServiceInterfaceMock) GetLyricsAt(ctx context.Context, songID int, timeMs int, next int) (*domain.LyricsPosition, error) {
+	ret := _m.Called(ctx, songID, timeMs, next)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLyricsAt")
+	}
+
+	var r0 *domain.LyricsPosition
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, int) (*domain.LyricsPosition, error)); ok {
+		return rf(ctx, songID, timeMs, next)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, int) *domain.LyricsPosition); ok {
+		r0 = rf(ctx, songID, timeMs, next)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.LyricsPosition)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, int) error); ok {
+		r1 = rf(ctx, songID, timeMs, next)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LyricsServiceInterfaceMock_GetLyricsAt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLyricsAt'
+type LyricsServiceInterfaceMock_GetLyricsAt_Call struct {
+	*mock.Call
+}
+
+// GetLyricsAt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - songID int
+//   - timeMs int
+//   - next int
+func (_e *LyricsServiceInterfaceMock_Expecter) GetLyricsAt(ctx interface{}, songID interface{}, timeMs interface{}, next interface{}) *LyricsServiceInterfaceMock_GetLyricsAt_Call {
+	return &LyricsServiceInterfaceMock_GetLyricsAt_Call{Call: _e.mock.On("GetLyricsAt", ctx, songID, timeMs, next)}
+}
+
+func (_c *LyricsServiceInterfaceMock_GetLyricsAt_Call) Run(run func(ctx context.Context, songID int, timeMs int, next int)) *LyricsServiceInterfaceMock_GetLyricsAt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *LyricsServiceInterfaceMock_GetLyricsAt_Call) Return(_a0 *domain.LyricsPosition, _a1 error) *LyricsServiceInterfaceMock_GetLyricsAt_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LyricsServiceInterfaceMock_GetLyricsAt_Call) RunAndReturn(run func(context.Context, int, int, int) (*domain.LyricsPosition, error)) *LyricsServiceInterfaceMock_GetLyricsAt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ImportLRC provides a mock function with given fields: ctx, songID, data
+func (_m *LyricsServiceInterfaceMock) ImportLRC(ctx context.Context, songID int, data string) (*domain.SyncedLyrics, error) {
+	ret := _m.Called(ctx, songID, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportLRC")
+	}
+
+	var r0 *domain.SyncedLyrics
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) (*domain.SyncedLyrics, error)); ok {
+		return rf(ctx, songID, data)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) *domain.SyncedLyrics); ok {
+		r0 = rf(ctx, songID, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.SyncedLyrics)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, string) error); ok {
+		r1 = rf(ctx, songID, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LyricsServiceInterfaceMock_ImportLRC_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportLRC'
+type LyricsServiceInterfaceMock_ImportLRC_Call struct {
+	*mock.Call
+}
+
+// ImportLRC is a helper method to define mock.On call
+//   - ctx context.Context
+//   - songID int
+//   - data string
+func (_e *LyricsServiceInterfaceMock_Expecter) ImportLRC(ctx interface{}, songID interface{}, data interface{}) *LyricsServiceInterfaceMock_ImportLRC_Call {
+	return &LyricsServiceInterfaceMock_ImportLRC_Call{Call: _e.mock.On("ImportLRC", ctx, songID, data)}
+}
+
+func (_c *LyricsServiceInterfaceMock_ImportLRC_Call) Run(run func(ctx context.Context, songID int, data string)) *LyricsServiceInterfaceMock_ImportLRC_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *LyricsServiceInterfaceMock_ImportLRC_Call) Return(_a0 *domain.SyncedLyrics, _a1 error) *LyricsServiceInterfaceMock_ImportLRC_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LyricsServiceInterfaceMock_ImportLRC_Call) RunAndReturn(run func(context.Context, int, string) (*domain.SyncedLyrics, error)) *LyricsServiceInterfaceMock_ImportLRC_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveLyrics provides a mock function with given fields: ctx, lyrics
+func (_m *LyricsServiceInterfaceMock) SaveLyrics(ctx context.Context, lyrics *domain.SyncedLyrics) (*domain.SyncedLyrics, error) {
+	ret := _m.Called(ctx, lyrics)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveLyrics")
+	}
+
+	var r0 *domain.SyncedLyrics
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.SyncedLyrics) (*domain.SyncedLyrics, error)); ok {
+		return rf(ctx, lyrics)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.SyncedLyrics) *domain.SyncedLyrics); ok {
+		r0 = rf(ctx, lyrics)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.SyncedLyrics)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.SyncedLyrics) error); ok {
+		r1 = rf(ctx, lyrics)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LyricsServiceInterfaceMock_SaveLyrics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveLyrics'
+type LyricsServiceInterfaceMock_SaveLyrics_Call struct {
+	*mock.Call
+}
+
+// SaveLyrics is a helper method to define mock.On call
+//   - ctx context.Context
+//   - lyrics *domain.SyncedLyrics
+func (_e *LyricsServiceInterfaceMock_Expecter) SaveLyrics(ctx interface{}, lyrics interface{}) *LyricsServiceInterfaceMock_SaveLyrics_Call {
+	return &LyricsServiceInterfaceMock_SaveLyrics_Call{Call: _e.mock.On("SaveLyrics", ctx, lyrics)}
+}
+
+func (_c *LyricsServiceInterfaceMock_SaveLyrics_Call) Run(run func(ctx context.Context, lyrics *domain.SyncedLyrics)) *LyricsServiceInterfaceMock_SaveLyrics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.SyncedLyrics))
+	})
+	return _c
+}
+
+func (_c *LyricsServiceInterfaceMock_SaveLyrics_Call) Return(_a0 *domain.SyncedLyrics, _a1 error) *LyricsServiceInterfaceMock_SaveLyrics_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LyricsServiceInterfaceMock_SaveLyrics_Call) RunAndReturn(run func(context.Context, *domain.SyncedLyrics) (*domain.SyncedLyrics, error)) *LyricsServiceInterfaceMock_SaveLyrics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewLyricsServiceInterfaceMock creates a new instance of LyricsServiceInterfaceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLyricsServiceInterfaceMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *LyricsServiceInterfaceMock {
+	mock := &LyricsServiceInterfaceMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
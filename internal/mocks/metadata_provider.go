@@ -0,0 +1,142 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/mashfeii/songs_library/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MetadataProviderMock is an autogenerated mock type for the MetadataProvider type
+type MetadataProviderMock struct {
+	mock.Mock
+}
+
+type MetadataProviderMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MetadataProviderMock) EXPECT() *MetadataProviderMock_Expecter {
+	return &MetadataProviderMock_Expecter{mock: &_m.Mock}
+}
+
+// Lookup provides a mock function with given fields: ctx, group, song
+func (_m *MetadataProviderMock) Lookup(ctx context.Context, group string, song string) (*domain.SongMetadata, error) {
+	ret := _m.Called(ctx, group, song)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Lookup")
+	}
+
+	var r0 *domain.SongMetadata
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*domain.SongMetadata, error)); ok {
+		return rf(ctx, group, song)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *domain.SongMetadata); ok {
+		r0 = rf(ctx, group, song)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.SongMetadata)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, group, song)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MetadataProviderMock_Lookup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Lookup'
+type MetadataProviderMock_Lookup_Call struct {
+	*mock.Call
+}
+
+// Lookup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - group string
+//   - song string
+func (_e *MetadataProviderMock_Expecter) Lookup(ctx interface{}, group interface{}, song interface{}) *MetadataProviderMock_Lookup_Call {
+	return &MetadataProviderMock_Lookup_Call{Call: _e.mock.On("Lookup", ctx, group, song)}
+}
+
+func (_c *MetadataProviderMock_Lookup_Call) Run(run func(ctx context.Context, group string, song string)) *MetadataProviderMock_Lookup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MetadataProviderMock_Lookup_Call) Return(_a0 *domain.SongMetadata, _a1 error) *MetadataProviderMock_Lookup_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MetadataProviderMock_Lookup_Call) RunAndReturn(run func(context.Context, string, string) (*domain.SongMetadata, error)) *MetadataProviderMock_Lookup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Name provides a mock function with no fields
+func (_m *MetadataProviderMock) Name() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Name")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// MetadataProviderMock_Name_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Name'
+type MetadataProviderMock_Name_Call struct {
+	*mock.Call
+}
+
+// Name is a helper method to define mock.On call
+func (_e *MetadataProviderMock_Expecter) Name() *MetadataProviderMock_Name_Call {
+	return &MetadataProviderMock_Name_Call{Call: _e.mock.On("Name")}
+}
+
+func (_c *MetadataProviderMock_Name_Call) Run(run func()) *MetadataProviderMock_Name_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MetadataProviderMock_Name_Call) Return(_a0 string) *MetadataProviderMock_Name_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MetadataProviderMock_Name_Call) RunAndReturn(run func() string) *MetadataProviderMock_Name_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMetadataProviderMock creates a new instance of MetadataProviderMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMetadataProviderMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MetadataProviderMock {
+	mock := &MetadataProviderMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,474 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/mashfeii/songs_library/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MetadataRefreshRepositoryMock is an autogenerated mock type for the MetadataRefreshRepository type
+type MetadataRefreshRepositoryMock struct {
+	mock.Mock
+}
+
+type MetadataRefreshRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MetadataRefreshRepositoryMock) EXPECT() *MetadataRefreshRepositoryMock_Expecter {
+	return &MetadataRefreshRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// AddMetadataProposals provides a mock function with given fields: ctx, proposals
+func (_m *MetadataRefreshRepositoryMock) AddMetadataProposals(ctx context.Context, proposals []domain.MetadataProposal) error {
+	ret := _m.Called(ctx, proposals)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddMetadataProposals")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.MetadataProposal) error); ok {
+		r0 = rf(ctx, proposals)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MetadataRefreshRepositoryMock_AddMetadataProposals_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddMetadataProposals'
+type MetadataRefreshRepositoryMock_AddMetadataProposals_Call struct {
+	*mock.Call
+}
+
+// AddMetadataProposals is a helper method to define mock.On call
+//   - ctx context.Context
+//   - proposals []domain.MetadataProposal
+func (_e *MetadataRefreshRepositoryMock_Expecter) AddMetadataProposals(ctx interface{}, proposals interface{}) *MetadataRefreshRepositoryMock_AddMetadataProposals_Call {
+	return &MetadataRefreshRepositoryMock_AddMetadataProposals_Call{Call: _e.mock.On("AddMetadataProposals", ctx, proposals)}
+}
+
+func (_c *MetadataRefreshRepositoryMock_AddMetadataProposals_Call) Run(run func(ctx context.Context, proposals []domain.MetadataProposal)) *MetadataRefreshRepositoryMock_AddMetadataProposals_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]domain.MetadataProposal))
+	})
+	return _c
+}
+
+func (_c *MetadataRefreshRepositoryMock_AddMetadataProposals_Call) Return(_a0 error) *MetadataRefreshRepositoryMock_AddMetadataProposals_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MetadataRefreshRepositoryMock_AddMetadataProposals_Call) RunAndReturn(run func(context.Context, []domain.MetadataProposal) error) *MetadataRefreshRepositoryMock_AddMetadataProposals_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FailRunningRefreshRuns provides a mock function with given fields: ctx, reason
+func (_m *MetadataRefreshRepositoryMock) FailRunningRefreshRuns(ctx context.Context, reason string) (int, error) {
+	ret := _m.Called(ctx, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FailRunningRefreshRuns")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int, error)); ok {
+		return rf(ctx, reason)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int); ok {
+		r0 = rf(ctx, reason)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, reason)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MetadataRefreshRepositoryMock_FailRunningRefreshRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FailRunningRefreshRuns'
+type MetadataRefreshRepositoryMock_FailRunningRefreshRuns_Call struct {
+	*mock.Call
+}
+
+// FailRunningRefreshRuns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reason string
+func (_e *MetadataRefreshRepositoryMock_Expecter) FailRunningRefreshRuns(ctx interface{}, reason interface{}) *MetadataRefreshRepositoryMock_FailRunningRefreshRuns_Call {
+	return &MetadataRefreshRepositoryMock_FailRunningRefreshRuns_Call{Call: _e.mock.On("FailRunningRefreshRuns", ctx, reason)}
+}
+
+func (_c *MetadataRefreshRepositoryMock_FailRunningRefreshRuns_Call) Run(run func(ctx context.Context, reason string)) *MetadataRefreshRepositoryMock_FailRunningRefreshRuns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MetadataRefreshRepositoryMock_FailRunningRefreshRuns_Call) Return(_a0 int, _a1 error) *MetadataRefreshRepositoryMock_FailRunningRefreshRuns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MetadataRefreshRepositoryMock_FailRunningRefreshRuns_Call) RunAndReturn(run func(context.Context, string) (int, error)) *MetadataRefreshRepositoryMock_FailRunningRefreshRuns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FinishRefreshRun provides a mock function with given fields: ctx, run
+func (_m *MetadataRefreshRepositoryMock) FinishRefreshRun(ctx context.Context, run *domain.RefreshRun) error {
+	ret := _m.Called(ctx, run)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FinishRefreshRun")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.RefreshRun) error); ok {
+		r0 = rf(ctx, run)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MetadataRefreshRepositoryMock_FinishRefreshRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FinishRefreshRun'
+type MetadataRefreshRepositoryMock_FinishRefreshRun_Call struct {
+	*mock.Call
+}
+
+// FinishRefreshRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - run *domain.RefreshRun
+func (_e *MetadataRefreshRepositoryMock_Expecter) FinishRefreshRun(ctx interface{}, run interface{}) *MetadataRefreshRepositoryMock_FinishRefreshRun_Call {
+	return &MetadataRefreshRepositoryMock_FinishRefreshRun_Call{Call: _e.mock.On("FinishRefreshRun", ctx, run)}
+}
+
+func (_c *MetadataRefreshRepositoryMock_FinishRefreshRun_Call) Run(run func(ctx context.Context, run *domain.RefreshRun)) *MetadataRefreshRepositoryMock_FinishRefreshRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.RefreshRun))
+	})
+	return _c
+}
+
+func (_c *MetadataRefreshRepositoryMock_FinishRefreshRun_Call) Return(_a0 error) *MetadataRefreshRepositoryMock_FinishRefreshRun_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MetadataRefreshRepositoryMock_FinishRefreshRun_Call) RunAndReturn(run func(context.Context, *domain.RefreshRun) error) *MetadataRefreshRepositoryMock_FinishRefreshRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRefreshRun provides a mock function with given fields: ctx, id
+func (_m *MetadataRefreshRepositoryMock) GetRefreshRun(ctx context.Context, id int) (*domain.RefreshRun, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRefreshRun")
+	}
+
+	var r0 *domain.RefreshRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*domain.RefreshRun, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *domain.RefreshRun); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.RefreshRun)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MetadataRefreshRepositoryMock_GetRefreshRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRefreshRun'
+type MetadataRefreshRepositoryMock_GetRefreshRun_Call struct {
+	*mock.Call
+}
+
+// GetRefreshRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *MetadataRefreshRepositoryMock_Expecter) GetRefreshRun(ctx interface{}, id interface{}) *MetadataRefreshRepositoryMock_GetRefreshRun_Call {
+	return &MetadataRefreshRepositoryMock_GetRefreshRun_Call{Call: _e.mock.On("GetRefreshRun", ctx, id)}
+}
+
+func (_c *MetadataRefreshRepositoryMock_GetRefreshRun_Call) Run(run func(ctx context.Context, id int)) *MetadataRefreshRepositoryMock_GetRefreshRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MetadataRefreshRepositoryMock_GetRefreshRun_Call) Return(_a0 *domain.RefreshRun, _a1 error) *MetadataRefreshRepositoryMock_GetRefreshRun_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MetadataRefreshRepositoryMock_GetRefreshRun_Call) RunAndReturn(run func(context.Context, int) (*domain.RefreshRun, error)) *MetadataRefreshRepositoryMock_GetRefreshRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRefreshRuns provides a mock function with given fields: ctx, pageReq
+func (_m *MetadataRefreshRepositoryMock) GetRefreshRuns(ctx context.Context, pageReq domain.PageRequest) (*domain.Page[domain.RefreshRun], error) {
+	ret := _m.Called(ctx, pageReq)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRefreshRuns")
+	}
+
+	var r0 *domain.Page[domain.RefreshRun]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PageRequest) (*domain.Page[domain.RefreshRun], error)); ok {
+		return rf(ctx, pageReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PageRequest) *domain.Page[domain.RefreshRun]); ok {
+		r0 = rf(ctx, pageReq)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Page[domain.RefreshRun])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.PageRequest) error); ok {
+		r1 = rf(ctx, pageReq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MetadataRefreshRepositoryMock_GetRefreshRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRefreshRuns'
+type MetadataRefreshRepositoryMock_GetRefreshRuns_Call struct {
+	*mock.Call
+}
+
+// GetRefreshRuns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pageReq domain.PageRequest
+func (_e *MetadataRefreshRepositoryMock_Expecter) GetRefreshRuns(ctx interface{}, pageReq interface{}) *MetadataRefreshRepositoryMock_GetRefreshRuns_Call {
+	return &MetadataRefreshRepositoryMock_GetRefreshRuns_Call{Call: _e.mock.On("GetRefreshRuns", ctx, pageReq)}
+}
+
+func (_c *MetadataRefreshRepositoryMock_GetRefreshRuns_Call) Run(run func(ctx context.Context, pageReq domain.PageRequest)) *MetadataRefreshRepositoryMock_GetRefreshRuns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.PageRequest))
+	})
+	return _c
+}
+
+func (_c *MetadataRefreshRepositoryMock_GetRefreshRuns_Call) Return(_a0 *domain.Page[domain.RefreshRun], _a1 error) *MetadataRefreshRepositoryMock_GetRefreshRuns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MetadataRefreshRepositoryMock_GetRefreshRuns_Call) RunAndReturn(run func(context.Context, domain.PageRequest) (*domain.Page[domain.RefreshRun], error)) *MetadataRefreshRepositoryMock_GetRefreshRuns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStaleSongs provides a mock function with given fields: ctx, enrichedBefore, limit
+func (_m *MetadataRefreshRepositoryMock) GetStaleSongs(ctx context.Context, enrichedBefore time.Time, limit int) ([]domain.Song, error) {
+	ret := _m.Called(ctx, enrichedBefore, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStaleSongs")
+	}
+
+	var r0 []domain.Song
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]domain.Song, error)); ok {
+		return rf(ctx, enrichedBefore, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) []domain.Song); ok {
+		r0 = rf(ctx, enrichedBefore, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Song)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, enrichedBefore, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MetadataRefreshRepositoryMock_GetStaleSongs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStaleSongs'
+type MetadataRefreshRepositoryMock_GetStaleSongs_Call struct {
+	*mock.Call
+}
+
+// GetStaleSongs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enrichedBefore time.Time
+//   - limit int
+func (_e *MetadataRefreshRepositoryMock_Expecter) GetStaleSongs(ctx interface{}, enrichedBefore interface{}, limit interface{}) *MetadataRefreshRepositoryMock_GetStaleSongs_Call {
+	return &MetadataRefreshRepositoryMock_GetStaleSongs_Call{Call: _e.mock.On("GetStaleSongs", ctx, enrichedBefore, limit)}
+}
+
+func (_c *MetadataRefreshRepositoryMock_GetStaleSongs_Call) Run(run func(ctx context.Context, enrichedBefore time.Time, limit int)) *MetadataRefreshRepositoryMock_GetStaleSongs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MetadataRefreshRepositoryMock_GetStaleSongs_Call) Return(_a0 []domain.Song, _a1 error) *MetadataRefreshRepositoryMock_GetStaleSongs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MetadataRefreshRepositoryMock_GetStaleSongs_Call) RunAndReturn(run func(context.Context, time.Time, int) ([]domain.Song, error)) *MetadataRefreshRepositoryMock_GetStaleSongs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StartRefreshRun provides a mock function with given fields: ctx
+func (_m *MetadataRefreshRepositoryMock) StartRefreshRun(ctx context.Context) (*domain.RefreshRun, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartRefreshRun")
+	}
+
+	var r0 *domain.RefreshRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*domain.RefreshRun, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *domain.RefreshRun); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.RefreshRun)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MetadataRefreshRepositoryMock_StartRefreshRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartRefreshRun'
+type MetadataRefreshRepositoryMock_StartRefreshRun_Call struct {
+	*mock.Call
+}
+
+// StartRefreshRun is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MetadataRefreshRepositoryMock_Expecter) StartRefreshRun(ctx interface{}) *MetadataRefreshRepositoryMock_StartRefreshRun_Call {
+	return &MetadataRefreshRepositoryMock_StartRefreshRun_Call{Call: _e.mock.On("StartRefreshRun", ctx)}
+}
+
+func (_c *MetadataRefreshRepositoryMock_StartRefreshRun_Call) Run(run func(ctx context.Context)) *MetadataRefreshRepositoryMock_StartRefreshRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MetadataRefreshRepositoryMock_StartRefreshRun_Call) Return(_a0 *domain.RefreshRun, _a1 error) *MetadataRefreshRepositoryMock_StartRefreshRun_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MetadataRefreshRepositoryMock_StartRefreshRun_Call) RunAndReturn(run func(context.Context) (*domain.RefreshRun, error)) *MetadataRefreshRepositoryMock_StartRefreshRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TouchSongMetadata provides a mock function with given fields: ctx, songID
+func (_m *MetadataRefreshRepositoryMock) TouchSongMetadata(ctx context.Context, songID int) error {
+	ret := _m.Called(ctx, songID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TouchSongMetadata")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, songID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MetadataRefreshRepositoryMock_TouchSongMetadata_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TouchSongMetadata'
+type MetadataRefreshRepositoryMock_TouchSongMetadata_Call struct {
+	*mock.Call
+}
+
+// TouchSongMetadata is a helper method to define mock.On call
+//   - ctx context.Context
+//   - songID int
+func (_e *MetadataRefreshRepositoryMock_Expecter) TouchSongMetadata(ctx interface{}, songID interface{}) *MetadataRefreshRepositoryMock_TouchSongMetadata_Call {
+	return &MetadataRefreshRepositoryMock_TouchSongMetadata_Call{Call: _e.mock.On("TouchSongMetadata", ctx, songID)}
+}
+
+func (_c *MetadataRefreshRepositoryMock_TouchSongMetadata_Call) Run(run func(ctx context.Context, songID int)) *MetadataRefreshRepositoryMock_TouchSongMetadata_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MetadataRefreshRepositoryMock_TouchSongMetadata_Call) Return(_a0 error) *MetadataRefreshRepositoryMock_TouchSongMetadata_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MetadataRefreshRepositoryMock_TouchSongMetadata_Call) RunAndReturn(run func(context.Context, int) error) *MetadataRefreshRepositoryMock_TouchSongMetadata_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMetadataRefreshRepositoryMock creates a new instance of MetadataRefreshRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMetadataRefreshRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MetadataRefreshRepositoryMock {
+	mock := &MetadataRefreshRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,271 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/mashfeii/songs_library/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MetadataRefreshServiceInterfaceMock is an autogenerated mock type for the MetadataRefreshServiceInterface type
+type MetadataRefreshServiceInterfaceMock struct {
+	mock.Mock
+}
+
+type MetadataRefreshServiceInterfaceMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MetadataRefreshServiceInterfaceMock) EXPECT() *MetadataRefreshServiceInterfaceMock_Expecter {
+	return &MetadataRefreshServiceInterfaceMock_Expecter{mock: &_m.Mock}
+}
+
+// GetRefreshRun provides a mock function with given fields: ctx, id
+func (_m *MetadataRefreshServiceInterfaceMock) GetRefreshRun(ctx context.Context, id int) (*domain.RefreshRun, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRefreshRun")
+	}
+
+	var r0 *domain.RefreshRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*domain.RefreshRun, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *domain.RefreshRun); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.RefreshRun)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MetadataRefreshServiceInterfaceMock_GetRefreshRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRefreshRun'
+type MetadataRefreshServiceInterfaceMock_GetRefreshRun_Call struct {
+	*mock.Call
+}
+
+// GetRefreshRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *MetadataRefreshServiceInterfaceMock_Expecter) GetRefreshRun(ctx interface{}, id interface{}) *MetadataRefreshServiceInterfaceMock_GetRefreshRun_Call {
+	return &MetadataRefreshServiceInterfaceMock_GetRefreshRun_Call{Call: _e.mock.On("GetRefreshRun", ctx, id)}
+}
+
+func (_c *MetadataRefreshServiceInterfaceMock_GetRefreshRun_Call) Run(run func(ctx context.Context, id int)) *MetadataRefreshServiceInterfaceMock_GetRefreshRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MetadataRefreshServiceInterfaceMock_GetRefreshRun_Call) Return(_a0 *domain.RefreshRun, _a1 error) *MetadataRefreshServiceInterfaceMock_GetRefreshRun_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MetadataRefreshServiceInterfaceMock_GetRefreshRun_Call) RunAndReturn(run func(context.Context, int) (*domain.RefreshRun, error)) *MetadataRefreshServiceInterfaceMock_GetRefreshRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRefreshRuns provides a mock function with given fields: ctx, pageReq
+func (_m *MetadataRefreshServiceInterfaceMock) GetRefreshRuns(ctx context.Context, pageReq domain.PageRequest) (*domain.Page[domain.RefreshRun], error) {
+	ret := _m.Called(ctx, pageReq)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRefreshRuns")
+	}
+
+	var r0 *domain.Page[domain.RefreshRun]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PageRequest) (*domain.Page[domain.RefreshRun], error)); ok {
+		return rf(ctx, pageReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PageRequest) *domain.Page[domain.RefreshRun]); ok {
+		r0 = rf(ctx, pageReq)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Page[domain.RefreshRun])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.PageRequest) error); ok {
+		r1 = rf(ctx, pageReq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MetadataRefreshServiceInterfaceMock_GetRefreshRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRefreshRuns'
+type MetadataRefreshServiceInterfaceMock_GetRefreshRuns_Call struct {
+	*mock.Call
+}
+
+// GetRefreshRuns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pageReq domain.PageRequest
+func (_e *MetadataRefreshServiceInterfaceMock_Expecter) GetRefreshRuns(ctx interface{}, pageReq interface{}) *MetadataRefreshServiceInterfaceMock_GetRefreshRuns_Call {
+	return &MetadataRefreshServiceInterfaceMock_GetRefreshRuns_Call{Call: _e.mock.On("GetRefreshRuns", ctx, pageReq)}
+}
+
+func (_c *MetadataRefreshServiceInterfaceMock_GetRefreshRuns_Call) Run(run func(ctx context.Context, pageReq domain.PageRequest)) *MetadataRefreshServiceInterfaceMock_GetRefreshRuns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.PageRequest))
+	})
+	return _c
+}
+
+func (_c *MetadataRefreshServiceInterfaceMock_GetRefreshRuns_Call) Return(_a0 *domain.Page[domain.RefreshRun], _a1 error) *MetadataRefreshServiceInterfaceMock_GetRefreshRuns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MetadataRefreshServiceInterfaceMock_GetRefreshRuns_Call) RunAndReturn(run func(context.Context, domain.PageRequest) (*domain.Page[domain.RefreshRun], error)) *MetadataRefreshServiceInterfaceMock_GetRefreshRuns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RunRefresh provides a mock function with given fields: ctx
+func (_m *MetadataRefreshServiceInterfaceMock) RunRefresh(ctx context.Context) (*domain.RefreshRun, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunRefresh")
+	}
+
+	var r0 *domain.RefreshRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*domain.RefreshRun, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *domain.RefreshRun); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.RefreshRun)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MetadataRefreshServiceInterfaceMock_RunRefresh_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunRefresh'
+type MetadataRefreshServiceInterfaceMock_RunRefresh_Call struct {
+	*mock.Call
+}
+
+// RunRefresh is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MetadataRefreshServiceInterfaceMock_Expecter) RunRefresh(ctx interface{}) *MetadataRefreshServiceInterfaceMock_RunRefresh_Call {
+	return &MetadataRefreshServiceInterfaceMock_RunRefresh_Call{Call: _e.mock.On("RunRefresh", ctx)}
+}
+
+func (_c *MetadataRefreshServiceInterfaceMock_RunRefresh_Call) Run(run func(ctx context.Context)) *MetadataRefreshServiceInterfaceMock_RunRefresh_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MetadataRefreshServiceInterfaceMock_RunRefresh_Call) Return(_a0 *domain.RefreshRun, _a1 error) *MetadataRefreshServiceInterfaceMock_RunRefresh_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MetadataRefreshServiceInterfaceMock_RunRefresh_Call) RunAndReturn(run func(context.Context) (*domain.RefreshRun, error)) *MetadataRefreshServiceInterfaceMock_RunRefresh_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StartRefresh provides a mock function with given fields: ctx
+func (_m *MetadataRefreshServiceInterfaceMock) StartRefresh(ctx context.Context) (*domain.RefreshRun, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartRefresh")
+	}
+
+	var r0 *domain.RefreshRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*domain.RefreshRun, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *domain.RefreshRun); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.RefreshRun)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MetadataRefreshServiceInterfaceMock_StartRefresh_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartRefresh'
+type MetadataRefreshServiceInterfaceMock_StartRefresh_Call struct {
+	*mock.Call
+}
+
+// StartRefresh is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MetadataRefreshServiceInterfaceMock_Expecter) StartRefresh(ctx interface{}) *MetadataRefreshServiceInterfaceMock_StartRefresh_Call {
+	return &MetadataRefreshServiceInterfaceMock_StartRefresh_Call{Call: _e.mock.On("StartRefresh", ctx)}
+}
+
+func (_c *MetadataRefreshServiceInterfaceMock_StartRefresh_Call) Run(run func(ctx context.Context)) *MetadataRefreshServiceInterfaceMock_StartRefresh_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MetadataRefreshServiceInterfaceMock_StartRefresh_Call) Return(_a0 *domain.RefreshRun, _a1 error) *MetadataRefreshServiceInterfaceMock_StartRefresh_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MetadataRefreshServiceInterfaceMock_StartRefresh_Call) RunAndReturn(run func(context.Context) (*domain.RefreshRun, error)) *MetadataRefreshServiceInterfaceMock_StartRefresh_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMetadataRefreshServiceInterfaceMock creates a new instance of MetadataRefreshServiceInterfaceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMetadataRefreshServiceInterfaceMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MetadataRefreshServiceInterfaceMock {
+	mock := &MetadataRefreshServiceInterfaceMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
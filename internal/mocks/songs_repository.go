@@ -8,6 +8,8 @@ import (
 	domain "github.com/mashfeii/songs_library/internal/domain"
 
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // SongsRepositoryMock is an autogenerated mock type for the SongsRepository type
@@ -80,17 +82,123 @@ func (_c *SongsRepositoryMock_AddSong_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
-// DeleteSong provides a mock function with given fields: ctx, id
-func (_m *SongsRepositoryMock) DeleteSong(ctx context.Context, id int) error {
-	ret := _m.Called(ctx, id)
+// ClaimPendingSong provides a mock function with given fields: ctx, lease
+func (_m *SongsRepositoryMock) ClaimPendingSong(ctx context.Context, lease time.Duration) (*domain.Song, error) {
+	ret := _m.Called(ctx, lease)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimPendingSong")
+	}
+
+	var r0 *domain.Song
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration) (*domain.Song, error)); ok {
+		return rf(ctx, lease)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration) *domain.Song); ok {
+		r0 = rf(ctx, lease)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Song)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Duration) error); ok {
+		r1 = rf(ctx, lease)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SongsRepositoryMock_ClaimPendingSong_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimPendingSong'
+type SongsRepositoryMock_ClaimPendingSong_Call struct {
+	*mock.Call
+}
+
+// ClaimPendingSong is a helper method to define mock.On call
+//   - ctx context.Context
+//   - lease time.Duration
+func (_e *SongsRepositoryMock_Expecter) ClaimPendingSong(ctx interface{}, lease interface{}) *SongsRepositoryMock_ClaimPendingSong_Call {
+	return &SongsRepositoryMock_ClaimPendingSong_Call{Call: _e.mock.On("ClaimPendingSong", ctx, lease)}
+}
+
+func (_c *SongsRepositoryMock_ClaimPendingSong_Call) Run(run func(ctx context.Context, lease time.Duration)) *SongsRepositoryMock_ClaimPendingSong_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *SongsRepositoryMock_ClaimPendingSong_Call) Return(_a0 *domain.Song, _a1 error) *SongsRepositoryMock_ClaimPendingSong_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SongsRepositoryMock_ClaimPendingSong_Call) RunAndReturn(run func(context.Context, time.Duration) (*domain.Song, error)) *SongsRepositoryMock_ClaimPendingSong_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CompleteEnrichment provides a mock function with given fields: ctx, song
+func (_m *SongsRepositoryMock) CompleteEnrichment(ctx context.Context, song *domain.Song) error {
+	ret := _m.Called(ctx, song)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompleteEnrichment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Song) error); ok {
+		r0 = rf(ctx, song)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SongsRepositoryMock_CompleteEnrichment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompleteEnrichment'
+type SongsRepositoryMock_CompleteEnrichment_Call struct {
+	*mock.Call
+}
+
+// CompleteEnrichment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - song *domain.Song
+func (_e *SongsRepositoryMock_Expecter) CompleteEnrichment(ctx interface{}, song interface{}) *SongsRepositoryMock_CompleteEnrichment_Call {
+	return &SongsRepositoryMock_CompleteEnrichment_Call{Call: _e.mock.On("CompleteEnrichment", ctx, song)}
+}
+
+func (_c *SongsRepositoryMock_CompleteEnrichment_Call) Run(run func(ctx context.Context, song *domain.Song)) *SongsRepositoryMock_CompleteEnrichment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.Song))
+	})
+	return _c
+}
+
+func (_c *SongsRepositoryMock_CompleteEnrichment_Call) Return(_a0 error) *SongsRepositoryMock_CompleteEnrichment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SongsRepositoryMock_CompleteEnrichment_Call) RunAndReturn(run func(context.Context, *domain.Song) error) *SongsRepositoryMock_CompleteEnrichment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSong provides a mock function with given fields: ctx, id, version
+func (_m *SongsRepositoryMock) DeleteSong(ctx context.Context, id int, version int) error {
+	ret := _m.Called(ctx, id, version)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteSong")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) error); ok {
+		r0 = rf(ctx, id, version)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -106,13 +214,14 @@ type SongsRepositoryMock_DeleteSong_Call struct {
 // DeleteSong is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id int
-func (_e *SongsRepositoryMock_Expecter) DeleteSong(ctx interface{}, id interface{}) *SongsRepositoryMock_DeleteSong_Call {
-	return &SongsRepositoryMock_DeleteSong_Call{Call: _e.mock.On("DeleteSong", ctx, id)}
+//   - version int
+func (_e *SongsRepositoryMock_Expecter) DeleteSong(ctx interface{}, id interface{}, version interface{}) *SongsRepositoryMock_DeleteSong_Call {
+	return &SongsRepositoryMock_DeleteSong_Call{Call: _e.mock.On("DeleteSong", ctx, id, version)}
 }
 
-func (_c *SongsRepositoryMock_DeleteSong_Call) Run(run func(ctx context.Context, id int)) *SongsRepositoryMock_DeleteSong_Call {
+func (_c *SongsRepositoryMock_DeleteSong_Call) Run(run func(ctx context.Context, id int, version int)) *SongsRepositoryMock_DeleteSong_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int))
+		run(args[0].(context.Context), args[1].(int), args[2].(int))
 	})
 	return _c
 }
@@ -122,7 +231,55 @@ func (_c *SongsRepositoryMock_DeleteSong_Call) Return(_a0 error) *SongsRepositor
 	return _c
 }
 
-func (_c *SongsRepositoryMock_DeleteSong_Call) RunAndReturn(run func(context.Context, int) error) *SongsRepositoryMock_DeleteSong_Call {
+func (_c *SongsRepositoryMock_DeleteSong_Call) RunAndReturn(run func(context.Context, int, int) error) *SongsRepositoryMock_DeleteSong_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FailEnrichment provides a mock function with given fields: ctx, song, reason
+func (_m *SongsRepositoryMock) FailEnrichment(ctx context.Context, song *domain.Song, reason string) error {
+	ret := _m.Called(ctx, song, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FailEnrichment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Song, string) error); ok {
+		r0 = rf(ctx, song, reason)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SongsRepositoryMock_FailEnrichment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FailEnrichment'
+type SongsRepositoryMock_FailEnrichment_Call struct {
+	*mock.Call
+}
+
+// FailEnrichment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - song *domain.Song
+//   - reason string
+func (_e *SongsRepositoryMock_Expecter) FailEnrichment(ctx interface{}, song interface{}, reason interface{}) *SongsRepositoryMock_FailEnrichment_Call {
+	return &SongsRepositoryMock_FailEnrichment_Call{Call: _e.mock.On("FailEnrichment", ctx, song, reason)}
+}
+
+func (_c *SongsRepositoryMock_FailEnrichment_Call) Run(run func(ctx context.Context, song *domain.Song, reason string)) *SongsRepositoryMock_FailEnrichment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.Song), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *SongsRepositoryMock_FailEnrichment_Call) Return(_a0 error) *SongsRepositoryMock_FailEnrichment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SongsRepositoryMock_FailEnrichment_Call) RunAndReturn(run func(context.Context, *domain.Song, string) error) *SongsRepositoryMock_FailEnrichment_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -186,29 +343,149 @@ func (_c *SongsRepositoryMock_GetSongByID_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
-// GetSongs provides a mock function with given fields: ctx, filters, page, size
-func (_m *SongsRepositoryMock) GetSongs(ctx context.Context, filters map[string]string, page int, size int) ([]domain.Song, error) {
-	ret := _m.Called(ctx, filters, page, size)
+// GetSongRevision provides a mock function with given fields: ctx, songID, revision
+func (_m *SongsRepositoryMock) GetSongRevision(ctx context.Context, songID int, revision int) (*domain.Revision, error) {
+	ret := _m.Called(ctx, songID, revision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSongRevision")
+	}
+
+	var r0 *domain.Revision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) (*domain.Revision, error)); ok {
+		return rf(ctx, songID, revision)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) *domain.Revision); ok {
+		r0 = rf(ctx, songID, revision)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Revision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, songID, revision)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SongsRepositoryMock_GetSongRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSongRevision'
+type SongsRepositoryMock_GetSongRevision_Call struct {
+	*mock.Call
+}
+
+// GetSongRevision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - songID int
+//   - revision int
+func (_e *SongsRepositoryMock_Expecter) GetSongRevision(ctx interface{}, songID interface{}, revision interface{}) *SongsRepositoryMock_GetSongRevision_Call {
+	return &SongsRepositoryMock_GetSongRevision_Call{Call: _e.mock.On("GetSongRevision", ctx, songID, revision)}
+}
+
+func (_c *SongsRepositoryMock_GetSongRevision_Call) Run(run func(ctx context.Context, songID int, revision int)) *SongsRepositoryMock_GetSongRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *SongsRepositoryMock_GetSongRevision_Call) Return(_a0 *domain.Revision, _a1 error) *SongsRepositoryMock_GetSongRevision_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SongsRepositoryMock_GetSongRevision_Call) RunAndReturn(run func(context.Context, int, int) (*domain.Revision, error)) *SongsRepositoryMock_GetSongRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSongRevisions provides a mock function with given fields: ctx, songID, pageReq
+func (_m *SongsRepositoryMock) GetSongRevisions(ctx context.Context, songID int, pageReq domain.PageRequest) (*domain.Page[domain.Revision], error) {
+	ret := _m.Called(ctx, songID, pageReq)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSongRevisions")
+	}
+
+	var r0 *domain.Page[domain.Revision]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, domain.PageRequest) (*domain.Page[domain.Revision], error)); ok {
+		return rf(ctx, songID, pageReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, domain.PageRequest) *domain.Page[domain.Revision]); ok {
+		r0 = rf(ctx, songID, pageReq)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Page[domain.Revision])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, domain.PageRequest) error); ok {
+		r1 = rf(ctx, songID, pageReq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SongsRepositoryMock_GetSongRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSongRevisions'
+type SongsRepositoryMock_GetSongRevisions_Call struct {
+	*mock.Call
+}
+
+// GetSongRevisions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - songID int
+//   - pageReq domain.PageRequest
+func (_e *SongsRepositoryMock_Expecter) GetSongRevisions(ctx interface{}, songID interface{}, pageReq interface{}) *SongsRepositoryMock_GetSongRevisions_Call {
+	return &SongsRepositoryMock_GetSongRevisions_Call{Call: _e.mock.On("GetSongRevisions", ctx, songID, pageReq)}
+}
+
+func (_c *SongsRepositoryMock_GetSongRevisions_Call) Run(run func(ctx context.Context, songID int, pageReq domain.PageRequest)) *SongsRepositoryMock_GetSongRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(domain.PageRequest))
+	})
+	return _c
+}
+
+func (_c *SongsRepositoryMock_GetSongRevisions_Call) Return(_a0 *domain.Page[domain.Revision], _a1 error) *SongsRepositoryMock_GetSongRevisions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SongsRepositoryMock_GetSongRevisions_Call) RunAndReturn(run func(context.Context, int, domain.PageRequest) (*domain.Page[domain.Revision], error)) *SongsRepositoryMock_GetSongRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSongs provides a mock function with given fields: ctx, filter, pageReq
+func (_m *SongsRepositoryMock) GetSongs(ctx context.Context, filter *domain.SongFilter, pageReq domain.PageRequest) (*domain.Page[domain.Song], error) {
+	ret := _m.Called(ctx, filter, pageReq)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetSongs")
 	}
 
-	var r0 []domain.Song
+	var r0 *domain.Page[domain.Song]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, map[string]string, int, int) ([]domain.Song, error)); ok {
-		return rf(ctx, filters, page, size)
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.SongFilter, domain.PageRequest) (*domain.Page[domain.Song], error)); ok {
+		return rf(ctx, filter, pageReq)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, map[string]string, int, int) []domain.Song); ok {
-		r0 = rf(ctx, filters, page, size)
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.SongFilter, domain.PageRequest) *domain.Page[domain.Song]); ok {
+		r0 = rf(ctx, filter, pageReq)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]domain.Song)
+			r0 = ret.Get(0).(*domain.Page[domain.Song])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, map[string]string, int, int) error); ok {
-		r1 = rf(ctx, filters, page, size)
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.SongFilter, domain.PageRequest) error); ok {
+		r1 = rf(ctx, filter, pageReq)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -223,41 +500,314 @@ type SongsRepositoryMock_GetSongs_Call struct {
 
 // GetSongs is a helper method to define mock.On call
 //   - ctx context.Context
-//   - filters map[string]string
+//   - filter *domain.SongFilter
+//   - pageReq domain.PageRequest
+func (_e *SongsRepositoryMock_Expecter) GetSongs(ctx interface{}, filter interface{}, pageReq interface{}) *SongsRepositoryMock_GetSongs_Call {
+	return &SongsRepositoryMock_GetSongs_Call{Call: _e.mock.On("GetSongs", ctx, filter, pageReq)}
+}
+
+func (_c *SongsRepositoryMock_GetSongs_Call) Run(run func(ctx context.Context, filter *domain.SongFilter, pageReq domain.PageRequest)) *SongsRepositoryMock_GetSongs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.SongFilter), args[2].(domain.PageRequest))
+	})
+	return _c
+}
+
+func (_c *SongsRepositoryMock_GetSongs_Call) Return(_a0 *domain.Page[domain.Song], _a1 error) *SongsRepositoryMock_GetSongs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SongsRepositoryMock_GetSongs_Call) RunAndReturn(run func(context.Context, *domain.SongFilter, domain.PageRequest) (*domain.Page[domain.Song], error)) *SongsRepositoryMock_GetSongs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTrashedSongs provides a mock function with given fields: ctx, pageReq
+func (_m *SongsRepositoryMock) GetTrashedSongs(ctx context.Context, pageReq domain.PageRequest) (*domain.Page[domain.Song], error) {
+	ret := _m.Called(ctx, pageReq)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTrashedSongs")
+	}
+
+	var r0 *domain.Page[domain.Song]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PageRequest) (*domain.Page[domain.Song], error)); ok {
+		return rf(ctx, pageReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PageRequest) *domain.Page[domain.Song]); ok {
+		r0 = rf(ctx, pageReq)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Page[domain.Song])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.PageRequest) error); ok {
+		r1 = rf(ctx, pageReq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SongsRepositoryMock_GetTrashedSongs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTrashedSongs'
+type SongsRepositoryMock_GetTrashedSongs_Call struct {
+	*mock.Call
+}
+
+// GetTrashedSongs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pageReq domain.PageRequest
+func (_e *SongsRepositoryMock_Expecter) GetTrashedSongs(ctx interface{}, pageReq interface{}) *SongsRepositoryMock_GetTrashedSongs_Call {
+	return &SongsRepositoryMock_GetTrashedSongs_Call{Call: _e.mock.On("GetTrashedSongs", ctx, pageReq)}
+}
+
+func (_c *SongsRepositoryMock_GetTrashedSongs_Call) Run(run func(ctx context.Context, pageReq domain.PageRequest)) *SongsRepositoryMock_GetTrashedSongs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.PageRequest))
+	})
+	return _c
+}
+
+func (_c *SongsRepositoryMock_GetTrashedSongs_Call) Return(_a0 *domain.Page[domain.Song], _a1 error) *SongsRepositoryMock_GetTrashedSongs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SongsRepositoryMock_GetTrashedSongs_Call) RunAndReturn(run func(context.Context, domain.PageRequest) (*domain.Page[domain.Song], error)) *SongsRepositoryMock_GetTrashedSongs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeDeletedSongs provides a mock function with given fields: ctx, deletedBefore
+func (_m *SongsRepositoryMock) PurgeDeletedSongs(ctx context.Context, deletedBefore time.Time) ([]domain.Song, error) {
+	ret := _m.Called(ctx, deletedBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeDeletedSongs")
+	}
+
+	var r0 []domain.Song
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]domain.Song, error)); ok {
+		return rf(ctx, deletedBefore)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []domain.Song); ok {
+		r0 = rf(ctx, deletedBefore)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Song)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, deletedBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SongsRepositoryMock_PurgeDeletedSongs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeDeletedSongs'
+type SongsRepositoryMock_PurgeDeletedSongs_Call struct {
+	*mock.Call
+}
+
+// PurgeDeletedSongs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deletedBefore time.Time
+func (_e *SongsRepositoryMock_Expecter) PurgeDeletedSongs(ctx interface{}, deletedBefore interface{}) *SongsRepositoryMock_PurgeDeletedSongs_Call {
+	return &SongsRepositoryMock_PurgeDeletedSongs_Call{Call: _e.mock.On("PurgeDeletedSongs", ctx, deletedBefore)}
+}
+
+func (_c *SongsRepositoryMock_PurgeDeletedSongs_Call) Run(run func(ctx context.Context, deletedBefore time.Time)) *SongsRepositoryMock_PurgeDeletedSongs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *SongsRepositoryMock_PurgeDeletedSongs_Call) Return(_a0 []domain.Song, _a1 error) *SongsRepositoryMock_PurgeDeletedSongs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SongsRepositoryMock_PurgeDeletedSongs_Call) RunAndReturn(run func(context.Context, time.Time) ([]domain.Song, error)) *SongsRepositoryMock_PurgeDeletedSongs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreSong provides a mock function with given fields: ctx, id
+func (_m *SongsRepositoryMock) RestoreSong(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreSong")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SongsRepositoryMock_RestoreSong_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreSong'
+type SongsRepositoryMock_RestoreSong_Call struct {
+	*mock.Call
+}
+
+// RestoreSong is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *SongsRepositoryMock_Expecter) RestoreSong(ctx interface{}, id interface{}) *SongsRepositoryMock_RestoreSong_Call {
+	return &SongsRepositoryMock_RestoreSong_Call{Call: _e.mock.On("RestoreSong", ctx, id)}
+}
+
+func (_c *SongsRepositoryMock_RestoreSong_Call) Run(run func(ctx context.Context, id int)) *SongsRepositoryMock_RestoreSong_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *SongsRepositoryMock_RestoreSong_Call) Return(_a0 error) *SongsRepositoryMock_RestoreSong_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SongsRepositoryMock_RestoreSong_Call) RunAndReturn(run func(context.Context, int) error) *SongsRepositoryMock_RestoreSong_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchSongs provides a mock function with given fields: ctx, query, page, size
+func (_m *SongsRepositoryMock) SearchSongs(ctx context.Context, query string, page int, size int) ([]domain.SongSearchResult, error) {
+	ret := _m.Called(ctx, query, page, size)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchSongs")
+	}
+
+	var r0 []domain.SongSearchResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) ([]domain.SongSearchResult, error)); ok {
+		return rf(ctx, query, page, size)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) []domain.SongSearchResult); ok {
+		r0 = rf(ctx, query, page, size)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.SongSearchResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, int) error); ok {
+		r1 = rf(ctx, query, page, size)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SongsRepositoryMock_SearchSongs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchSongs'
+type SongsRepositoryMock_SearchSongs_Call struct {
+	*mock.Call
+}
+
+// SearchSongs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query string
 //   - page int
 //   - size int
-func (_e *SongsRepositoryMock_Expecter) GetSongs(ctx interface{}, filters interface{}, page interface{}, size interface{}) *SongsRepositoryMock_GetSongs_Call {
-	return &SongsRepositoryMock_GetSongs_Call{Call: _e.mock.On("GetSongs", ctx, filters, page, size)}
+func (_e *SongsRepositoryMock_Expecter) SearchSongs(ctx interface{}, query interface{}, page interface{}, size interface{}) *SongsRepositoryMock_SearchSongs_Call {
+	return &SongsRepositoryMock_SearchSongs_Call{Call: _e.mock.On("SearchSongs", ctx, query, page, size)}
 }
 
-func (_c *SongsRepositoryMock_GetSongs_Call) Run(run func(ctx context.Context, filters map[string]string, page int, size int)) *SongsRepositoryMock_GetSongs_Call {
+func (_c *SongsRepositoryMock_SearchSongs_Call) Run(run func(ctx context.Context, query string, page int, size int)) *SongsRepositoryMock_SearchSongs_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(map[string]string), args[2].(int), args[3].(int))
+		run(args[0].(context.Context), args[1].(string), args[2].(int), args[3].(int))
 	})
 	return _c
 }
 
-func (_c *SongsRepositoryMock_GetSongs_Call) Return(_a0 []domain.Song, _a1 error) *SongsRepositoryMock_GetSongs_Call {
+func (_c *SongsRepositoryMock_SearchSongs_Call) Return(_a0 []domain.SongSearchResult, _a1 error) *SongsRepositoryMock_SearchSongs_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *SongsRepositoryMock_GetSongs_Call) RunAndReturn(run func(context.Context, map[string]string, int, int) ([]domain.Song, error)) *SongsRepositoryMock_GetSongs_Call {
+func (_c *SongsRepositoryMock_SearchSongs_Call) RunAndReturn(run func(context.Context, string, int, int) ([]domain.SongSearchResult, error)) *SongsRepositoryMock_SearchSongs_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateSong provides a mock function with given fields: ctx, song
-func (_m *SongsRepositoryMock) UpdateSong(ctx context.Context, song *domain.Song) error {
-	ret := _m.Called(ctx, song)
+// SetSongArtists provides a mock function with given fields: ctx, songID, artists
+func (_m *SongsRepositoryMock) SetSongArtists(ctx context.Context, songID int, artists []domain.SongArtist) error {
+	ret := _m.Called(ctx, songID, artists)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetSongArtists")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, []domain.SongArtist) error); ok {
+		r0 = rf(ctx, songID, artists)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SongsRepositoryMock_SetSongArtists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetSongArtists'
+type SongsRepositoryMock_SetSongArtists_Call struct {
+	*mock.Call
+}
+
+// SetSongArtists is a helper method to define mock.On call
+//   - ctx context.Context
+//   - songID int
+//   - artists []domain.SongArtist
+func (_e *SongsRepositoryMock_Expecter) SetSongArtists(ctx interface{}, songID interface{}, artists interface{}) *SongsRepositoryMock_SetSongArtists_Call {
+	return &SongsRepositoryMock_SetSongArtists_Call{Call: _e.mock.On("SetSongArtists", ctx, songID, artists)}
+}
+
+func (_c *SongsRepositoryMock_SetSongArtists_Call) Run(run func(ctx context.Context, songID int, artists []domain.SongArtist)) *SongsRepositoryMock_SetSongArtists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].([]domain.SongArtist))
+	})
+	return _c
+}
+
+func (_c *SongsRepositoryMock_SetSongArtists_Call) Return(_a0 error) *SongsRepositoryMock_SetSongArtists_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SongsRepositoryMock_SetSongArtists_Call) RunAndReturn(run func(context.Context, int, []domain.SongArtist) error) *SongsRepositoryMock_SetSongArtists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSong provides a mock function with given fields: ctx, song, info
+func (_m *SongsRepositoryMock) UpdateSong(ctx context.Context, song *domain.Song, info domain.RevisionInfo) error {
+	ret := _m.Called(ctx, song, info)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateSong")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *domain.Song) error); ok {
-		r0 = rf(ctx, song)
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Song, domain.RevisionInfo) error); ok {
+		r0 = rf(ctx, song, info)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -273,13 +823,14 @@ type SongsRepositoryMock_UpdateSong_Call struct {
 // UpdateSong is a helper method to define mock.On call
 //   - ctx context.Context
 //   - song *domain.Song
-func (_e *SongsRepositoryMock_Expecter) UpdateSong(ctx interface{}, song interface{}) *SongsRepositoryMock_UpdateSong_Call {
-	return &SongsRepositoryMock_UpdateSong_Call{Call: _e.mock.On("UpdateSong", ctx, song)}
+//   - info domain.RevisionInfo
+func (_e *SongsRepositoryMock_Expecter) UpdateSong(ctx interface{}, song interface{}, info interface{}) *SongsRepositoryMock_UpdateSong_Call {
+	return &SongsRepositoryMock_UpdateSong_Call{Call: _e.mock.On("UpdateSong", ctx, song, info)}
 }
 
-func (_c *SongsRepositoryMock_UpdateSong_Call) Run(run func(ctx context.Context, song *domain.Song)) *SongsRepositoryMock_UpdateSong_Call {
+func (_c *SongsRepositoryMock_UpdateSong_Call) Run(run func(ctx context.Context, song *domain.Song, info domain.RevisionInfo)) *SongsRepositoryMock_UpdateSong_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*domain.Song))
+		run(args[0].(context.Context), args[1].(*domain.Song), args[2].(domain.RevisionInfo))
 	})
 	return _c
 }
@@ -289,7 +840,7 @@ func (_c *SongsRepositoryMock_UpdateSong_Call) Return(_a0 error) *SongsRepositor
 	return _c
 }
 
-func (_c *SongsRepositoryMock_UpdateSong_Call) RunAndReturn(run func(context.Context, *domain.Song) error) *SongsRepositoryMock_UpdateSong_Call {
+func (_c *SongsRepositoryMock_UpdateSong_Call) RunAndReturn(run func(context.Context, *domain.Song, domain.RevisionInfo) error) *SongsRepositoryMock_UpdateSong_Call {
 	_c.Call.Return(run)
 	return _c
 }
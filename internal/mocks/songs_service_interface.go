@@ -7,6 +7,8 @@ import (
 
 	domain "github.com/mashfeii/songs_library/internal/domain"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // SongsServiceInterfaceMock is an autogenerated mock type for the SongsServiceInterface type
@@ -79,17 +81,17 @@ func (_c *SongsServiceInterfaceMock_AddSong_Call) RunAndReturn(run func(context.
 	return _c
 }
 
-// DeleteSong provides a mock function with given fields: ctx, id
-func (_m *SongsServiceInterfaceMock) DeleteSong(ctx context.Context, id int) error {
-	ret := _m.Called(ctx, id)
+// DeleteSong provides a mock function with given fields: ctx, id, version
+func (_m *SongsServiceInterfaceMock) DeleteSong(ctx context.Context, id int, version int) error {
+	ret := _m.Called(ctx, id, version)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteSong")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) error); ok {
+		r0 = rf(ctx, id, version)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -105,13 +107,14 @@ type SongsServiceInterfaceMock_DeleteSong_Call struct {
 // DeleteSong is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id int
-func (_e *SongsServiceInterfaceMock_Expecter) DeleteSong(ctx interface{}, id interface{}) *SongsServiceInterfaceMock_DeleteSong_Call {
-	return &SongsServiceInterfaceMock_DeleteSong_Call{Call: _e.mock.On("DeleteSong", ctx, id)}
+//   - version int
+func (_e *SongsServiceInterfaceMock_Expecter) DeleteSong(ctx interface{}, id interface{}, version interface{}) *SongsServiceInterfaceMock_DeleteSong_Call {
+	return &SongsServiceInterfaceMock_DeleteSong_Call{Call: _e.mock.On("DeleteSong", ctx, id, version)}
 }
 
-func (_c *SongsServiceInterfaceMock_DeleteSong_Call) Run(run func(ctx context.Context, id int)) *SongsServiceInterfaceMock_DeleteSong_Call {
+func (_c *SongsServiceInterfaceMock_DeleteSong_Call) Run(run func(ctx context.Context, id int, version int)) *SongsServiceInterfaceMock_DeleteSong_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int))
+		run(args[0].(context.Context), args[1].(int), args[2].(int))
 	})
 	return _c
 }
@@ -121,34 +124,329 @@ func (_c *SongsServiceInterfaceMock_DeleteSong_Call) Return(_a0 error) *SongsSer
 	return _c
 }
 
-func (_c *SongsServiceInterfaceMock_DeleteSong_Call) RunAndReturn(run func(context.Context, int) error) *SongsServiceInterfaceMock_DeleteSong_Call {
+func (_c *SongsServiceInterfaceMock_DeleteSong_Call) RunAndReturn(run func(context.Context, int, int) error) *SongsServiceInterfaceMock_DeleteSong_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetSongVerses provides a mock function with given fields: ctx, id, page, size
-func (_m *SongsServiceInterfaceMock) GetSongVerses(ctx context.Context, id int, page int, size int) ([]string, error) {
-	ret := _m.Called(ctx, id, page, size)
+// DiffSongRevisions provides a mock function with given fields: ctx, id, from, to
+func (_m *SongsServiceInterfaceMock) DiffSongRevisions(ctx context.Context, id int, from int, to int) (string, error) {
+	ret := _m.Called(ctx, id, from, to)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetSongVerses")
+		panic("no return value specified for DiffSongRevisions")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, int) (string, error)); ok {
+		return rf(ctx, id, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, int) string); ok {
+		r0 = rf(ctx, id, from, to)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, int) error); ok {
+		r1 = rf(ctx, id, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SongsServiceInterfaceMock_DiffSongRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DiffSongRevisions'
+type SongsServiceInterfaceMock_DiffSongRevisions_Call struct {
+	*mock.Call
+}
+
+// DiffSongRevisions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - from int
+//   - to int
+func (_e *SongsServiceInterfaceMock_Expecter) DiffSongRevisions(ctx interface{}, id interface{}, from interface{}, to interface{}) *SongsServiceInterfaceMock_DiffSongRevisions_Call {
+	return &SongsServiceInterfaceMock_DiffSongRevisions_Call{Call: _e.mock.On("DiffSongRevisions", ctx, id, from, to)}
+}
+
+func (_c *SongsServiceInterfaceMock_DiffSongRevisions_Call) Run(run func(ctx context.Context, id int, from int, to int)) *SongsServiceInterfaceMock_DiffSongRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *SongsServiceInterfaceMock_DiffSongRevisions_Call) Return(_a0 string, _a1 error) *SongsServiceInterfaceMock_DiffSongRevisions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SongsServiceInterfaceMock_DiffSongRevisions_Call) RunAndReturn(run func(context.Context, int, int, int) (string, error)) *SongsServiceInterfaceMock_DiffSongRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnrichPendingSong provides a mock function with given fields: ctx, lease
+func (_m *SongsServiceInterfaceMock) EnrichPendingSong(ctx context.Context, lease time.Duration) (bool, error) {
+	ret := _m.Called(ctx, lease)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnrichPendingSong")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration) (bool, error)); ok {
+		return rf(ctx, lease)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration) bool); ok {
+		r0 = rf(ctx, lease)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Duration) error); ok {
+		r1 = rf(ctx, lease)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SongsServiceInterfaceMock_EnrichPendingSong_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnrichPendingSong'
+type SongsServiceInterfaceMock_EnrichPendingSong_Call struct {
+	*mock.Call
+}
+
+// EnrichPendingSong is a helper method to define mock.On call
+//   - ctx context.Context
+//   - lease time.Duration
+func (_e *SongsServiceInterfaceMock_Expecter) EnrichPendingSong(ctx interface{}, lease interface{}) *SongsServiceInterfaceMock_EnrichPendingSong_Call {
+	return &SongsServiceInterfaceMock_EnrichPendingSong_Call{Call: _e.mock.On("EnrichPendingSong", ctx, lease)}
+}
+
+func (_c *SongsServiceInterfaceMock_EnrichPendingSong_Call) Run(run func(ctx context.Context, lease time.Duration)) *SongsServiceInterfaceMock_EnrichPendingSong_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *SongsServiceInterfaceMock_EnrichPendingSong_Call) Return(_a0 bool, _a1 error) *SongsServiceInterfaceMock_EnrichPendingSong_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SongsServiceInterfaceMock_EnrichPendingSong_Call) RunAndReturn(run func(context.Context, time.Duration) (bool, error)) *SongsServiceInterfaceMock_EnrichPendingSong_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSong provides a mock function with given fields: ctx, id
+func (_m *SongsServiceInterfaceMock) GetSong(ctx context.Context, id int) (*domain.Song, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSong")
 	}
 
-	var r0 []string
+	var r0 *domain.Song
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int, int, int) ([]string, error)); ok {
-		return rf(ctx, id, page, size)
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*domain.Song, error)); ok {
+		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int, int, int) []string); ok {
-		r0 = rf(ctx, id, page, size)
+	if rf, ok := ret.Get(0).(func(context.Context, int) *domain.Song); ok {
+		r0 = rf(ctx, id)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]string)
+			r0 = ret.Get(0).(*domain.Song)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int, int, int) error); ok {
-		r1 = rf(ctx, id, page, size)
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SongsServiceInterfaceMock_GetSong_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSong'
+type SongsServiceInterfaceMock_GetSong_Call struct {
+	*mock.Call
+}
+
+// GetSong is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *SongsServiceInterfaceMock_Expecter) GetSong(ctx interface{}, id interface{}) *SongsServiceInterfaceMock_GetSong_Call {
+	return &SongsServiceInterfaceMock_GetSong_Call{Call: _e.mock.On("GetSong", ctx, id)}
+}
+
+func (_c *SongsServiceInterfaceMock_GetSong_Call) Run(run func(ctx context.Context, id int)) *SongsServiceInterfaceMock_GetSong_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *SongsServiceInterfaceMock_GetSong_Call) Return(_a0 *domain.Song, _a1 error) *SongsServiceInterfaceMock_GetSong_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SongsServiceInterfaceMock_GetSong_Call) RunAndReturn(run func(context.Context, int) (*domain.Song, error)) *SongsServiceInterfaceMock_GetSong_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSongRevision provides a mock function with given fields: ctx, id, revision
+func (_m *SongsServiceInterfaceMock) GetSongRevision(ctx context.Context, id int, revision int) (*domain.Revision, error) {
+	ret := _m.Called(ctx, id, revision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSongRevision")
+	}
+
+	var r0 *domain.Revision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) (*domain.Revision, error)); ok {
+		return rf(ctx, id, revision)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) *domain.Revision); ok {
+		r0 = rf(ctx, id, revision)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Revision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, id, revision)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SongsServiceInterfaceMock_GetSongRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSongRevision'
+type SongsServiceInterfaceMock_GetSongRevision_Call struct {
+	*mock.Call
+}
+
+// GetSongRevision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - revision int
+func (_e *SongsServiceInterfaceMock_Expecter) GetSongRevision(ctx interface{}, id interface{}, revision interface{}) *SongsServiceInterfaceMock_GetSongRevision_Call {
+	return &SongsServiceInterfaceMock_GetSongRevision_Call{Call: _e.mock.On("GetSongRevision", ctx, id, revision)}
+}
+
+func (_c *SongsServiceInterfaceMock_GetSongRevision_Call) Run(run func(ctx context.Context, id int, revision int)) *SongsServiceInterfaceMock_GetSongRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *SongsServiceInterfaceMock_GetSongRevision_Call) Return(_a0 *domain.Revision, _a1 error) *SongsServiceInterfaceMock_GetSongRevision_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SongsServiceInterfaceMock_GetSongRevision_Call) RunAndReturn(run func(context.Context, int, int) (*domain.Revision, error)) *SongsServiceInterfaceMock_GetSongRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSongRevisions provides a mock function with given fields: ctx, id, pageReq
+func (_m *SongsServiceInterfaceMock) GetSongRevisions(ctx context.Context, id int, pageReq domain.PageRequest) (*domain.Page[domain.Revision], error) {
+	ret := _m.Called(ctx, id, pageReq)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSongRevisions")
+	}
+
+	var r0 *domain.Page[domain.Revision]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, domain.PageRequest) (*domain.Page[domain.Revision], error)); ok {
+		return rf(ctx, id, pageReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, domain.PageRequest) *domain.Page[domain.Revision]); ok {
+		r0 = rf(ctx, id, pageReq)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Page[domain.Revision])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, domain.PageRequest) error); ok {
+		r1 = rf(ctx, id, pageReq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SongsServiceInterfaceMock_GetSongRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSongRevisions'
+type SongsServiceInterfaceMock_GetSongRevisions_Call struct {
+	*mock.Call
+}
+
+// GetSongRevisions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - pageReq domain.PageRequest
+func (_e *SongsServiceInterfaceMock_Expecter) GetSongRevisions(ctx interface{}, id interface{}, pageReq interface{}) *SongsServiceInterfaceMock_GetSongRevisions_Call {
+	return &SongsServiceInterfaceMock_GetSongRevisions_Call{Call: _e.mock.On("GetSongRevisions", ctx, id, pageReq)}
+}
+
+func (_c *SongsServiceInterfaceMock_GetSongRevisions_Call) Run(run func(ctx context.Context, id int, pageReq domain.PageRequest)) *SongsServiceInterfaceMock_GetSongRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(domain.PageRequest))
+	})
+	return _c
+}
+
+func (_c *SongsServiceInterfaceMock_GetSongRevisions_Call) Return(_a0 *domain.Page[domain.Revision], _a1 error) *SongsServiceInterfaceMock_GetSongRevisions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SongsServiceInterfaceMock_GetSongRevisions_Call) RunAndReturn(run func(context.Context, int, domain.PageRequest) (*domain.Page[domain.Revision], error)) *SongsServiceInterfaceMock_GetSongRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSongStatus provides a mock function with given fields: ctx, id
+func (_m *SongsServiceInterfaceMock) GetSongStatus(ctx context.Context, id int) (*domain.Song, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSongStatus")
+	}
+
+	var r0 *domain.Song
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*domain.Song, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *domain.Song); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Song)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -156,6 +454,72 @@ func (_m *SongsServiceInterfaceMock) GetSongVerses(ctx context.Context, id int,
 	return r0, r1
 }
 
+// SongsServiceInterfaceMock_GetSongStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSongStatus'
+type SongsServiceInterfaceMock_GetSongStatus_Call struct {
+	*mock.Call
+}
+
+// GetSongStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *SongsServiceInterfaceMock_Expecter) GetSongStatus(ctx interface{}, id interface{}) *SongsServiceInterfaceMock_GetSongStatus_Call {
+	return &SongsServiceInterfaceMock_GetSongStatus_Call{Call: _e.mock.On("GetSongStatus", ctx, id)}
+}
+
+func (_c *SongsServiceInterfaceMock_GetSongStatus_Call) Run(run func(ctx context.Context, id int)) *SongsServiceInterfaceMock_GetSongStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *SongsServiceInterfaceMock_GetSongStatus_Call) Return(_a0 *domain.Song, _a1 error) *SongsServiceInterfaceMock_GetSongStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SongsServiceInterfaceMock_GetSongStatus_Call) RunAndReturn(run func(context.Context, int) (*domain.Song, error)) *SongsServiceInterfaceMock_GetSongStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSongVerses provides a mock function with given fields: ctx, id, opts
+func (_m *SongsServiceInterfaceMock) GetSongVerses(ctx context.Context, id int, opts domain.VersesOptions) ([]domain.Verse, int, error) {
+	ret := _m.Called(ctx, id, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSongVerses")
+	}
+
+	var r0 []domain.Verse
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, domain.VersesOptions) ([]domain.Verse, int, error)); ok {
+		return rf(ctx, id, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, domain.VersesOptions) []domain.Verse); ok {
+		r0 = rf(ctx, id, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Verse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, domain.VersesOptions) int); ok {
+		r1 = rf(ctx, id, opts)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int, domain.VersesOptions) error); ok {
+		r2 = rf(ctx, id, opts)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // SongsServiceInterfaceMock_GetSongVerses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSongVerses'
 type SongsServiceInterfaceMock_GetSongVerses_Call struct {
 	*mock.Call
@@ -164,52 +528,51 @@ type SongsServiceInterfaceMock_GetSongVerses_Call struct {
 // GetSongVerses is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id int
-//   - page int
-//   - size int
-func (_e *SongsServiceInterfaceMock_Expecter) GetSongVerses(ctx interface{}, id interface{}, page interface{}, size interface{}) *SongsServiceInterfaceMock_GetSongVerses_Call {
-	return &SongsServiceInterfaceMock_GetSongVerses_Call{Call: _e.mock.On("GetSongVerses", ctx, id, page, size)}
+//   - opts domain.VersesOptions
+func (_e *SongsServiceInterfaceMock_Expecter) GetSongVerses(ctx interface{}, id interface{}, opts interface{}) *SongsServiceInterfaceMock_GetSongVerses_Call {
+	return &SongsServiceInterfaceMock_GetSongVerses_Call{Call: _e.mock.On("GetSongVerses", ctx, id, opts)}
 }
 
-func (_c *SongsServiceInterfaceMock_GetSongVerses_Call) Run(run func(ctx context.Context, id int, page int, size int)) *SongsServiceInterfaceMock_GetSongVerses_Call {
+func (_c *SongsServiceInterfaceMock_GetSongVerses_Call) Run(run func(ctx context.Context, id int, opts domain.VersesOptions)) *SongsServiceInterfaceMock_GetSongVerses_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int), args[2].(int), args[3].(int))
+		run(args[0].(context.Context), args[1].(int), args[2].(domain.VersesOptions))
 	})
 	return _c
 }
 
-func (_c *SongsServiceInterfaceMock_GetSongVerses_Call) Return(_a0 []string, _a1 error) *SongsServiceInterfaceMock_GetSongVerses_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *SongsServiceInterfaceMock_GetSongVerses_Call) Return(_a0 []domain.Verse, _a1 int, _a2 error) *SongsServiceInterfaceMock_GetSongVerses_Call {
+	_c.Call.Return(_a0, _a1, _a2)
 	return _c
 }
 
-func (_c *SongsServiceInterfaceMock_GetSongVerses_Call) RunAndReturn(run func(context.Context, int, int, int) ([]string, error)) *SongsServiceInterfaceMock_GetSongVerses_Call {
+func (_c *SongsServiceInterfaceMock_GetSongVerses_Call) RunAndReturn(run func(context.Context, int, domain.VersesOptions) ([]domain.Verse, int, error)) *SongsServiceInterfaceMock_GetSongVerses_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetSongs provides a mock function with given fields: ctx, filters, page, size
-func (_m *SongsServiceInterfaceMock) GetSongs(ctx context.Context, filters map[string]string, page int, size int) ([]domain.Song, error) {
-	ret := _m.Called(ctx, filters, page, size)
+// GetSongs provides a mock function with given fields: ctx, filter, pageReq
+func (_m *SongsServiceInterfaceMock) GetSongs(ctx context.Context, filter *domain.SongFilter, pageReq domain.PageRequest) (*domain.Page[domain.Song], error) {
+	ret := _m.Called(ctx, filter, pageReq)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetSongs")
 	}
 
-	var r0 []domain.Song
+	var r0 *domain.Page[domain.Song]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, map[string]string, int, int) ([]domain.Song, error)); ok {
-		return rf(ctx, filters, page, size)
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.SongFilter, domain.PageRequest) (*domain.Page[domain.Song], error)); ok {
+		return rf(ctx, filter, pageReq)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, map[string]string, int, int) []domain.Song); ok {
-		r0 = rf(ctx, filters, page, size)
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.SongFilter, domain.PageRequest) *domain.Page[domain.Song]); ok {
+		r0 = rf(ctx, filter, pageReq)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]domain.Song)
+			r0 = ret.Get(0).(*domain.Page[domain.Song])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, map[string]string, int, int) error); ok {
-		r1 = rf(ctx, filters, page, size)
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.SongFilter, domain.PageRequest) error); ok {
+		r1 = rf(ctx, filter, pageReq)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -224,41 +587,516 @@ type SongsServiceInterfaceMock_GetSongs_Call struct {
 
 // GetSongs is a helper method to define mock.On call
 //   - ctx context.Context
-//   - filters map[string]string
-//   - page int
-//   - size int
-func (_e *SongsServiceInterfaceMock_Expecter) GetSongs(ctx interface{}, filters interface{}, page interface{}, size interface{}) *SongsServiceInterfaceMock_GetSongs_Call {
-	return &SongsServiceInterfaceMock_GetSongs_Call{Call: _e.mock.On("GetSongs", ctx, filters, page, size)}
+//   - filter *domain.SongFilter
+//   - pageReq domain.PageRequest
+func (_e *SongsServiceInterfaceMock_Expecter) GetSongs(ctx interface{}, filter interface{}, pageReq interface{}) *SongsServiceInterfaceMock_GetSongs_Call {
+	return &SongsServiceInterfaceMock_GetSongs_Call{Call: _e.mock.On("GetSongs", ctx, filter, pageReq)}
+}
+
+func (_c *SongsServiceInterfaceMock_GetSongs_Call) Run(run func(ctx context.Context, filter *domain.SongFilter, pageReq domain.PageRequest)) *SongsServiceInterfaceMock_GetSongs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.SongFilter), args[2].(domain.PageRequest))
+	})
+	return _c
+}
+
+func (_c *SongsServiceInterfaceMock_GetSongs_Call) Return(_a0 *domain.Page[domain.Song], _a1 error) *SongsServiceInterfaceMock_GetSongs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SongsServiceInterfaceMock_GetSongs_Call) RunAndReturn(run func(context.Context, *domain.SongFilter, domain.PageRequest) (*domain.Page[domain.Song], error)) *SongsServiceInterfaceMock_GetSongs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTrashedSongs provides a mock function with given fields: ctx, pageReq
+func (_m *SongsServiceInterfaceMock) GetTrashedSongs(ctx context.Context, pageReq domain.PageRequest) (*domain.Page[domain.Song], error) {
+	ret := _m.Called(ctx, pageReq)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTrashedSongs")
+	}
+
+	var r0 *domain.Page[domain.Song]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PageRequest) (*domain.Page[domain.Song], error)); ok {
+		return rf(ctx, pageReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PageRequest) *domain.Page[domain.Song]); ok {
+		r0 = rf(ctx, pageReq)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Page[domain.Song])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.PageRequest) error); ok {
+		r1 = rf(ctx, pageReq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SongsServiceInterfaceMock_GetTrashedSongs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTrashedSongs'
+type SongsServiceInterfaceMock_GetTrashedSongs_Call struct {
+	*mock.Call
+}
+
+// GetTrashedSongs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pageReq domain.PageRequest
+func (_e *SongsServiceInterfaceMock_Expecter) GetTrashedSongs(ctx interface{}, pageReq interface{}) *SongsServiceInterfaceMock_GetTrashedSongs_Call {
+	return &SongsServiceInterfaceMock_GetTrashedSongs_Call{Call: _e.mock.On("GetTrashedSongs", ctx, pageReq)}
+}
+
+func (_c *SongsServiceInterfaceMock_GetTrashedSongs_Call) Run(run func(ctx context.Context, pageReq domain.PageRequest)) *SongsServiceInterfaceMock_GetTrashedSongs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.PageRequest))
+	})
+	return _c
+}
+
+func (_c *SongsServiceInterfaceMock_GetTrashedSongs_Call) Return(_a0 *domain.Page[domain.Song], _a1 error) *SongsServiceInterfaceMock_GetTrashedSongs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SongsServiceInterfaceMock_GetTrashedSongs_Call) RunAndReturn(run func(context.Context, domain.PageRequest) (*domain.Page[domain.Song], error)) *SongsServiceInterfaceMock_GetTrashedSongs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PatchSong provides a mock function with given fields: ctx, id, version, patch, reason
+func (_m *SongsServiceInterfaceMock) PatchSong(ctx context.Context, id int, version int, patch []byte, reason string) (*domain.Song, error) {
+	ret := _m.Called(ctx, id, version, patch, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PatchSong")
+	}
+
+	var r0 *domain.Song
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, []byte, string) (*domain.Song, error)); ok {
+		return rf(ctx, id, version, patch, reason)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, []byte, string) *domain.Song); ok {
+		r0 = rf(ctx, id, version, patch, reason)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Song)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, []byte, string) error); ok {
+		r1 = rf(ctx, id, version, patch, reason)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SongsServiceInterfaceMock_PatchSong_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PatchSong'
+type SongsServiceInterfaceMock_PatchSong_Call struct {
+	*mock.Call
+}
+
+// PatchSong is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - version int
+//   - patch []byte
+//   - reason string
+func (_e *SongsServiceInterfaceMock_Expecter) PatchSong(ctx interface{}, id interface{}, version interface{}, patch interface{}, reason interface{}) *SongsServiceInterfaceMock_PatchSong_Call {
+	return &SongsServiceInterfaceMock_PatchSong_Call{Call: _e.mock.On("PatchSong", ctx, id, version, patch, reason)}
+}
+
+func (_c *SongsServiceInterfaceMock_PatchSong_Call) Run(run func(ctx context.Context, id int, version int, patch []byte, reason string)) *SongsServiceInterfaceMock_PatchSong_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int), args[3].([]byte), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *SongsServiceInterfaceMock_PatchSong_Call) Return(_a0 *domain.Song, _a1 error) *SongsServiceInterfaceMock_PatchSong_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SongsServiceInterfaceMock_PatchSong_Call) RunAndReturn(run func(context.Context, int, int, []byte, string) (*domain.Song, error)) *SongsServiceInterfaceMock_PatchSong_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeDeletedSongs provides a mock function with given fields: ctx, retention
+func (_m *SongsServiceInterfaceMock) PurgeDeletedSongs(ctx context.Context, retention time.Duration) (int, error) {
+	ret := _m.Called(ctx, retention)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeDeletedSongs")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration) (int, error)); ok {
+		return rf(ctx, retention)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration) int); ok {
+		r0 = rf(ctx, retention)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Duration) error); ok {
+		r1 = rf(ctx, retention)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SongsServiceInterfaceMock_PurgeDeletedSongs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeDeletedSongs'
+type SongsServiceInterfaceMock_PurgeDeletedSongs_Call struct {
+	*mock.Call
+}
+
+// PurgeDeletedSongs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - retention time.Duration
+func (_e *SongsServiceInterfaceMock_Expecter) PurgeDeletedSongs(ctx interface{}, retention interface{}) *SongsServiceInterfaceMock_PurgeDeletedSongs_Call {
+	return &SongsServiceInterfaceMock_PurgeDeletedSongs_Call{Call: _e.mock.On("PurgeDeletedSongs", ctx, retention)}
+}
+
+func (_c *SongsServiceInterfaceMock_PurgeDeletedSongs_Call) Run(run func(ctx context.Context, retention time.Duration)) *SongsServiceInterfaceMock_PurgeDeletedSongs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *SongsServiceInterfaceMock_PurgeDeletedSongs_Call) Return(_a0 int, _a1 error) *SongsServiceInterfaceMock_PurgeDeletedSongs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SongsServiceInterfaceMock_PurgeDeletedSongs_Call) RunAndReturn(run func(context.Context, time.Duration) (int, error)) *SongsServiceInterfaceMock_PurgeDeletedSongs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// QueueSong provides a mock function with given fields: ctx, songReq
+func (_m *SongsServiceInterfaceMock) QueueSong(ctx context.Context, songReq *domain.AddSongRequest) (int, error) {
+	ret := _m.Called(ctx, songReq)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QueueSong")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.AddSongRequest) (int, error)); ok {
+		return rf(ctx, songReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.AddSongRequest) int); ok {
+		r0 = rf(ctx, songReq)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.AddSongRequest) error); ok {
+		r1 = rf(ctx, songReq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SongsServiceInterfaceMock_QueueSong_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueueSong'
+type SongsServiceInterfaceMock_QueueSong_Call struct {
+	*mock.Call
+}
+
+// QueueSong is a helper method to define mock.On call
+//   - ctx context.Context
+//   - songReq *domain.AddSongRequest
+func (_e *SongsServiceInterfaceMock_Expecter) QueueSong(ctx interface{}, songReq interface{}) *SongsServiceInterfaceMock_QueueSong_Call {
+	return &SongsServiceInterfaceMock_QueueSong_Call{Call: _e.mock.On("QueueSong", ctx, songReq)}
+}
+
+func (_c *SongsServiceInterfaceMock_QueueSong_Call) Run(run func(ctx context.Context, songReq *domain.AddSongRequest)) *SongsServiceInterfaceMock_QueueSong_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.AddSongRequest))
+	})
+	return _c
+}
+
+func (_c *SongsServiceInterfaceMock_QueueSong_Call) Return(_a0 int, _a1 error) *SongsServiceInterfaceMock_QueueSong_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SongsServiceInterfaceMock_QueueSong_Call) RunAndReturn(run func(context.Context, *domain.AddSongRequest) (int, error)) *SongsServiceInterfaceMock_QueueSong_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreSong provides a mock function with given fields: ctx, id
+func (_m *SongsServiceInterfaceMock) RestoreSong(ctx context.Context, id int) (*domain.Song, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreSong")
+	}
+
+	var r0 *domain.Song
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*domain.Song, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *domain.Song); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Song)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SongsServiceInterfaceMock_RestoreSong_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreSong'
+type SongsServiceInterfaceMock_RestoreSong_Call struct {
+	*mock.Call
+}
+
+// RestoreSong is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *SongsServiceInterfaceMock_Expecter) RestoreSong(ctx interface{}, id interface{}) *SongsServiceInterfaceMock_RestoreSong_Call {
+	return &SongsServiceInterfaceMock_RestoreSong_Call{Call: _e.mock.On("RestoreSong", ctx, id)}
+}
+
+func (_c *SongsServiceInterfaceMock_RestoreSong_Call) Run(run func(ctx context.Context, id int)) *SongsServiceInterfaceMock_RestoreSong_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *SongsServiceInterfaceMock_RestoreSong_Call) Return(_a0 *domain.Song, _a1 error) *SongsServiceInterfaceMock_RestoreSong_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SongsServiceInterfaceMock_RestoreSong_Call) RunAndReturn(run func(context.Context, int) (*domain.Song, error)) *SongsServiceInterfaceMock_RestoreSong_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreSongRevision provides a mock function with given fields: ctx, id, revision, reason
+func (_m *SongsServiceInterfaceMock) RestoreSongRevision(ctx context.Context, id int, revision int, reason string) (*domain.Song, error) {
+	ret := _m.Called(ctx, id, revision, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreSongRevision")
+	}
+
+	var r0 *domain.Song
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, string) (*domain.Song, error)); ok {
+		return rf(ctx, id, revision, reason)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, string) *domain.Song); ok {
+		r0 = rf(ctx, id, revision, reason)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Song)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, string) error); ok {
+		r1 = rf(ctx, id, revision, reason)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SongsServiceInterfaceMock_RestoreSongRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreSongRevision'
+type SongsServiceInterfaceMock_RestoreSongRevision_Call struct {
+	*mock.Call
+}
+
+// RestoreSongRevision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - revision int
+//   - reason string
+func (_e *SongsServiceInterfaceMock_Expecter) RestoreSongRevision(ctx interface{}, id interface{}, revision interface{}, reason interface{}) *SongsServiceInterfaceMock_RestoreSongRevision_Call {
+	return &SongsServiceInterfaceMock_RestoreSongRevision_Call{Call: _e.mock.On("RestoreSongRevision", ctx, id, revision, reason)}
+}
+
+func (_c *SongsServiceInterfaceMock_RestoreSongRevision_Call) Run(run func(ctx context.Context, id int, revision int, reason string)) *SongsServiceInterfaceMock_RestoreSongRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *SongsServiceInterfaceMock_RestoreSongRevision_Call) Return(_a0 *domain.Song, _a1 error) *SongsServiceInterfaceMock_RestoreSongRevision_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SongsServiceInterfaceMock_RestoreSongRevision_Call) RunAndReturn(run func(context.Context, int, int, string) (*domain.Song, error)) *SongsServiceInterfaceMock_RestoreSongRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchSongs provides a mock function with given fields: ctx, query, page, size
+func (_m *SongsServiceInterfaceMock) SearchSongs(ctx context.Context, query string, page int, size int) ([]domain.SongSearchResult, error) {
+	ret := _m.Called(ctx, query, page, size)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchSongs")
+	}
+
+	var r0 []domain.SongSearchResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) ([]domain.SongSearchResult, error)); ok {
+		return rf(ctx, query, page, size)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) []domain.SongSearchResult); ok {
+		r0 = rf(ctx, query, page, size)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.SongSearchResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, int) error); ok {
+		r1 = rf(ctx, query, page, size)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SongsServiceInterfaceMock_SearchSongs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchSongs'
+type SongsServiceInterfaceMock_SearchSongs_Call struct {
+	*mock.Call
+}
+
+// SearchSongs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query string
+//   - page int
+//   - size int
+func (_e *SongsServiceInterfaceMock_Expecter) SearchSongs(ctx interface{}, query interface{}, page interface{}, size interface{}) *SongsServiceInterfaceMock_SearchSongs_Call {
+	return &SongsServiceInterfaceMock_SearchSongs_Call{Call: _e.mock.On("SearchSongs", ctx, query, page, size)}
+}
+
+func (_c *SongsServiceInterfaceMock_SearchSongs_Call) Run(run func(ctx context.Context, query string, page int, size int)) *SongsServiceInterfaceMock_SearchSongs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *SongsServiceInterfaceMock_SearchSongs_Call) Return(_a0 []domain.SongSearchResult, _a1 error) *SongsServiceInterfaceMock_SearchSongs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SongsServiceInterfaceMock_SearchSongs_Call) RunAndReturn(run func(context.Context, string, int, int) ([]domain.SongSearchResult, error)) *SongsServiceInterfaceMock_SearchSongs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetSongArtists provides a mock function with given fields: ctx, id, req
+func (_m *SongsServiceInterfaceMock) SetSongArtists(ctx context.Context, id int, req *domain.SongArtistsRequest) (*domain.Song, error) {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetSongArtists")
+	}
+
+	var r0 *domain.Song
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *domain.SongArtistsRequest) (*domain.Song, error)); ok {
+		return rf(ctx, id, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, *domain.SongArtistsRequest) *domain.Song); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Song)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, *domain.SongArtistsRequest) error); ok {
+		r1 = rf(ctx, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SongsServiceInterfaceMock_SetSongArtists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetSongArtists'
+type SongsServiceInterfaceMock_SetSongArtists_Call struct {
+	*mock.Call
+}
+
+// SetSongArtists is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - req *domain.SongArtistsRequest
+func (_e *SongsServiceInterfaceMock_Expecter) SetSongArtists(ctx interface{}, id interface{}, req interface{}) *SongsServiceInterfaceMock_SetSongArtists_Call {
+	return &SongsServiceInterfaceMock_SetSongArtists_Call{Call: _e.mock.On("SetSongArtists", ctx, id, req)}
 }
 
-func (_c *SongsServiceInterfaceMock_GetSongs_Call) Run(run func(ctx context.Context, filters map[string]string, page int, size int)) *SongsServiceInterfaceMock_GetSongs_Call {
+func (_c *SongsServiceInterfaceMock_SetSongArtists_Call) Run(run func(ctx context.Context, id int, req *domain.SongArtistsRequest)) *SongsServiceInterfaceMock_SetSongArtists_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(map[string]string), args[2].(int), args[3].(int))
+		run(args[0].(context.Context), args[1].(int), args[2].(*domain.SongArtistsRequest))
 	})
 	return _c
 }
 
-func (_c *SongsServiceInterfaceMock_GetSongs_Call) Return(_a0 []domain.Song, _a1 error) *SongsServiceInterfaceMock_GetSongs_Call {
+func (_c *SongsServiceInterfaceMock_SetSongArtists_Call) Return(_a0 *domain.Song, _a1 error) *SongsServiceInterfaceMock_SetSongArtists_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *SongsServiceInterfaceMock_GetSongs_Call) RunAndReturn(run func(context.Context, map[string]string, int, int) ([]domain.Song, error)) *SongsServiceInterfaceMock_GetSongs_Call {
+func (_c *SongsServiceInterfaceMock_SetSongArtists_Call) RunAndReturn(run func(context.Context, int, *domain.SongArtistsRequest) (*domain.Song, error)) *SongsServiceInterfaceMock_SetSongArtists_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateSong provides a mock function with given fields: ctx, song
-func (_m *SongsServiceInterfaceMock) UpdateSong(ctx context.Context, song *domain.Song) error {
-	ret := _m.Called(ctx, song)
+// UpdateSong provides a mock function with given fields: ctx, song, reason
+func (_m *SongsServiceInterfaceMock) UpdateSong(ctx context.Context, song *domain.Song, reason string) error {
+	ret := _m.Called(ctx, song, reason)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateSong")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *domain.Song) error); ok {
-		r0 = rf(ctx, song)
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Song, string) error); ok {
+		r0 = rf(ctx, song, reason)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -274,13 +1112,14 @@ type SongsServiceInterfaceMock_UpdateSong_Call struct {
 // UpdateSong is a helper method to define mock.On call
 //   - ctx context.Context
 //   - song *domain.Song
-func (_e *SongsServiceInterfaceMock_Expecter) UpdateSong(ctx interface{}, song interface{}) *SongsServiceInterfaceMock_UpdateSong_Call {
-	return &SongsServiceInterfaceMock_UpdateSong_Call{Call: _e.mock.On("UpdateSong", ctx, song)}
+//   - reason string
+func (_e *SongsServiceInterfaceMock_Expecter) UpdateSong(ctx interface{}, song interface{}, reason interface{}) *SongsServiceInterfaceMock_UpdateSong_Call {
+	return &SongsServiceInterfaceMock_UpdateSong_Call{Call: _e.mock.On("UpdateSong", ctx, song, reason)}
 }
 
-func (_c *SongsServiceInterfaceMock_UpdateSong_Call) Run(run func(ctx context.Context, song *domain.Song)) *SongsServiceInterfaceMock_UpdateSong_Call {
+func (_c *SongsServiceInterfaceMock_UpdateSong_Call) Run(run func(ctx context.Context, song *domain.Song, reason string)) *SongsServiceInterfaceMock_UpdateSong_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*domain.Song))
+		run(args[0].(context.Context), args[1].(*domain.Song), args[2].(string))
 	})
 	return _c
 }
@@ -290,7 +1129,7 @@ func (_c *SongsServiceInterfaceMock_UpdateSong_Call) Return(_a0 error) *SongsSer
 	return _c
 }
 
-func (_c *SongsServiceInterfaceMock_UpdateSong_Call) RunAndReturn(run func(context.Context, *domain.Song) error) *SongsServiceInterfaceMock_UpdateSong_Call {
+func (_c *SongsServiceInterfaceMock_UpdateSong_Call) RunAndReturn(run func(context.Context, *domain.Song, string) error) *SongsServiceInterfaceMock_UpdateSong_Call {
 	_c.Call.Return(run)
 	return _c
 }
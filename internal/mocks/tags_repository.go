@@ -0,0 +1,157 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/mashfeii/songs_library/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TagsRepositoryMock is an autogenerated mock type for the TagsRepository type
+type TagsRepositoryMock struct {
+	mock.Mock
+}
+
+type TagsRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TagsRepositoryMock) EXPECT() *TagsRepositoryMock_Expecter {
+	return &TagsRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// GetTags provides a mock function with given fields: ctx, kind
+func (_m *TagsRepositoryMock) GetTags(ctx context.Context, kind domain.TagKind) ([]domain.TagCount, error) {
+	ret := _m.Called(ctx, kind)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTags")
+	}
+
+	var r0 []domain.TagCount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.TagKind) ([]domain.TagCount, error)); ok {
+		return rf(ctx, kind)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.TagKind) []domain.TagCount); ok {
+		r0 = rf(ctx, kind)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.TagCount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.TagKind) error); ok {
+		r1 = rf(ctx, kind)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TagsRepositoryMock_GetTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTags'
+type TagsRepositoryMock_GetTags_Call struct {
+	*mock.Call
+}
+
+// GetTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - kind domain.TagKind
+func (_e *TagsRepositoryMock_Expecter) GetTags(ctx interface{}, kind interface{}) *TagsRepositoryMock_GetTags_Call {
+	return &TagsRepositoryMock_GetTags_Call{Call: _e.mock.On("GetTags", ctx, kind)}
+}
+
+func (_c *TagsRepositoryMock_GetTags_Call) Run(run func(ctx context.Context, kind domain.TagKind)) *TagsRepositoryMock_GetTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.TagKind))
+	})
+	return _c
+}
+
+func (_c *TagsRepositoryMock_GetTags_Call) Return(_a0 []domain.TagCount, _a1 error) *TagsRepositoryMock_GetTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TagsRepositoryMock_GetTags_Call) RunAndReturn(run func(context.Context, domain.TagKind) ([]domain.TagCount, error)) *TagsRepositoryMock_GetTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetSongTags provides a mock function with given fields: ctx, songID, tags
+func (_m *TagsRepositoryMock) SetSongTags(ctx context.Context, songID int, tags []domain.Tag) ([]domain.Tag, error) {
+	ret := _m.Called(ctx, songID, tags)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetSongTags")
+	}
+
+	var r0 []domain.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, []domain.Tag) ([]domain.Tag, error)); ok {
+		return rf(ctx, songID, tags)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, []domain.Tag) []domain.Tag); ok {
+		r0 = rf(ctx, songID, tags)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, []domain.Tag) error); ok {
+		r1 = rf(ctx, songID, tags)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TagsRepositoryMock_SetSongTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetSongTags'
+type TagsRepositoryMock_SetSongTags_Call struct {
+	*mock.Call
+}
+
+// SetSongTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - songID int
+//   - tags []domain.Tag
+func (_e *TagsRepositoryMock_Expecter) SetSongTags(ctx interface{}, songID interface{}, tags interface{}) *TagsRepositoryMock_SetSongTags_Call {
+	return &TagsRepositoryMock_SetSongTags_Call{Call: _e.mock.On("SetSongTags", ctx, songID, tags)}
+}
+
+func (_c *TagsRepositoryMock_SetSongTags_Call) Run(run func(ctx context.Context, songID int, tags []domain.Tag)) *TagsRepositoryMock_SetSongTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].([]domain.Tag))
+	})
+	return _c
+}
+
+func (_c *TagsRepositoryMock_SetSongTags_Call) Return(_a0 []domain.Tag, _a1 error) *TagsRepositoryMock_SetSongTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TagsRepositoryMock_SetSongTags_Call) RunAndReturn(run func(context.Context, int, []domain.Tag) ([]domain.Tag, error)) *TagsRepositoryMock_SetSongTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTagsRepositoryMock creates a new instance of TagsRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTagsRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TagsRepositoryMock {
+	mock := &TagsRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,156 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/mashfeii/songs_library/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TagsServiceInterfaceMock is an autogenerated mock type for the TagsServiceInterface type
+type TagsServiceInterfaceMock struct {
+	mock.Mock
+}
+
+type TagsServiceInterfaceMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TagsServiceInterfaceMock) EXPECT() *TagsServiceInterfaceMock_Expecter {
+	return &TagsServiceInterfaceMock_Expecter{mock: &_m.Mock}
+}
+
+// GetTags provides a mock function with given fields: ctx, kind
+func (_m *TagsServiceInterfaceMock) GetTags(ctx context.Context, kind domain.TagKind) ([]domain.TagCount, error) {
+	ret := _m.Called(ctx, kind)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTags")
+	}
+
+	var r0 []domain.TagCount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.TagKind) ([]domain.TagCount, error)); ok {
+		return rf(ctx, kind)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.TagKind) []domain.TagCount); ok {
+		r0 = rf(ctx, kind)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.TagCount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.TagKind) error); ok {
+		r1 = rf(ctx, kind)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TagsServiceInterfaceMock_GetTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTags'
+type TagsServiceInterfaceMock_GetTags_Call struct {
+	*mock.Call
+}
+
+// GetTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - kind domain.TagKind
+func (_e *TagsServiceInterfaceMock_Expecter) GetTags(ctx interface{}, kind interface{}) *TagsServiceInterfaceMock_GetTags_Call {
+	return &TagsServiceInterfaceMock_GetTags_Call{Call: _e.mock.On("GetTags", ctx, kind)}
+}
+
+func (_c *TagsServiceInterfaceMock_GetTags_Call) Run(run func(ctx context.Context, kind domain.TagKind)) *TagsServiceInterfaceMock_GetTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.TagKind))
+	})
+	return _c
+}
+
+func (_c *TagsServiceInterfaceMock_GetTags_Call) Return(_a0 []domain.TagCount, _a1 error) *TagsServiceInterfaceMock_GetTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TagsServiceInterfaceMock_GetTags_Call) RunAndReturn(run func(context.Context, domain.TagKind) ([]domain.TagCount, error)) *TagsServiceInterfaceMock_GetTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetSongTags provides a mock function with given fields: ctx, songID, req
+func (_m *TagsServiceInterfaceMock) SetSongTags(ctx context.Context, songID int, req *domain.SongTagsRequest) ([]domain.Tag, error) {
+	ret := _m.Called(ctx, songID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetSongTags")
+	}
+
+	var r0 []domain.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *domain.SongTagsRequest) ([]domain.Tag, error)); ok {
+		return rf(ctx, songID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, *domain.SongTagsRequest) []domain.Tag); ok {
+		r0 = rf(ctx, songID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, *domain.SongTagsRequest) error); ok {
+		r1 = rf(ctx, songID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TagsServiceInterfaceMock_SetSongTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetSongTags'
+type TagsServiceInterfaceMock_SetSongTags_Call struct {
+	*mock.Call
+}
+
+// SetSongTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - songID int
+//   - req *domain.SongTagsRequest
+func (_e *TagsServiceInterfaceMock_Expecter) SetSongTags(ctx interface{}, songID interface{}, req interface{}) *TagsServiceInterfaceMock_SetSongTags_Call {
+	return &TagsServiceInterfaceMock_SetSongTags_Call{Call: _e.mock.On("SetSongTags", ctx, songID, req)}
+}
+
+func (_c *TagsServiceInterfaceMock_SetSongTags_Call) Run(run func(ctx context.Context, songID int, req *domain.SongTagsRequest)) *TagsServiceInterfaceMock_SetSongTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(*domain.SongTagsRequest))
+	})
+	return _c
+}
+
+func (_c *TagsServiceInterfaceMock_SetSongTags_Call) Return(_a0 []domain.Tag, _a1 error) *TagsServiceInterfaceMock_SetSongTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TagsServiceInterfaceMock_SetSongTags_Call) RunAndReturn(run func(context.Context, int, *domain.SongTagsRequest) ([]domain.Tag, error)) *TagsServiceInterfaceMock_SetSongTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTagsServiceInterfaceMock creates a new instance of TagsServiceInterfaceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTagsServiceInterfaceMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TagsServiceInterfaceMock {
+	mock := &TagsServiceInterfaceMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,251 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/mashfeii/songs_library/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TranslationsRepositoryMock is an autogenerated mock type for the TranslationsRepository type
+type TranslationsRepositoryMock struct {
+	mock.Mock
+}
+
+type TranslationsRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TranslationsRepositoryMock) EXPECT() *TranslationsRepositoryMock_Expecter {
+	return &TranslationsRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// AddTranslation provides a mock function with given fields: ctx, translation
+func (_m *TranslationsRepositoryMock) AddTranslation(ctx context.Context, translation *domain.Translation) error {
+	ret := _m.Called(ctx, translation)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddTranslation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Translation) error); ok {
+		r0 = rf(ctx, translation)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TranslationsRepositoryMock_AddTranslation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddTranslation'
+type TranslationsRepositoryMock_AddTranslation_Call struct {
+	*mock.Call
+}
+
+// AddTranslation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - translation *domain.Translation
+func (_e *TranslationsRepositoryMock_Expecter) AddTranslation(ctx interface{}, translation interface{}) *TranslationsRepositoryMock_AddTranslation_Call {
+	return &TranslationsRepositoryMock_AddTranslation_Call{Call: _e.mock.On("AddTranslation", ctx, translation)}
+}
+
+func (_c *TranslationsRepositoryMock_AddTranslation_Call) Run(run func(ctx context.Context, translation *domain.Translation)) *TranslationsRepositoryMock_AddTranslation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.Translation))
+	})
+	return _c
+}
+
+func (_c *TranslationsRepositoryMock_AddTranslation_Call) Return(_a0 error) *TranslationsRepositoryMock_AddTranslation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TranslationsRepositoryMock_AddTranslation_Call) RunAndReturn(run func(context.Context, *domain.Translation) error) *TranslationsRepositoryMock_AddTranslation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTranslation provides a mock function with given fields: ctx, songID, language
+func (_m *TranslationsRepositoryMock) GetTranslation(ctx context.Context, songID int, language string) (*domain.Translation, error) {
+	ret := _m.Called(ctx, songID, language)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTranslation")
+	}
+
+	var r0 *domain.Translation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) (*domain.Translation, error)); ok {
+		return rf(ctx, songID, language)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) *domain.Translation); ok {
+		r0 = rf(ctx, songID, language)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Translation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, string) error); ok {
+		r1 = rf(ctx, songID, language)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TranslationsRepositoryMock_GetTranslation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTranslation'
+type TranslationsRepositoryMock_GetTranslation_Call struct {
+	*mock.Call
+}
+
+// GetTranslation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - songID int
+//   - language string
+func (_e *TranslationsRepositoryMock_Expecter) GetTranslation(ctx interface{}, songID interface{}, language interface{}) *TranslationsRepositoryMock_GetTranslation_Call {
+	return &TranslationsRepositoryMock_GetTranslation_Call{Call: _e.mock.On("GetTranslation", ctx, songID, language)}
+}
+
+func (_c *TranslationsRepositoryMock_GetTranslation_Call) Run(run func(ctx context.Context, songID int, language string)) *TranslationsRepositoryMock_GetTranslation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TranslationsRepositoryMock_GetTranslation_Call) Return(_a0 *domain.Translation, _a1 error) *TranslationsRepositoryMock_GetTranslation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TranslationsRepositoryMock_GetTranslation_Call) RunAndReturn(run func(context.Context, int, string) (*domain.Translation, error)) *TranslationsRepositoryMock_GetTranslation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTranslations provides a mock function with given fields: ctx, songID
+func (_m *TranslationsRepositoryMock) GetTranslations(ctx context.Context, songID int) ([]domain.Translation, error) {
+	ret := _m.Called(ctx, songID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTranslations")
+	}
+
+	var r0 []domain.Translation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]domain.Translation, error)); ok {
+		return rf(ctx, songID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []domain.Translation); ok {
+		r0 = rf(ctx, songID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Translation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, songID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TranslationsRepositoryMock_GetTranslations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTranslations'
+type TranslationsRepositoryMock_GetTranslations_Call struct {
+	*mock.Call
+}
+
+// GetTranslations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - songID int
+func (_e *TranslationsRepositoryMock_Expecter) GetTranslations(ctx interface{}, songID interface{}) *TranslationsRepositoryMock_GetTranslations_Call {
+	return &TranslationsRepositoryMock_GetTranslations_Call{Call: _e.mock.On("GetTranslations", ctx, songID)}
+}
+
+func (_c *TranslationsRepositoryMock_GetTranslations_Call) Run(run func(ctx context.Context, songID int)) *TranslationsRepositoryMock_GetTranslations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *TranslationsRepositoryMock_GetTranslations_Call) Return(_a0 []domain.Translation, _a1 error) *TranslationsRepositoryMock_GetTranslations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TranslationsRepositoryMock_GetTranslations_Call) RunAndReturn(run func(context.Context, int) ([]domain.Translation, error)) *TranslationsRepositoryMock_GetTranslations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTranslation provides a mock function with given fields: ctx, translation
+func (_m *TranslationsRepositoryMock) UpdateTranslation(ctx context.Context, translation *domain.Translation) error {
+	ret := _m.Called(ctx, translation)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTranslation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Translation) error); ok {
+		r0 = rf(ctx, translation)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TranslationsRepositoryMock_UpdateTranslation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTranslation'
+type TranslationsRepositoryMock_UpdateTranslation_Call struct {
+	*mock.Call
+}
+
+// UpdateTranslation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - translation *domain.Translation
+func (_e *TranslationsRepositoryMock_Expecter) UpdateTranslation(ctx interface{}, translation interface{}) *TranslationsRepositoryMock_UpdateTranslation_Call {
+	return &TranslationsRepositoryMock_UpdateTranslation_Call{Call: _e.mock.On("UpdateTranslation", ctx, translation)}
+}
+
+func (_c *TranslationsRepositoryMock_UpdateTranslation_Call) Run(run func(ctx context.Context, translation *domain.Translation)) *TranslationsRepositoryMock_UpdateTranslation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.Translation))
+	})
+	return _c
+}
+
+func (_c *TranslationsRepositoryMock_UpdateTranslation_Call) Return(_a0 error) *TranslationsRepositoryMock_UpdateTranslation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TranslationsRepositoryMock_UpdateTranslation_Call) RunAndReturn(run func(context.Context, *domain.Translation) error) *TranslationsRepositoryMock_UpdateTranslation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTranslationsRepositoryMock creates a new instance of TranslationsRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTranslationsRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TranslationsRepositoryMock {
+	mock := &TranslationsRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
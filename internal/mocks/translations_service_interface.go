@@ -0,0 +1,277 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/mashfeii/songs_library/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TranslationsServiceInterfaceMock is an autogenerated mock type for the TranslationsServiceInterface type
+type TranslationsServiceInterfaceMock struct {
+	mock.Mock
+}
+
+type TranslationsServiceInterfaceMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TranslationsServiceInterfaceMock) EXPECT() *TranslationsServiceInterfaceMock_Expecter {
+	return &TranslationsServiceInterfaceMock_Expecter{mock: &_m.Mock}
+}
+
+// AddTranslation provides a mock function with given fields: ctx, songID, req
+func (_m *TranslationsServiceInterfaceMock) AddTranslation(ctx context.Context, songID int, req *domain.TranslationRequest) (*domain.Translation, error) {
+	ret := _m.Called(ctx, songID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddTranslation")
+	}
+
+	var r0 *domain.Translation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *domain.TranslationRequest) (*domain.Translation, error)); ok {
+		return rf(ctx, songID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, *domain.TranslationRequest) *domain.Translation); ok {
+		r0 = rf(ctx, songID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Translation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, *domain.TranslationRequest) error); ok {
+		r1 = rf(ctx, songID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TranslationsServiceInterfaceMock_AddTranslation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddTranslation'
+type TranslationsServiceInterfaceMock_AddTranslation_Call struct {
+	*mock.Call
+}
+
+// AddTranslation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - songID int
+//   - req *domain.TranslationRequest
+func (_e *TranslationsServiceInterfaceMock_Expecter) AddTranslation(ctx interface{}, songID interface{}, req interface{}) *TranslationsServiceInterfaceMock_AddTranslation_Call {
+	return &TranslationsServiceInterfaceMock_AddTranslation_Call{Call: _e.mock.On("AddTranslation", ctx, songID, req)}
+}
+
+func (_c *TranslationsServiceInterfaceMock_AddTranslation_Call) Run(run func(ctx context.Context, songID int, req *domain.TranslationRequest)) *TranslationsServiceInterfaceMock_AddTranslation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(*domain.TranslationRequest))
+	})
+	return _c
+}
+
+func (_c *TranslationsServiceInterfaceMock_AddTranslation_Call) Return(_a0 *domain.Translation, _a1 error) *TranslationsServiceInterfaceMock_AddTranslation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TranslationsServiceInterfaceMock_AddTranslation_Call) RunAndReturn(run func(context.Context, int, *domain.TranslationRequest) (*domain.Translation, error)) *TranslationsServiceInterfaceMock_AddTranslation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTranslation provides a mock function with given fields: ctx, songID, language
+func (_m *TranslationsServiceInterfaceMock) GetTranslation(ctx context.Context, songID int, language string) (*domain.Translation, error) {
+	ret := _m.Called(ctx, songID, language)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTranslation")
+	}
+
+	var r0 *domain.Translation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) (*domain.Translation, error)); ok {
+		return rf(ctx, songID, language)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) *domain.Translation); ok {
+		r0 = rf(ctx, songID, language)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Translation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, string) error); ok {
+		r1 = rf(ctx, songID, language)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TranslationsServiceInterfaceMock_GetTranslation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTranslation'
+type TranslationsServiceInterfaceMock_GetTranslation_Call struct {
+	*mock.Call
+}
+
+// GetTranslation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - songID int
+//   - language string
+func (_e *TranslationsServiceInterfaceMock_Expecter) GetTranslation(ctx interface{}, songID interface{}, language interface{}) *TranslationsServiceInterfaceMock_GetTranslation_Call {
+	return &TranslationsServiceInterfaceMock_GetTranslation_Call{Call: _e.mock.On("GetTranslation", ctx, songID, language)}
+}
+
+func (_c *TranslationsServiceInterfaceMock_GetTranslation_Call) Run(run func(ctx context.Context, songID int, language string)) *TranslationsServiceInterfaceMock_GetTranslation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TranslationsServiceInterfaceMock_GetTranslation_Call) Return(_a0 *domain.Translation, _a1 error) *TranslationsServiceInterfaceMock_GetTranslation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TranslationsServiceInterfaceMock_GetTranslation_Call) RunAndReturn(run func(context.Context, int, string) (*domain.Translation, error)) *TranslationsServiceInterfaceMock_GetTranslation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTranslations provides a mock function with given fields: ctx, songID
+func (_m *TranslationsServiceInterfaceMock) GetTranslations(ctx context.Context, songID int) ([]domain.Translation, error) {
+	ret := _m.Called(ctx, songID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTranslations")
+	}
+
+	var r0 []domain.Translation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]domain.Translation, error)); ok {
+		return rf(ctx, songID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []domain.Translation); ok {
+		r0 = rf(ctx, songID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Translation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, songID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TranslationsServiceInterfaceMock_GetTranslations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTranslations'
+type TranslationsServiceInterfaceMock_GetTranslations_Call struct {
+	*mock.Call
+}
+
+// GetTranslations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - songID int
+func (_e *TranslationsServiceInterfaceMock_Expecter) GetTranslations(ctx interface{}, songID interface{}) *TranslationsServiceInterfaceMock_GetTranslations_Call {
+	return &TranslationsServiceInterfaceMock_GetTranslations_Call{Call: _e.mock.On("GetTranslations", ctx, songID)}
+}
+
+func (_c *TranslationsServiceInterfaceMock_GetTranslations_Call) Run(run func(ctx context.Context, songID int)) *TranslationsServiceInterfaceMock_GetTranslations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *TranslationsServiceInterfaceMock_GetTranslations_Call) Return(_a0 []domain.Translation, _a1 error) *TranslationsServiceInterfaceMock_GetTranslations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TranslationsServiceInterfaceMock_GetTranslations_Call) RunAndReturn(run func(context.Context, int) ([]domain.Translation, error)) *TranslationsServiceInterfaceMock_GetTranslations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTranslation provides a mock function with given fields: ctx, songID, language, req
+func (_m *TranslationsServiceInterfaceMock) UpdateTranslation(ctx context.Context, songID int, language string, req *domain.UpdateTranslationRequest) (*domain.Translation, error) {
+	ret := _m.Called(ctx, songID, language, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTranslation")
+	}
+
+	var r0 *domain.Translation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string, *domain.UpdateTranslationRequest) (*domain.Translation, error)); ok {
+		return rf(ctx, songID, language, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, string, *domain.UpdateTranslationRequest) *domain.Translation); ok {
+		r0 = rf(ctx, songID, language, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Translation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, string, *domain.UpdateTranslationRequest) error); ok {
+		r1 = rf(ctx, songID, language, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TranslationsServiceInterfaceMock_UpdateTranslation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTranslation'
+type TranslationsServiceInterfaceMock_UpdateTranslation_Call struct {
+	*mock.Call
+}
+
+// UpdateTranslation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - songID int
+//   - language string
+//   - req *domain.UpdateTranslationRequest
+func (_e *TranslationsServiceInterfaceMock_Expecter) UpdateTranslation(ctx interface{}, songID interface{}, language interface{}, req interface{}) *TranslationsServiceInterfaceMock_UpdateTranslation_Call {
+	return &TranslationsServiceInterfaceMock_UpdateTranslation_Call{Call: _e.mock.On("UpdateTranslation", ctx, songID, language, req)}
+}
+
+func (_c *TranslationsServiceInterfaceMock_UpdateTranslation_Call) Run(run func(ctx context.Context, songID int, language string, req *domain.UpdateTranslationRequest)) *TranslationsServiceInterfaceMock_UpdateTranslation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(string), args[3].(*domain.UpdateTranslationRequest))
+	})
+	return _c
+}
+
+func (_c *TranslationsServiceInterfaceMock_UpdateTranslation_Call) Return(_a0 *domain.Translation, _a1 error) *TranslationsServiceInterfaceMock_UpdateTranslation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TranslationsServiceInterfaceMock_UpdateTranslation_Call) RunAndReturn(run func(context.Context, int, string, *domain.UpdateTranslationRequest) (*domain.Translation, error)) *TranslationsServiceInterfaceMock_UpdateTranslation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTranslationsServiceInterfaceMock creates a new instance of TranslationsServiceInterfaceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTranslationsServiceInterfaceMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TranslationsServiceInterfaceMock {
+	mock := &TranslationsServiceInterfaceMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
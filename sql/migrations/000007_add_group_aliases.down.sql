@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS group_aliases;
+
+DROP FUNCTION IF EXISTS normalize_group_name(TEXT);
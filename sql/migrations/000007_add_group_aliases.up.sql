@@ -0,0 +1,20 @@
+BEGIN;
+
+CREATE OR REPLACE FUNCTION normalize_group_name(name TEXT) RETURNS TEXT
+LANGUAGE SQL IMMUTABLE STRICT PARALLEL SAFE
+AS $$ SELECT lower(regexp_replace(btrim(name), '\s+', ' ', 'g')) $$;
+
+CREATE TABLE IF NOT EXISTS group_aliases (
+    alias TEXT PRIMARY KEY,
+    group_id INT NOT NULL REFERENCES groups(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_group_aliases_group_id ON group_aliases (group_id);
+
+INSERT INTO group_aliases (alias, group_id)
+SELECT DISTINCT ON (normalize_group_name(name)) normalize_group_name(name), id
+FROM groups
+ORDER BY normalize_group_name(name), id
+ON CONFLICT (alias) DO NOTHING;
+
+COMMIT;
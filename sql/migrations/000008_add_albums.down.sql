@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_songs_album_track;
+
+ALTER TABLE songs
+DROP CONSTRAINT IF EXISTS fk_album,
+DROP COLUMN IF EXISTS track_number,
+DROP COLUMN IF EXISTS album_id;
+
+DROP TABLE IF EXISTS albums;
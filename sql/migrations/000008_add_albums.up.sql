@@ -0,0 +1,20 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS albums (
+    id SERIAL PRIMARY KEY,
+    group_id INT NOT NULL,
+    title TEXT NOT NULL,
+    release_date DATE,
+    cover_link TEXT,
+    CONSTRAINT fk_album_group FOREIGN KEY (group_id) REFERENCES groups(id),
+    CONSTRAINT uq_album_group_title UNIQUE (group_id, title)
+);
+
+ALTER TABLE songs
+ADD COLUMN IF NOT EXISTS album_id INT,
+ADD COLUMN IF NOT EXISTS track_number INT CHECK (track_number > 0),
+ADD CONSTRAINT fk_album FOREIGN KEY (album_id) REFERENCES albums(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_songs_album_track ON songs (album_id, track_number);
+
+COMMIT;
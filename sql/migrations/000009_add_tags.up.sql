@@ -0,0 +1,21 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS tags (
+    id SERIAL PRIMARY KEY,
+    name TEXT NOT NULL,
+    kind TEXT NOT NULL DEFAULT 'tag' CHECK (kind IN ('genre', 'tag')),
+    CONSTRAINT uq_tag_kind_name UNIQUE (kind, name)
+);
+
+CREATE TABLE IF NOT EXISTS song_tags (
+    song_id INT NOT NULL,
+    tag_id INT NOT NULL,
+    PRIMARY KEY (song_id, tag_id),
+    CONSTRAINT fk_song_tag_song FOREIGN KEY (song_id) REFERENCES songs(id) ON DELETE CASCADE,
+    CONSTRAINT fk_song_tag_tag FOREIGN KEY (tag_id) REFERENCES tags(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_song_tags_tag ON song_tags (tag_id);
+CREATE INDEX IF NOT EXISTS idx_tags_name ON tags (name);
+
+COMMIT;
@@ -0,0 +1,5 @@
+DROP TRIGGER IF EXISTS trg_songs_primary_artist ON songs;
+
+DROP FUNCTION IF EXISTS sync_song_primary_artist();
+
+DROP TABLE IF EXISTS song_artists;
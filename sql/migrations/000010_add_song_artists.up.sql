@@ -0,0 +1,46 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS song_artists (
+    song_id INT NOT NULL,
+    group_id INT NOT NULL,
+    role TEXT NOT NULL CHECK (role IN ('primary', 'featured', 'composer', 'producer')),
+    position INT NOT NULL DEFAULT 0,
+    PRIMARY KEY (song_id, group_id, role),
+    CONSTRAINT fk_song_artist_song FOREIGN KEY (song_id) REFERENCES songs(id) ON DELETE CASCADE,
+    CONSTRAINT fk_song_artist_group FOREIGN KEY (group_id) REFERENCES groups(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_song_artists_group_role ON song_artists (group_id, role);
+
+INSERT INTO song_artists (song_id, group_id, role)
+SELECT id, group_id, 'primary' FROM songs
+ON CONFLICT DO NOTHING;
+
+-- songs.group_id stays the main primary artist, the trigger mirrors it into song_artists
+-- so every writer of songs keeps the credits consistent.
+CREATE OR REPLACE FUNCTION sync_song_primary_artist() RETURNS TRIGGER
+LANGUAGE plpgsql
+AS $$
+BEGIN
+    IF TG_OP = 'UPDATE' THEN
+        IF OLD.group_id = NEW.group_id THEN
+            RETURN NEW;
+        END IF;
+
+        DELETE FROM song_artists
+        WHERE song_id = NEW.id AND group_id = OLD.group_id AND role = 'primary';
+    END IF;
+
+    INSERT INTO song_artists (song_id, group_id, role)
+    VALUES (NEW.id, NEW.group_id, 'primary')
+    ON CONFLICT DO NOTHING;
+
+    RETURN NEW;
+END
+$$;
+
+CREATE TRIGGER trg_songs_primary_artist
+AFTER INSERT OR UPDATE OF group_id ON songs
+FOR EACH ROW EXECUTE FUNCTION sync_song_primary_artist();
+
+COMMIT;
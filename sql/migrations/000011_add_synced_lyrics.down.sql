@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS synced_lyrics_lines;
+
+DROP TABLE IF EXISTS synced_lyrics;
@@ -0,0 +1,19 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS synced_lyrics (
+    song_id INT PRIMARY KEY,
+    tags JSONB NOT NULL DEFAULT '{}',
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT fk_synced_lyrics_song FOREIGN KEY (song_id) REFERENCES songs(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS synced_lyrics_lines (
+    song_id INT NOT NULL,
+    position INT NOT NULL,
+    time_ms INT NOT NULL CHECK (time_ms >= 0),
+    text TEXT NOT NULL,
+    PRIMARY KEY (song_id, position),
+    CONSTRAINT fk_synced_line_lyrics FOREIGN KEY (song_id) REFERENCES synced_lyrics(song_id) ON DELETE CASCADE
+);
+
+COMMIT;
@@ -0,0 +1,18 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS song_revisions (
+    song_id INT NOT NULL,
+    revision INT NOT NULL,
+    group_name TEXT NOT NULL,
+    song_name TEXT NOT NULL,
+    release_date DATE,
+    text TEXT,
+    link TEXT,
+    author TEXT NOT NULL DEFAULT '',
+    reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (song_id, revision),
+    CONSTRAINT fk_revision_song FOREIGN KEY (song_id) REFERENCES songs(id) ON DELETE CASCADE
+);
+
+COMMIT;
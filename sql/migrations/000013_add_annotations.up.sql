@@ -0,0 +1,20 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS annotations (
+    id SERIAL PRIMARY KEY,
+    song_id INT NOT NULL,
+    start_line INT NOT NULL CHECK (start_line > 0),
+    end_line INT NOT NULL,
+    body TEXT NOT NULL,
+    anchor_text TEXT NOT NULL,
+    author TEXT NOT NULL DEFAULT '',
+    stale BOOLEAN NOT NULL DEFAULT false,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT fk_annotation_song FOREIGN KEY (song_id) REFERENCES songs(id) ON DELETE CASCADE,
+    CONSTRAINT chk_annotation_range CHECK (end_line >= start_line)
+);
+
+CREATE INDEX IF NOT EXISTS idx_annotations_song_lines ON annotations (song_id, start_line);
+
+COMMIT;
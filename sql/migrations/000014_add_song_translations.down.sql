@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS song_translations;
+
+ALTER TABLE song_revisions DROP COLUMN IF EXISTS language;
+
+DROP INDEX IF EXISTS idx_songs_language;
+
+ALTER TABLE songs DROP COLUMN IF EXISTS language;
@@ -0,0 +1,20 @@
+BEGIN;
+
+ALTER TABLE songs ADD COLUMN IF NOT EXISTS language TEXT;
+
+CREATE INDEX IF NOT EXISTS idx_songs_language ON songs (language);
+
+ALTER TABLE song_revisions ADD COLUMN IF NOT EXISTS language TEXT;
+
+CREATE TABLE IF NOT EXISTS song_translations (
+    song_id INT NOT NULL,
+    language TEXT NOT NULL,
+    text TEXT NOT NULL,
+    translator TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (song_id, language),
+    CONSTRAINT fk_translation_song FOREIGN KEY (song_id) REFERENCES songs(id) ON DELETE CASCADE
+);
+
+COMMIT;
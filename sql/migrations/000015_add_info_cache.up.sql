@@ -0,0 +1,16 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS info_cache (
+    group_key TEXT NOT NULL,
+    song_key TEXT NOT NULL,
+    group_name TEXT NOT NULL,
+    song_name TEXT NOT NULL,
+    status INT NOT NULL,
+    payload JSONB,
+    fetched_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (group_key, song_key)
+);
+
+CREATE INDEX IF NOT EXISTS idx_info_cache_fetched_at ON info_cache (fetched_at);
+
+COMMIT;
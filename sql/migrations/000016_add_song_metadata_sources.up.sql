@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE songs ADD COLUMN IF NOT EXISTS metadata_sources JSONB;
+
+COMMIT;
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_songs_pending;
+
+ALTER TABLE songs
+DROP COLUMN IF EXISTS status_error,
+DROP COLUMN IF EXISTS status;
@@ -0,0 +1,10 @@
+BEGIN;
+
+ALTER TABLE songs
+ADD COLUMN IF NOT EXISTS status TEXT NOT NULL DEFAULT 'enriched'
+  CHECK (status IN ('pending', 'enriched', 'failed')),
+ADD COLUMN IF NOT EXISTS status_error TEXT;
+
+CREATE INDEX IF NOT EXISTS idx_songs_pending ON songs (id) WHERE status = 'pending';
+
+COMMIT;
@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS metadata_proposals;
+DROP TABLE IF EXISTS metadata_refresh_runs;
+
+DROP INDEX IF EXISTS idx_songs_enriched_at;
+
+ALTER TABLE songs
+DROP COLUMN IF EXISTS edited_fields,
+DROP COLUMN IF EXISTS enriched_at;
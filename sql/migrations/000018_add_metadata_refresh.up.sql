@@ -0,0 +1,37 @@
+BEGIN;
+
+ALTER TABLE songs
+ADD COLUMN IF NOT EXISTS enriched_at TIMESTAMPTZ,
+ADD COLUMN IF NOT EXISTS edited_fields TEXT[] NOT NULL DEFAULT '{}';
+
+CREATE INDEX IF NOT EXISTS idx_songs_enriched_at ON songs (enriched_at NULLS FIRST, id) WHERE status = 'enriched';
+
+CREATE TABLE IF NOT EXISTS metadata_refresh_runs (
+    id SERIAL PRIMARY KEY,
+    status TEXT NOT NULL DEFAULT 'running' CHECK (status IN ('running', 'finished', 'failed')),
+    checked INT NOT NULL DEFAULT 0,
+    changed INT NOT NULL DEFAULT 0,
+    proposed INT NOT NULL DEFAULT 0,
+    unchanged INT NOT NULL DEFAULT 0,
+    failed INT NOT NULL DEFAULT 0,
+    error TEXT,
+    started_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    finished_at TIMESTAMPTZ
+);
+
+CREATE TABLE IF NOT EXISTS metadata_proposals (
+    id SERIAL PRIMARY KEY,
+    run_id INT NOT NULL,
+    song_id INT NOT NULL,
+    field TEXT NOT NULL,
+    current_value TEXT NOT NULL,
+    proposed_value TEXT NOT NULL,
+    provider TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT fk_proposal_run FOREIGN KEY (run_id) REFERENCES metadata_refresh_runs(id) ON DELETE CASCADE,
+    CONSTRAINT fk_proposal_song FOREIGN KEY (song_id) REFERENCES songs(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_metadata_proposals_run ON metadata_proposals (run_id, song_id);
+
+COMMIT;
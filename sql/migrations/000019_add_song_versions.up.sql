@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE songs
+ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1;
+
+COMMIT;
@@ -0,0 +1,9 @@
+UPDATE songs SET status = 'pending' WHERE status = 'processing';
+
+DROP INDEX IF EXISTS idx_songs_pending;
+CREATE INDEX IF NOT EXISTS idx_songs_pending ON songs (id) WHERE status = 'pending';
+
+ALTER TABLE songs
+DROP COLUMN IF EXISTS claimed_at,
+DROP CONSTRAINT IF EXISTS songs_status_check,
+ADD CONSTRAINT songs_status_check CHECK (status IN ('pending', 'enriched', 'failed'));
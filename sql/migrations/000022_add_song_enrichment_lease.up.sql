@@ -0,0 +1,11 @@
+BEGIN;
+
+ALTER TABLE songs
+DROP CONSTRAINT IF EXISTS songs_status_check,
+ADD CONSTRAINT songs_status_check CHECK (status IN ('pending', 'processing', 'enriched', 'failed')),
+ADD COLUMN IF NOT EXISTS claimed_at TIMESTAMPTZ;
+
+DROP INDEX IF EXISTS idx_songs_pending;
+CREATE INDEX IF NOT EXISTS idx_songs_pending ON songs (id) WHERE status IN ('pending', 'processing');
+
+COMMIT;
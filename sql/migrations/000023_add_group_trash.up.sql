@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE groups
+ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+
+COMMIT;
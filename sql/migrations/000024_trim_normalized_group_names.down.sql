@@ -0,0 +1,3 @@
+CREATE OR REPLACE FUNCTION normalize_group_name(name TEXT) RETURNS TEXT
+LANGUAGE SQL IMMUTABLE STRICT PARALLEL SAFE
+AS $$ SELECT lower(regexp_replace(btrim(name), '\s+', ' ', 'g')) $$;
@@ -0,0 +1,20 @@
+BEGIN;
+
+-- Collapse whitespace before trimming, so leading and trailing tabs or newlines are dropped
+-- the same way domain.NormalizeLookupKey drops them.
+CREATE OR REPLACE FUNCTION normalize_group_name(name TEXT) RETURNS TEXT
+LANGUAGE SQL IMMUTABLE STRICT PARALLEL SAFE
+AS $$ SELECT lower(btrim(regexp_replace(name, '\s+', ' ', 'g'))) $$;
+
+-- Aliases normalizing to the same name keep the already normalized one, or the first one.
+DELETE FROM group_aliases AS a
+USING group_aliases AS b
+WHERE a.alias <> b.alias
+  AND normalize_group_name(a.alias) = normalize_group_name(b.alias)
+  AND a.alias <> normalize_group_name(a.alias)
+  AND (b.alias = normalize_group_name(b.alias) OR b.alias < a.alias);
+
+UPDATE group_aliases SET alias = normalize_group_name(alias)
+WHERE alias <> normalize_group_name(alias);
+
+COMMIT;